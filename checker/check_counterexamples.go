@@ -0,0 +1,121 @@
+package checker
+
+import (
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oasdiff/oasdiff/diff"
+	"github.com/oasdiff/oasdiff/utils"
+)
+
+const (
+	RequestBodyCounterexampleFoundId  = "request-body-counterexample-found"
+	ResponseBodyCounterexampleFoundId = "response-body-counterexample-found"
+
+	// CounterexampleAttribute is the attribute that holds the payload that demonstrates the break
+	CounterexampleAttribute = "counterexample"
+)
+
+// CounterexamplesCheck is an optional verification pass that complements the rule-based checks
+// It generates samples from the base request body schemas and verifies that the revision schemas still accept them
+// It generates samples from the revision response body schemas and verifies that the base schemas accept them
+// The check is disabled unless one of its rules is included with WithOptionalChecks (--include-checks)
+func CounterexamplesCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
+	result := make(Changes, 0)
+	if diffReport.PathsDiff == nil {
+		return result
+	}
+
+	checkRequests := config.isIncluded(RequestBodyCounterexampleFoundId)
+	checkResponses := config.isIncluded(ResponseBodyCounterexampleFoundId)
+	if !checkRequests && !checkResponses {
+		return result
+	}
+
+	for path, pathItem := range diffReport.PathsDiff.Modified {
+		if pathItem.OperationsDiff == nil {
+			continue
+		}
+		for operation, operationItem := range pathItem.OperationsDiff.Modified {
+
+			if checkRequests &&
+				operationItem.RequestBodyDiff != nil &&
+				operationItem.RequestBodyDiff.ContentDiff != nil &&
+				operationItem.Base.RequestBody != nil && operationItem.Base.RequestBody.Value != nil &&
+				operationItem.Revision.RequestBody != nil && operationItem.Revision.RequestBody.Value != nil {
+				for mediaType := range operationItem.RequestBodyDiff.ContentDiff.MediaTypeModified {
+					counterexample, reason, found := findCounterexample(
+						operationItem.Base.RequestBody.Value.Content.Get(mediaType),
+						operationItem.Revision.RequestBody.Value.Content.Get(mediaType),
+						true)
+					if !found {
+						continue
+					}
+					result = append(result, withCounterexample(NewApiChange(
+						RequestBodyCounterexampleFoundId,
+						config,
+						[]any{mediaType, reason},
+						"",
+						operationsSources,
+						operationItem.Revision,
+						operation,
+						path,
+					), counterexample))
+				}
+			}
+
+			if checkResponses && operationItem.ResponsesDiff != nil {
+				for status, responseDiff := range operationItem.ResponsesDiff.Modified {
+					if responseDiff.ContentDiff == nil || responseDiff.Base == nil || responseDiff.Revision == nil {
+						continue
+					}
+					for mediaType := range responseDiff.ContentDiff.MediaTypeModified {
+						counterexample, reason, found := findCounterexample(
+							responseDiff.Revision.Content.Get(mediaType),
+							responseDiff.Base.Content.Get(mediaType),
+							false)
+						if !found {
+							continue
+						}
+						result = append(result, withCounterexample(NewApiChange(
+							ResponseBodyCounterexampleFoundId,
+							config,
+							[]any{mediaType, status, reason},
+							"",
+							operationsSources,
+							operationItem.Revision,
+							operation,
+							path,
+						), counterexample))
+					}
+				}
+			}
+		}
+	}
+	return result
+}
+
+// findCounterexample returns the first sample generated from the source schema that the target schema rejects, and the reason for the rejection
+func findCounterexample(source, target *openapi3.MediaType, request bool) (any, string, bool) {
+	if source == nil || target == nil ||
+		source.Schema == nil || source.Schema.Value == nil ||
+		target.Schema == nil || target.Schema.Value == nil {
+		return nil, "", false
+	}
+
+	opts := getSampleValidationOptions(request)
+	for _, sample := range generateSamples(source.Schema.Value, request) {
+		if err := target.Schema.Value.VisitJSON(sample, opts...); err != nil {
+			return sample, utils.GetValidationReason(err), true
+		}
+	}
+	return nil, "", false
+}
+
+func withCounterexample(change ApiChange, counterexample any) ApiChange {
+	attributes := make(map[string]any, len(change.Attributes)+1)
+	for key, value := range change.Attributes {
+		attributes[key] = value
+	}
+	attributes[CounterexampleAttribute] = counterexample
+	change.Attributes = attributes
+	return change
+}
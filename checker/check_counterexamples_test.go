@@ -0,0 +1,140 @@
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/diff"
+	"github.com/oasdiff/oasdiff/load"
+	"github.com/stretchr/testify/require"
+)
+
+func counterexamplesConfig() *checker.Config {
+	return singleCheckConfig(checker.CounterexamplesCheck).WithOptionalChecks([]string{
+		checker.RequestBodyCounterexampleFoundId,
+		checker.ResponseBodyCounterexampleFoundId,
+	})
+}
+
+// BC: changing a request body schema so that it rejects a payload generated from the base schema is breaking
+func TestRequestBodyCounterexampleFound(t *testing.T) {
+	s1, err := open("../data/checker/counterexamples_base.yaml")
+	require.NoError(t, err)
+	s2, err := open("../data/checker/counterexamples_revision.yaml")
+	require.NoError(t, err)
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(diff.NewConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibility(counterexamplesConfig(), d, osm)
+	require.Contains(t, errs, checker.ApiChange{
+		Id:          checker.RequestBodyCounterexampleFoundId,
+		Args:        []any{"application/json", `value doesn't satisfy "not"`},
+		Level:       checker.ERR,
+		Operation:   "POST",
+		Path:        "/pets",
+		Source:      load.NewSource("../data/checker/counterexamples_revision.yaml"),
+		OperationId: "createPet",
+		CommonChange: checker.CommonChange{
+			Attributes: map[string]any{
+				checker.CounterexampleAttribute: map[string]any{"legacy": true, "name": "sample"},
+			},
+		},
+	})
+}
+
+// BC: changing a response body schema so that it may return a payload that the base schema rejects is breaking
+func TestResponseBodyCounterexampleFound(t *testing.T) {
+	s1, err := open("../data/checker/counterexamples_base.yaml")
+	require.NoError(t, err)
+	s2, err := open("../data/checker/counterexamples_revision.yaml")
+	require.NoError(t, err)
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(diff.NewConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibility(counterexamplesConfig(), d, osm)
+	require.Contains(t, errs, checker.ApiChange{
+		Id:          checker.ResponseBodyCounterexampleFoundId,
+		Args:        []any{"application/json", "200", "/id: value must be an integer"},
+		Level:       checker.ERR,
+		Operation:   "POST",
+		Path:        "/pets",
+		Source:      load.NewSource("../data/checker/counterexamples_revision.yaml"),
+		OperationId: "createPet",
+		CommonChange: checker.CommonChange{
+			Attributes: map[string]any{
+				checker.CounterexampleAttribute: map[string]any{"id": "sample"},
+			},
+		},
+	})
+}
+
+// BC: a schema change that accepts all the generated payloads is not breaking
+func TestCounterexamples_NotFound(t *testing.T) {
+	s1, err := open("../data/checker/counterexamples_base.yaml")
+	require.NoError(t, err)
+	s2, err := open("../data/checker/counterexamples_base.yaml")
+	require.NoError(t, err)
+
+	s2.Spec.Paths.Value("/pets").Post.RequestBody.Value.Content["application/json"].Schema.Value.Properties["name"].Value.Description = "the pet's name"
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(diff.NewConfig(), s1, s2)
+	require.NoError(t, err)
+	require.Empty(t, checker.CheckBackwardCompatibility(counterexamplesConfig(), d, osm))
+}
+
+// the counterexamples check is disabled unless it is included explicitly
+func TestCounterexamples_DisabledByDefault(t *testing.T) {
+	s1, err := open("../data/checker/counterexamples_base.yaml")
+	require.NoError(t, err)
+	s2, err := open("../data/checker/counterexamples_revision.yaml")
+	require.NoError(t, err)
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(diff.NewConfig(), s1, s2)
+	require.NoError(t, err)
+	require.Empty(t, checker.CheckBackwardCompatibilityUntilLevel(singleCheckConfig(checker.CounterexamplesCheck), d, osm, checker.INFO))
+}
+
+// the counterexamples check is disabled when its included rules are set to NONE
+func TestCounterexamples_DisabledByLevel(t *testing.T) {
+	s1, err := open("../data/checker/counterexamples_base.yaml")
+	require.NoError(t, err)
+	s2, err := open("../data/checker/counterexamples_revision.yaml")
+	require.NoError(t, err)
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(diff.NewConfig(), s1, s2)
+	require.NoError(t, err)
+	config := counterexamplesConfig().WithSeverityLevels(map[string]checker.Level{
+		checker.RequestBodyCounterexampleFoundId:  checker.NONE,
+		checker.ResponseBodyCounterexampleFoundId: checker.NONE,
+	})
+	require.Empty(t, checker.CheckBackwardCompatibilityUntilLevel(config, d, osm, checker.INFO))
+}
+
+// BC: changing a oneOf subschema so that it rejects a payload generated from the base schema is breaking
+func TestRequestBodyCounterexampleFound_OneOf(t *testing.T) {
+	s1, err := open("../data/checker/counterexamples_one_of_base.yaml")
+	require.NoError(t, err)
+	s2, err := open("../data/checker/counterexamples_one_of_revision.yaml")
+	require.NoError(t, err)
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(diff.NewConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibility(counterexamplesConfig(), d, osm)
+	require.Len(t, errs, 1)
+	require.Equal(t, checker.RequestBodyCounterexampleFoundId, errs[0].GetId())
+	require.Equal(t, map[string]any{"bark": false, "kind": "dog"}, errs[0].GetAttributes()[checker.CounterexampleAttribute])
+}
+
+// BC: the counterexample generator terminates on self-referencing schemas
+func TestCounterexamples_Recursive(t *testing.T) {
+	s1, err := open("../data/checker/counterexamples_recursive_base.yaml")
+	require.NoError(t, err)
+	s2, err := open("../data/checker/counterexamples_recursive_revision.yaml")
+	require.NoError(t, err)
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(diff.NewConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibility(counterexamplesConfig(), d, osm)
+	require.NotEmpty(t, errs)
+	require.Equal(t, checker.RequestBodyCounterexampleFoundId, errs[0].GetId())
+}
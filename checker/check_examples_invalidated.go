@@ -0,0 +1,138 @@
+package checker
+
+import (
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oasdiff/oasdiff/diff"
+	"github.com/oasdiff/oasdiff/utils"
+)
+
+const (
+	RequestParameterExampleInvalidatedId = "request-parameter-example-invalidated"
+	RequestBodyExampleInvalidatedId      = "request-body-example-invalidated"
+	ResponseBodyExampleInvalidatedId     = "response-body-example-invalidated"
+)
+
+// ExamplesInvalidatedCheck reports base examples that were valid against the base schema but are no longer valid against the revision schema
+func ExamplesInvalidatedCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
+	result := make(Changes, 0)
+	if diffReport.PathsDiff == nil {
+		return result
+	}
+
+	for path, pathItem := range diffReport.PathsDiff.Modified {
+		if pathItem.OperationsDiff == nil {
+			continue
+		}
+		for operation, operationItem := range pathItem.OperationsDiff.Modified {
+
+			if operationItem.ParametersDiff != nil {
+				for paramLocation, paramDiffs := range operationItem.ParametersDiff.Modified {
+					for paramName, paramDiff := range paramDiffs {
+						if paramDiff.Base == nil || paramDiff.Revision == nil {
+							continue
+						}
+						for _, invalidated := range getInvalidatedExamples(
+							utils.GetExamples(paramDiff.Base.Example, paramDiff.Base.Examples),
+							utils.GetParameterSchema(paramDiff.Base),
+							utils.GetParameterSchema(paramDiff.Revision),
+							openapi3.VisitAsRequest()) {
+							result = append(result, NewApiChange(
+								RequestParameterExampleInvalidatedId,
+								config,
+								[]any{invalidated.name, paramLocation, paramName, invalidated.reason},
+								"",
+								operationsSources,
+								operationItem.Revision,
+								operation,
+								path,
+							))
+						}
+					}
+				}
+			}
+
+			if operationItem.RequestBodyDiff != nil &&
+				operationItem.RequestBodyDiff.ContentDiff != nil &&
+				operationItem.Base.RequestBody != nil && operationItem.Base.RequestBody.Value != nil &&
+				operationItem.Revision.RequestBody != nil && operationItem.Revision.RequestBody.Value != nil {
+				for mediaType := range operationItem.RequestBodyDiff.ContentDiff.MediaTypeModified {
+					for _, invalidated := range getInvalidatedMediaTypeExamples(
+						operationItem.Base.RequestBody.Value.Content.Get(mediaType),
+						operationItem.Revision.RequestBody.Value.Content.Get(mediaType),
+						openapi3.VisitAsRequest()) {
+						result = append(result, NewApiChange(
+							RequestBodyExampleInvalidatedId,
+							config,
+							[]any{invalidated.name, mediaType, invalidated.reason},
+							"",
+							operationsSources,
+							operationItem.Revision,
+							operation,
+							path,
+						))
+					}
+				}
+			}
+
+			if operationItem.ResponsesDiff != nil {
+				for status, responseDiff := range operationItem.ResponsesDiff.Modified {
+					if responseDiff.ContentDiff == nil || responseDiff.Base == nil || responseDiff.Revision == nil {
+						continue
+					}
+					for mediaType := range responseDiff.ContentDiff.MediaTypeModified {
+						for _, invalidated := range getInvalidatedMediaTypeExamples(
+							responseDiff.Base.Content.Get(mediaType),
+							responseDiff.Revision.Content.Get(mediaType),
+							openapi3.VisitAsResponse()) {
+							result = append(result, NewApiChange(
+								ResponseBodyExampleInvalidatedId,
+								config,
+								[]any{invalidated.name, mediaType, status, invalidated.reason},
+								"",
+								operationsSources,
+								operationItem.Revision,
+								operation,
+								path,
+							))
+						}
+					}
+				}
+			}
+		}
+	}
+	return result
+}
+
+type invalidatedExample struct {
+	name   string
+	reason string
+}
+
+func getInvalidatedMediaTypeExamples(base, revision *openapi3.MediaType, opts ...openapi3.SchemaValidationOption) []invalidatedExample {
+	if base == nil || revision == nil || base.Schema == nil || revision.Schema == nil {
+		return nil
+	}
+
+	return getInvalidatedExamples(utils.GetExamples(base.Example, base.Examples), base.Schema.Value, revision.Schema.Value, opts...)
+}
+
+// getInvalidatedExamples returns the examples that are valid against the base schema but invalid against the revision schema
+func getInvalidatedExamples(examples []utils.Example, base, revision *openapi3.Schema, opts ...openapi3.SchemaValidationOption) []invalidatedExample {
+	if base == nil || revision == nil {
+		return nil
+	}
+
+	result := []invalidatedExample{}
+	for _, example := range examples {
+		if base.VisitJSON(example.Value, opts...) != nil {
+			continue
+		}
+		if err := revision.VisitJSON(example.Value, opts...); err != nil {
+			result = append(result, invalidatedExample{
+				name:   example.Name,
+				reason: utils.GetValidationReason(err),
+			})
+		}
+	}
+	return result
+}
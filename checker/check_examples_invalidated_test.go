@@ -0,0 +1,92 @@
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/diff"
+	"github.com/oasdiff/oasdiff/load"
+	"github.com/stretchr/testify/require"
+)
+
+// BC: changing a request parameter schema so that its example no longer matches is breaking
+func TestRequestParameterExampleInvalidated(t *testing.T) {
+	s1, err := open("../data/checker/examples_invalidated_base.yaml")
+	require.NoError(t, err)
+	s2, err := open("../data/checker/examples_invalidated_revision.yaml")
+	require.NoError(t, err)
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(diff.NewConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibility(singleCheckConfig(checker.ExamplesInvalidatedCheck), d, osm)
+	require.Contains(t, errs, checker.ApiChange{
+		Id:          checker.RequestParameterExampleInvalidatedId,
+		Args:        []any{"example", "query", "limit", "number must be at most 20"},
+		Level:       checker.ERR,
+		Operation:   "GET",
+		Path:        "/pets",
+		Source:      load.NewSource("../data/checker/examples_invalidated_revision.yaml"),
+		OperationId: "listPets",
+	})
+}
+
+// BC: changing a request body schema so that its example no longer matches is breaking
+func TestRequestBodyExampleInvalidated(t *testing.T) {
+	s1, err := open("../data/checker/examples_invalidated_base.yaml")
+	require.NoError(t, err)
+	s2, err := open("../data/checker/examples_invalidated_revision.yaml")
+	require.NoError(t, err)
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(diff.NewConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibility(singleCheckConfig(checker.ExamplesInvalidatedCheck), d, osm)
+	require.Contains(t, errs, checker.ApiChange{
+		Id:          checker.RequestBodyExampleInvalidatedId,
+		Args:        []any{"example", "application/json", "/tag: property \"tag\" is missing"},
+		Level:       checker.ERR,
+		Operation:   "POST",
+		Path:        "/pets",
+		Source:      load.NewSource("../data/checker/examples_invalidated_revision.yaml"),
+		OperationId: "createPet",
+	})
+}
+
+// BC: changing a response body schema so that its example no longer matches is breaking with warning
+func TestResponseBodyExampleInvalidated(t *testing.T) {
+	s1, err := open("../data/checker/examples_invalidated_base.yaml")
+	require.NoError(t, err)
+	s2, err := open("../data/checker/examples_invalidated_revision.yaml")
+	require.NoError(t, err)
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(diff.NewConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibility(singleCheckConfig(checker.ExamplesInvalidatedCheck), d, osm)
+	require.Contains(t, errs, checker.ApiChange{
+		Id:          checker.ResponseBodyExampleInvalidatedId,
+		Args:        []any{"pet", "application/json", "200", "/id: value must be a string"},
+		Level:       checker.WARN,
+		Operation:   "GET",
+		Path:        "/pets",
+		Source:      load.NewSource("../data/checker/examples_invalidated_revision.yaml"),
+		OperationId: "listPets",
+	})
+}
+
+// BC: changing a schema in a way that keeps the examples valid is not breaking
+func TestExamplesInvalidated_StillValid(t *testing.T) {
+	s1, err := open("../data/checker/examples_invalidated_base.yaml")
+	require.NoError(t, err)
+	s2, err := open("../data/checker/examples_invalidated_base.yaml")
+	require.NoError(t, err)
+
+	s2.Spec.Paths.Value("/pets").Get.Parameters[0].Value.Schema.Value.Max = ptrFloat(100)
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(diff.NewConfig(), s1, s2)
+	require.NoError(t, err)
+	errs := checker.CheckBackwardCompatibility(singleCheckConfig(checker.ExamplesInvalidatedCheck), d, osm)
+	require.Empty(t, errs)
+}
+
+func ptrFloat(f float64) *float64 {
+	return &f
+}
@@ -1,6 +1,10 @@
 package checker
 
-import "log"
+import (
+	"log"
+
+	"github.com/oasdiff/oasdiff/utils"
+)
 
 type Config struct {
 	Checks              BackwardCompatibilityChecks
@@ -8,6 +12,8 @@ type Config struct {
 	MinSunsetStableDays uint
 	LogLevels           map[string]Level
 	Attributes          []string
+	CustomRules         CustomRules
+	IncludedChecks      utils.StringSet // optional checks included with WithOptionalChecks
 }
 
 const (
@@ -32,8 +38,12 @@ func (config *Config) WithOptionalCheck(id string) *Config {
 
 // WithOptionalChecks overrides the log level of the given checks to ERR so they will appear in `oasdiff breaking`
 func (config *Config) WithOptionalChecks(ids []string) *Config {
+	if config.IncludedChecks == nil {
+		config.IncludedChecks = utils.StringSet{}
+	}
 	for _, id := range ids {
 		config.setLogLevel(id, ERR)
+		config.IncludedChecks.Add(id)
 	}
 	return config
 }
@@ -46,6 +56,21 @@ func (config *Config) WithSeverityLevels(severityLevels map[string]Level) *Confi
 	return config
 }
 
+// WithCustomRules adds user-defined rules to the checks.
+// Call it before WithSeverityLevels so that the severity levels can override the levels of the custom rules.
+func (config *Config) WithCustomRules(rules CustomRules) *Config {
+	if len(rules) == 0 {
+		return config
+	}
+
+	for _, rule := range rules {
+		config.LogLevels[rule.Id] = rule.Level
+	}
+	config.CustomRules = rules
+	config.Checks = append(config.Checks, CustomRulesCheck)
+	return config
+}
+
 // WithDeprecation sets the number of days before sunset for deprecation warnings.
 func (config *Config) WithDeprecation(deprecationDaysBeta uint, deprecationDaysStable uint) *Config {
 	config.MinSunsetBetaDays = deprecationDaysBeta
@@ -70,6 +95,11 @@ func (config *Config) WithAttributes(attributes []string) *Config {
 	return config
 }
 
+// isIncluded returns true if the optional check was included with WithOptionalChecks and its level wasn't set to NONE
+func (config *Config) isIncluded(checkId string) bool {
+	return config.IncludedChecks.Contains(checkId) && config.getLogLevel(checkId) != NONE
+}
+
 func (config *Config) getLogLevel(checkId string) Level {
 	level, ok := config.LogLevels[checkId]
 
@@ -8,8 +8,8 @@ import (
 )
 
 const (
-	numOfChecks = 98
-	numOfIds    = 289
+	numOfChecks = 101
+	numOfIds    = 295
 )
 
 func TestNewConfig(t *testing.T) {
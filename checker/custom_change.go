@@ -0,0 +1,171 @@
+package checker
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/TwiN/go-color"
+	"github.com/oasdiff/oasdiff/load"
+)
+
+// CustomChange represents a change that was reported by a user-defined rule, a plugin or a lint check
+// Unlike the built-in changes, its text is taken from the rule rather than from the localizations
+type CustomChange struct {
+	CommonChange
+
+	Id          string
+	Text        string
+	Comment     string
+	Args        []any
+	Level       Level
+	Operation   string
+	OperationId string
+	Path        string
+	Source      *load.Source
+	Section     string // the top-level section of the spec, like info or components, the default is paths
+
+	SourceFile      string
+	SourceLine      int
+	SourceLineEnd   int
+	SourceColumn    int
+	SourceColumnEnd int
+}
+
+func (c CustomChange) GetSection() string {
+	if c.Section != "" {
+		return c.Section
+	}
+	return "paths"
+}
+
+func (c CustomChange) IsBreaking() bool {
+	return c.GetLevel().IsBreaking()
+}
+
+func (c CustomChange) MatchIgnore(ignorePath, ignoreLine string, l Localizer) bool {
+	if ignorePath == "" {
+		return false
+	}
+
+	return ignorePath == strings.ToLower(c.Path) &&
+		strings.Contains(ignoreLine, strings.ToLower(c.Operation+" "+c.Path)) &&
+		strings.Contains(ignoreLine, strings.ToLower(c.GetUncolorizedText(l)))
+}
+
+func (c CustomChange) GetId() string {
+	return c.Id
+}
+
+func (c CustomChange) GetText(l Localizer) string {
+	return c.Text
+}
+
+func (c CustomChange) GetArgs() []any {
+	return c.Args
+}
+
+func (c CustomChange) GetUncolorizedText(l Localizer) string {
+	return c.Text
+}
+
+func (c CustomChange) GetComment(l Localizer) string {
+	return c.Comment
+}
+
+func (c CustomChange) GetLevel() Level {
+	return c.Level
+}
+
+func (c CustomChange) GetOperation() string {
+	return c.Operation
+}
+
+func (c CustomChange) GetOperationId() string {
+	return c.OperationId
+}
+
+func (c CustomChange) GetPath() string {
+	return c.Path
+}
+
+func (c CustomChange) GetSource() string {
+	return c.Source.String()
+}
+
+func (c CustomChange) GetSourceFile() string {
+	if c.SourceFile != "" {
+		return c.SourceFile
+	}
+
+	// Source is nil when the operation sources aren't loaded
+	if c.Source != nil && c.Source.IsFile() {
+		return c.Source.String()
+	}
+
+	return ""
+}
+
+func (c CustomChange) GetSourceLine() int {
+	return c.SourceLine
+}
+
+func (c CustomChange) GetSourceLineEnd() int {
+	return c.SourceLineEnd
+}
+
+func (c CustomChange) GetSourceColumn() int {
+	return c.SourceColumn
+}
+
+func (c CustomChange) GetSourceColumnEnd() int {
+	return c.SourceColumnEnd
+}
+
+func (c CustomChange) SingleLineError(l Localizer, colorMode ColorMode) string {
+	if c.Path == "" {
+		return c.singleLineErrorWithoutPath(l, colorMode)
+	}
+
+	const format = "%s %s %s, %s API %s %s %s [%s]."
+
+	if isColorEnabled(colorMode) {
+		return fmt.Sprintf(format, c.Level.PrettyString(), l("at"), c.GetSource(), l("in"), color.InGreen(c.Operation), color.InGreen(c.Path), c.Text, color.InYellow(c.Id))
+	}
+
+	return fmt.Sprintf(format, c.Level.String(), l("at"), c.GetSource(), l("in"), c.Operation, c.Path, c.Text, c.Id)
+}
+
+func (c CustomChange) MultiLineError(l Localizer, colorMode ColorMode) string {
+	if c.Path == "" {
+		return c.multiLineErrorWithoutPath(l, colorMode)
+	}
+
+	const format = "%s\t[%s] %s %s\t\n\t%s API %s %s\n\t\t%s"
+
+	if isColorEnabled(colorMode) {
+		return fmt.Sprintf(format, c.Level.PrettyString(), color.InYellow(c.Id), l("at"), c.GetSource(), l("in"), color.InGreen(c.Operation), color.InGreen(c.Path), c.Text)
+	}
+
+	return fmt.Sprintf(format, c.Level.String(), c.Id, l("at"), c.GetSource(), l("in"), c.Operation, c.Path, c.Text)
+}
+
+// singleLineErrorWithoutPath formats changes that don't refer to an endpoint, like lint errors outside of the paths
+func (c CustomChange) singleLineErrorWithoutPath(l Localizer, colorMode ColorMode) string {
+	const format = "%s %s %s, %s [%s]."
+
+	if isColorEnabled(colorMode) {
+		return fmt.Sprintf(format, c.Level.PrettyString(), l("at"), c.GetSource(), c.Text, color.InYellow(c.Id))
+	}
+
+	return fmt.Sprintf(format, c.Level.String(), l("at"), c.GetSource(), c.Text, c.Id)
+}
+
+func (c CustomChange) multiLineErrorWithoutPath(l Localizer, colorMode ColorMode) string {
+	const format = "%s\t[%s] %s %s\t\n\t\t%s"
+
+	if isColorEnabled(colorMode) {
+		return fmt.Sprintf(format, c.Level.PrettyString(), color.InYellow(c.Id), l("at"), c.GetSource(), c.Text)
+	}
+
+	return fmt.Sprintf(format, c.Level.String(), c.Id, l("at"), c.GetSource(), c.Text)
+}
@@ -0,0 +1,350 @@
+package checker
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oasdiff/oasdiff/diff"
+	"github.com/oasdiff/oasdiff/load"
+	"github.com/oasdiff/oasdiff/utils"
+	"gopkg.in/yaml.v3"
+)
+
+// CustomRule is a user-defined rule that reports a change with its own id, level and message when an endpoint change matches its criteria
+type CustomRule struct {
+	Id      string
+	Level   Level
+	Message string
+	Match   CustomRuleMatch
+}
+
+type CustomRules []CustomRule
+
+// CustomRuleMatch describes the endpoint changes that a custom rule reports
+// Empty criteria match all endpoints
+type CustomRuleMatch struct {
+	// Paths are path patterns where '*' matches a single path segment and '**' matches any number of segments, for example /v1/**
+	Paths []string `yaml:"paths"`
+	// Methods are HTTP methods, case insensitive
+	Methods []string `yaml:"methods"`
+	// Tags match endpoints that have one of these tags in the base or in the revision
+	Tags []string `yaml:"tags"`
+	// Location is a dot-separated pattern matched against the endpoint diff as it appears in `oasdiff diff -f json`, for example responses.modified.*.content.modified.*.schema.**.properties.added
+	// When empty, any change to the endpoint matches, including adding or deleting it
+	Location string `yaml:"location"`
+}
+
+type customRulesFile struct {
+	Rules []customRuleEntry `yaml:"rules"`
+}
+
+type customRuleEntry struct {
+	Id      string          `yaml:"id"`
+	Level   string          `yaml:"level"`
+	Message string          `yaml:"message"`
+	Match   CustomRuleMatch `yaml:"match"`
+}
+
+// LoadCustomRules reads custom rules from a YAML file
+func LoadCustomRules(file string) (CustomRules, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseCustomRules(data)
+}
+
+// ParseCustomRules parses custom rules from YAML
+func ParseCustomRules(data []byte) (CustomRules, error) {
+	var file customRulesFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, err
+	}
+
+	builtInIds := utils.StringList(GetAllRuleIds()).ToStringSet()
+	ids := utils.StringSet{}
+	result := make(CustomRules, 0, len(file.Rules))
+	for i, entry := range file.Rules {
+		if entry.Id == "" {
+			return nil, fmt.Errorf("rule #%d has no id", i+1)
+		}
+		if builtInIds.Contains(entry.Id) {
+			return nil, fmt.Errorf("rule id %q conflicts with a built-in rule", entry.Id)
+		}
+		if ids.Contains(entry.Id) {
+			return nil, fmt.Errorf("duplicate rule id %q", entry.Id)
+		}
+		ids.Add(entry.Id)
+
+		level, err := NewLevel(entry.Level)
+		if err != nil {
+			return nil, fmt.Errorf("invalid level %q in rule %q", entry.Level, entry.Id)
+		}
+
+		result = append(result, CustomRule{
+			Id:      entry.Id,
+			Level:   level,
+			Message: entry.Message,
+			Match:   entry.Match,
+		})
+	}
+
+	return result, nil
+}
+
+// GetIds returns the ids of the custom rules
+func (rules CustomRules) GetIds() []string {
+	result := make([]string, len(rules))
+	for i, rule := range rules {
+		result[i] = rule.Id
+	}
+	return result
+}
+
+// CustomRulesCheck evaluates the custom rules of the config
+func CustomRulesCheck(diffReport *diff.Diff, operationsSources *diff.OperationsSourcesMap, config *Config) Changes {
+	result := make(Changes, 0)
+	if diffReport.PathsDiff == nil || len(config.CustomRules) == 0 {
+		return result
+	}
+
+	for _, endpoint := range getChangedEndpoints(diffReport.PathsDiff) {
+		for _, rule := range config.CustomRules {
+			for _, match := range rule.Match.match(endpoint) {
+				result = append(result, newCustomChange(config, rule, endpoint, match, operationsSources))
+			}
+		}
+	}
+
+	return result
+}
+
+type changedEndpoint struct {
+	path      string
+	method    string
+	base      *openapi3.Operation
+	revision  *openapi3.Operation
+	diff      *diff.MethodDiff
+	operation *openapi3.Operation // the revision operation, or the base operation if the endpoint was deleted
+}
+
+func getChangedEndpoints(pathsDiff *diff.PathsDiff) []changedEndpoint {
+	result := []changedEndpoint{}
+
+	addAll := func(path string, pathItem *openapi3.PathItem, isBase bool) {
+		if pathItem == nil {
+			return
+		}
+		for method, operation := range pathItem.Operations() {
+			endpoint := changedEndpoint{path: path, method: method, operation: operation}
+			if isBase {
+				endpoint.base = operation
+			} else {
+				endpoint.revision = operation
+			}
+			result = append(result, endpoint)
+		}
+	}
+
+	for _, path := range pathsDiff.Added {
+		addAll(path, pathsDiff.Revision.Value(path), false)
+	}
+	for _, path := range pathsDiff.Deleted {
+		addAll(path, pathsDiff.Base.Value(path), true)
+	}
+	for path, pathDiff := range pathsDiff.Modified {
+		if pathDiff.OperationsDiff == nil {
+			continue
+		}
+		for _, method := range pathDiff.OperationsDiff.Added {
+			result = append(result, changedEndpoint{path: path, method: method, revision: pathDiff.Revision.GetOperation(method), operation: pathDiff.Revision.GetOperation(method)})
+		}
+		for _, method := range pathDiff.OperationsDiff.Deleted {
+			result = append(result, changedEndpoint{path: path, method: method, base: pathDiff.Base.GetOperation(method), operation: pathDiff.Base.GetOperation(method)})
+		}
+		for method, methodDiff := range pathDiff.OperationsDiff.Modified {
+			result = append(result, changedEndpoint{path: path, method: method, base: methodDiff.Base, revision: methodDiff.Revision, diff: methodDiff, operation: methodDiff.Revision})
+		}
+	}
+
+	return result
+}
+
+// customRuleMatch is a single match of a custom rule: the matched location in the endpoint diff and its value
+type customRuleMatch struct {
+	location string
+	value    string
+}
+
+func (match CustomRuleMatch) match(endpoint changedEndpoint) []customRuleMatch {
+	if len(match.Paths) > 0 && !slices.ContainsFunc(match.Paths, func(pattern string) bool {
+		return matchSegments(splitPattern(pattern, "/"), splitPattern(endpoint.path, "/"))
+	}) {
+		return nil
+	}
+
+	if len(match.Methods) > 0 && !slices.ContainsFunc(match.Methods, func(method string) bool {
+		return strings.EqualFold(method, endpoint.method)
+	}) {
+		return nil
+	}
+
+	if len(match.Tags) > 0 && !slices.ContainsFunc(match.Tags, func(tag string) bool {
+		return hasTag(endpoint.base, tag) || hasTag(endpoint.revision, tag)
+	}) {
+		return nil
+	}
+
+	if match.Location == "" {
+		return []customRuleMatch{{}}
+	}
+
+	if endpoint.diff == nil {
+		return nil
+	}
+
+	return matchLocation(splitPattern(match.Location, "."), endpoint.diff)
+}
+
+func hasTag(operation *openapi3.Operation, tag string) bool {
+	return operation != nil && slices.Contains(operation.Tags, tag)
+}
+
+// matchLocation matches the location pattern against the JSON representation of the endpoint diff
+func matchLocation(pattern []string, methodDiff *diff.MethodDiff) []customRuleMatch {
+	data, err := json.Marshal(methodDiff)
+	if err != nil {
+		return nil
+	}
+
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return nil
+	}
+
+	result := []customRuleMatch{}
+	walkLocation(pattern, []string{}, value, &result)
+	return result
+}
+
+func walkLocation(pattern, location []string, value any, result *[]customRuleMatch) {
+	if len(location) > 0 && matchSegments(pattern, location) {
+		*result = append(*result, getLocationMatches(location, value)...)
+		return
+	}
+
+	switch value := value.(type) {
+	case map[string]any:
+		keys := make([]string, 0, len(value))
+		for key := range value {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			walkLocation(pattern, append(slices.Clone(location), key), value[key], result)
+		}
+	case []any:
+		for i, item := range value {
+			walkLocation(pattern, append(slices.Clone(location), fmt.Sprint(i)), item, result)
+		}
+	}
+}
+
+// getLocationMatches returns a match for each item of a matched list, or a single match named after the matched key
+func getLocationMatches(location []string, value any) []customRuleMatch {
+	path := strings.Join(location, ".")
+
+	if items, ok := value.([]any); ok {
+		result := make([]customRuleMatch, 0, len(items))
+		for _, item := range items {
+			if _, ok := item.(map[string]any); ok {
+				continue
+			}
+			result = append(result, customRuleMatch{location: path, value: fmt.Sprint(item)})
+		}
+		return result
+	}
+
+	return []customRuleMatch{{location: path, value: location[len(location)-1]}}
+}
+
+func splitPattern(pattern, separator string) []string {
+	result := []string{}
+	for _, segment := range strings.Split(pattern, separator) {
+		if segment != "" {
+			result = append(result, segment)
+		}
+	}
+	return result
+}
+
+// matchSegments matches segments against a pattern where '*' matches a single segment and '**' matches any number of segments
+func matchSegments(pattern, segments []string) bool {
+	if len(pattern) == 0 {
+		return len(segments) == 0
+	}
+
+	if pattern[0] == "**" {
+		for i := 0; i <= len(segments); i++ {
+			if matchSegments(pattern[1:], segments[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+
+	if len(segments) == 0 {
+		return false
+	}
+
+	if pattern[0] != "*" && pattern[0] != segments[0] {
+		return false
+	}
+
+	return matchSegments(pattern[1:], segments[1:])
+}
+
+func newCustomChange(config *Config, rule CustomRule, endpoint changedEndpoint, match customRuleMatch, operationsSources *diff.OperationsSourcesMap) CustomChange {
+	message := rule.Message
+	if message == "" {
+		message = rule.Id
+	}
+
+	text := strings.NewReplacer(
+		"{path}", endpoint.path,
+		"{method}", endpoint.method,
+		"{location}", match.location,
+		"{value}", match.value,
+	).Replace(message)
+
+	var opId string
+	var src *load.Source
+	var attrs map[string]any
+	if endpoint.operation != nil {
+		opId = endpoint.operation.OperationID
+		if operationsSources != nil {
+			src = load.NewSource((*operationsSources)[endpoint.operation])
+		}
+		attrs = getAttributes(config, endpoint.operation)
+	}
+
+	return CustomChange{
+		Id:          rule.Id,
+		Text:        text,
+		Args:        []any{match.location, match.value},
+		Level:       config.getLogLevel(rule.Id),
+		Operation:   endpoint.method,
+		OperationId: opId,
+		Path:        endpoint.path,
+		Source:      src,
+		CommonChange: CommonChange{
+			Attributes: attrs,
+		},
+	}
+}
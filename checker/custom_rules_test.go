@@ -0,0 +1,121 @@
+package checker_test
+
+import (
+	"testing"
+
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/diff"
+	"github.com/oasdiff/oasdiff/load"
+	"github.com/stretchr/testify/require"
+)
+
+func getCustomRulesChanges(t *testing.T, rules checker.CustomRules) checker.Changes {
+	t.Helper()
+
+	s1, err := open("../data/custom-rules/base.yaml")
+	require.NoError(t, err)
+	s2, err := open("../data/custom-rules/revision.yaml")
+	require.NoError(t, err)
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(diff.NewConfig(), s1, s2)
+	require.NoError(t, err)
+	return checker.CheckBackwardCompatibilityUntilLevel(checker.NewConfig(nil).WithCustomRules(rules), d, osm, checker.INFO)
+}
+
+func TestCustomRules_Load(t *testing.T) {
+	rules, err := checker.LoadCustomRules("../data/custom-rules/rules.yaml")
+	require.NoError(t, err)
+	require.Equal(t, []string{"v1-response-property-added", "internal-endpoint-changed"}, rules.GetIds())
+	require.Equal(t, checker.WARN, rules[0].Level)
+	require.Equal(t, []string{"/v1/**"}, rules[0].Match.Paths)
+}
+
+func TestCustomRules_LoadMissingFile(t *testing.T) {
+	_, err := checker.LoadCustomRules("../data/custom-rules/missing.yaml")
+	require.Error(t, err)
+}
+
+func TestCustomRules_ConflictWithBuiltInRule(t *testing.T) {
+	_, err := checker.LoadCustomRules("../data/custom-rules/invalid-rules.yaml")
+	require.EqualError(t, err, `rule id "response-success-status-removed" conflicts with a built-in rule`)
+}
+
+func TestCustomRules_InvalidLevel(t *testing.T) {
+	_, err := checker.ParseCustomRules([]byte("rules:\n  - id: my-rule\n    level: critical\n"))
+	require.EqualError(t, err, `invalid level "critical" in rule "my-rule"`)
+}
+
+func TestCustomRules_DuplicateId(t *testing.T) {
+	_, err := checker.ParseCustomRules([]byte("rules:\n  - id: my-rule\n    level: err\n  - id: my-rule\n    level: warn\n"))
+	require.EqualError(t, err, `duplicate rule id "my-rule"`)
+}
+
+func TestCustomRules_Location(t *testing.T) {
+	rules, err := checker.LoadCustomRules("../data/custom-rules/rules.yaml")
+	require.NoError(t, err)
+
+	errs := getCustomRulesChanges(t, rules[:1])
+	require.Equal(t, checker.Changes{
+		checker.CustomChange{
+			Id:          "v1-response-property-added",
+			Text:        "added the response property age to a frozen v1 endpoint",
+			Args:        []any{"responses.modified.200.content.modified.application/json.schema.properties.added", "age"},
+			Level:       checker.WARN,
+			Operation:   "GET",
+			OperationId: "listPetsV1",
+			Path:        "/v1/pets",
+			Source:      load.NewSource("../data/custom-rules/revision.yaml"),
+		},
+	}, errs)
+}
+
+func TestCustomRules_Tags(t *testing.T) {
+	rules, err := checker.LoadCustomRules("../data/custom-rules/rules.yaml")
+	require.NoError(t, err)
+
+	errs := getCustomRulesChanges(t, rules[1:])
+	require.Len(t, errs, 2)
+	require.Equal(t, "internal endpoint GET /health changed", errs[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+	require.Equal(t, "internal endpoint GET /metrics changed", errs[1].GetUncolorizedText(checker.NewDefaultLocalizer()))
+	require.Equal(t, "../data/custom-rules/base.yaml", errs[1].GetSource())
+}
+
+func TestCustomRules_Methods(t *testing.T) {
+	errs := getCustomRulesChanges(t, checker.CustomRules{
+		{
+			Id:    "post-changed",
+			Level: checker.ERR,
+			Match: checker.CustomRuleMatch{Methods: []string{"post"}},
+		},
+	})
+	require.Empty(t, errs)
+}
+
+func TestCustomRules_SeverityLevels(t *testing.T) {
+	rules, err := checker.LoadCustomRules("../data/custom-rules/rules.yaml")
+	require.NoError(t, err)
+
+	levels, err := checker.ProcessSeverityLevels("../data/custom-rules/severity-levels.txt", rules.GetIds()...)
+	require.NoError(t, err)
+
+	config := checker.NewConfig(checker.GetAllChecks()).WithCustomRules(rules).WithSeverityLevels(levels)
+	require.Equal(t, checker.ERR, config.LogLevels["v1-response-property-added"])
+	require.Equal(t, checker.INFO, config.LogLevels["internal-endpoint-changed"])
+}
+
+func TestCustomRules_SeverityLevelsUnknownId(t *testing.T) {
+	_, err := checker.ProcessSeverityLevels("../data/custom-rules/severity-levels.txt")
+	require.EqualError(t, err, `invalid rule id "v1-response-property-added" on line 1`)
+}
+
+func TestCustomChange_NoSource(t *testing.T) {
+	change := checker.CustomChange{Id: "my-rule", Text: "my text", Level: checker.WARN, Operation: "GET", Path: "/pets"}
+	require.Empty(t, change.GetSourceFile())
+	require.Empty(t, change.GetSource())
+}
+
+func TestCustomChange_NoPath(t *testing.T) {
+	change := checker.CustomChange{Id: "info-missing", Text: "API is missing an info section", Level: checker.ERR, Source: load.NewSource("openapi.yaml")}
+	require.Equal(t, "error\t[info-missing] at openapi.yaml\t\n\t\tAPI is missing an info section", change.MultiLineError(checker.NewDefaultLocalizer(), checker.ColorNever))
+	require.Equal(t, "error at openapi.yaml, API is missing an info section [info-missing].", change.SingleLineError(checker.NewDefaultLocalizer(), checker.ColorNever))
+}
@@ -64,7 +64,9 @@ func ExampleCheckBackwardCompatibility() {
 	}
 
 	// Output:
-	// 4 breaking changes: 1 error, 3 warning
+	// 5 breaking changes: 2 error, 3 warning
+	// error at ../data/openapi-test3.yaml, in API GET /api/{domain}/{project}/badges/security-score the example '0' of the 'query' request parameter 'image' no longer matches the parameter schema: 'value doesn't satisfy "not"' [request-parameter-example-invalidated].
+	//
 	// error at ../data/openapi-test3.yaml, in API GET /api/{domain}/{project}/badges/security-score removed the success response with the status '201' [response-success-status-removed].
 	//
 	// warning at ../data/openapi-test3.yaml, in API GET /api/{domain}/{project}/badges/security-score deleted the 'cookie' request parameter 'test' [request-parameter-removed]. This is a warning because some apps may return an error when receiving a parameter that they do not expect. It is recommended to deprecate the parameter first.
@@ -15,11 +15,11 @@ func TestIgnore(t *testing.T) {
 	d, osm, err := diff.GetWithOperationsSourcesMap(diff.NewConfig(), s1, s2)
 	require.NoError(t, err)
 	errs := checker.CheckBackwardCompatibility(allChecksConfig(), d, osm)
-	require.Equal(t, 6, len(errs))
+	require.Equal(t, 7, len(errs))
 
 	errs, err = checker.ProcessIgnoredBackwardCompatibilityErrors(checker.ERR, errs, "../data/ignore-err-example.txt", checker.NewDefaultLocalizer())
 	require.NoError(t, err)
-	require.Equal(t, 5, len(errs))
+	require.Equal(t, 6, len(errs))
 }
 
 func TestIgnoreSubpath(t *testing.T) {
@@ -60,9 +60,9 @@ func TestIgnoreComponent(t *testing.T) {
 	d, osm, err := diff.GetWithOperationsSourcesMap(diff.NewConfig(), s1, s2)
 	require.NoError(t, err)
 	errs := checker.CheckBackwardCompatibility(allChecksConfig().WithOptionalCheck(checker.APISchemasRemovedId), d, osm)
-	require.Equal(t, 8, len(errs))
+	require.Equal(t, 9, len(errs))
 
 	errs, err = checker.ProcessIgnoredBackwardCompatibilityErrors(checker.ERR, errs, "../data/ignore-err-example.txt", checker.NewDefaultLocalizer())
 	require.NoError(t, err)
-	require.Equal(t, 5, len(errs))
+	require.Equal(t, 6, len(errs))
 }
@@ -74,22 +74,30 @@ func (level Level) IsBreaking() bool {
 }
 
 // ProcessSeverityLevels reads a file with severity levels and returns a map of severity levels
-func ProcessSeverityLevels(file string) (map[string]Level, error) {
+// customIds are the ids of custom rules which are valid in addition to the built-in rule ids
+func ProcessSeverityLevels(file string, customIds ...string) (map[string]Level, error) {
 	f, err := os.Open(file)
 	if err != nil {
 		return nil, err
 	}
 	defer f.Close()
 
-	return GetSeverityLevels(f)
+	return GetSeverityLevels(f, customIds...)
 }
 
 // GetSeverityLevels reads severity levels from a reader and returns a map of severity levels
-func GetSeverityLevels(source io.Reader) (map[string]Level, error) {
+// customIds are the ids of custom rules which are valid in addition to the built-in rule ids
+func GetSeverityLevels(source io.Reader, customIds ...string) (map[string]Level, error) {
+	return ReadSeverityLevels(source, append(GetAllRuleIds(), customIds...))
+}
+
+// ReadSeverityLevels reads severity levels of the given rule ids from a reader
+// Each line contains a rule id and a level, for example: api-path-removed-without-deprecation info
+func ReadSeverityLevels(source io.Reader, ruleIds []string) (map[string]Level, error) {
 
 	result := map[string]Level{}
 
-	validIds := utils.StringList(GetAllRuleIds()).ToStringSet()
+	validIds := utils.StringList(ruleIds).ToStringSet()
 
 	scanner := bufio.NewScanner(source)
 
@@ -12,6 +12,9 @@ import (
 )
 
 var localizations = map[string]string{
+	"en.messages.ambiguous-endpoints":                                        "endpoints %s %s and %s %s are ambiguous because a request may match both: %s and %s",
+	"en.messages.ambiguous-endpoints-comment":                                "The OpenAPI specification matches concrete paths before templated ones, but routers may resolve such requests differently.",
+	"en.messages.ambiguous-endpoints-description":                            "endpoints may match the same request",
 	"en.messages.api-deprecated-sunset-missing":                              "sunset date is missing for deprecated API",
 	"en.messages.api-deprecated-sunset-missing-description":                  "endpoint deprecated without sunset date",
 	"en.messages.api-deprecated-sunset-parse":                                "failed to parse sunset date: %v",
@@ -80,13 +83,56 @@ var localizations = map[string]string{
 	"en.messages.api-tag-removed":                                            "api tag %s removed",
 	"en.messages.api-tag-removed-description":                                "endpoint tag deleted",
 	"en.messages.at":                                                                  "at",
+	"en.messages.duplicate-endpoint":                                                  "endpoint %s %s is defined more than once, in lines %d and %d",
+	"en.messages.duplicate-endpoint-comment":                                          "Only the last definition is loaded and the others are silently ignored.",
+	"en.messages.duplicate-endpoint-description":                                      "endpoint is defined more than once",
+	"en.messages.duplicate-endpoint-param-names":                                      "endpoints %s %s and %s %s differ only by path parameter names: %s and %s",
+	"en.messages.duplicate-endpoint-param-names-comment":                              "oasdiff matches endpoints regardless of path parameter names and fails to compare duplicate endpoints. Add the x-since-date extension to specify which endpoint is newer, or use the --include-path-params flag to match path parameter names too.",
+	"en.messages.duplicate-endpoint-param-names-description":                          "endpoints differ only by path parameter names",
+	"en.messages.duplicate-path":                                                      "path %q is defined more than once, in lines %d and %d",
+	"en.messages.duplicate-path-comment":                                              "Only the last definition is loaded and the others are silently ignored.",
+	"en.messages.duplicate-path-description":                                          "path is defined more than once",
+	"en.messages.duplicate-property":                                                  "property %q is defined more than once, in lines %d and %d: %s",
+	"en.messages.duplicate-property-comment":                                          "Only the last definition is loaded and the others are silently ignored.",
+	"en.messages.duplicate-property-description":                                      "property is defined more than once",
+	"en.messages.duplicate-required-property":                                         "property %q appears more than once in the required list: %s",
+	"en.messages.duplicate-required-property-description":                             "property appears more than once in the required list",
 	"en.messages.endpoint-added":                                                      "endpoint added",
 	"en.messages.endpoint-added-description":                                          "endpoint added",
 	"en.messages.endpoint-deprecated":                                                 "endpoint deprecated",
 	"en.messages.endpoint-deprecated-description":                                     "endpoint deprecated",
 	"en.messages.endpoint-reactivated":                                                "endpoint reactivated",
 	"en.messages.endpoint-reactivated-description":                                    "endpoint reactivated (deprecation set to false)",
+	"en.messages.extra_required_props":                                                "none-existing properties %v defined as required",
+	"en.messages.extra_required_props-description":                                    "schema requires properties that are not defined",
+	"en.messages.header-default-invalid":                                              "default value %s of the header %q doesn't match its schema: %s: %s",
+	"en.messages.header-default-invalid-description":                                  "header default value doesn't match the schema",
+	"en.messages.header-example-invalid":                                              "example %q of the header %q doesn't match its schema: %s: %s",
+	"en.messages.header-example-invalid-description":                                  "header example doesn't match the schema",
+	"en.messages.header-schema-and-content":                                           "the header %q defines both schema and content, which are mutually exclusive: %s",
+	"en.messages.header-schema-and-content-description":                               "header defines both schema and content",
 	"en.messages.in":                                                                  "in",
+	"en.messages.info-invalid-contact-email":                                          "contact email must be in the format of an email address: %s",
+	"en.messages.info-invalid-contact-email-description":                              "contact email is not a valid email address",
+	"en.messages.info-invalid-contact-url":                                            "contact URL must be in the format of a URL: %s",
+	"en.messages.info-invalid-contact-url-description":                                "contact URL is not a valid URL",
+	"en.messages.info-invalid-license-url":                                            "license URL must be in the format of a URL: %s",
+	"en.messages.info-invalid-license-url-description":                                "license URL is not a valid URL",
+	"en.messages.info-invalid-terms-of-service":                                       "terms of service must be in the format of a URL: %s",
+	"en.messages.info-invalid-terms-of-service-description":                           "terms of service is not a valid URL",
+	"en.messages.info-missing":                                                        "info is missing",
+	"en.messages.info-missing-comment":                                                "It is a good practice to include general information about your API into the specification. Title and Version fields are required.",
+	"en.messages.info-missing-description":                                            "the info object is missing",
+	"en.messages.info-title-missing":                                                  "the title of the API is missing",
+	"en.messages.info-title-missing-description":                                      "the title of the API is missing",
+	"en.messages.info-version-missing":                                                "the version of the API is missing",
+	"en.messages.info-version-missing-description":                                    "the version of the API is missing",
+	"en.messages.invalid-regex-pattern":                                               "invalid regex pattern: %s",
+	"en.messages.invalid-regex-pattern-description":                                   "schema pattern is not a valid regular expression",
+	"en.messages.json-schema-dialect-invalid":                                         "jsonSchemaDialect must be in the format of a URI: %s: %s",
+	"en.messages.json-schema-dialect-invalid-description":                             "jsonSchemaDialect is not a valid URI",
+	"en.messages.media-type-example-invalid":                                          "example %q of the media type %q doesn't match its schema: %s: %s",
+	"en.messages.media-type-example-invalid-description":                              "media type example doesn't match the schema",
 	"en.messages.new-optional-request-default-parameter-to-existing-path":             "added the new optional %s request parameter %s to all path's operations",
 	"en.messages.new-optional-request-default-parameter-to-existing-path-description": "optional request parameter added at path level",
 	"en.messages.new-optional-request-parameter":                                      "added the new optional %s request parameter %s",
@@ -105,10 +151,31 @@ var localizations = map[string]string{
 	"en.messages.new-required-request-property-description":                           "required property added to request",
 	"en.messages.new-required-request-property-with-default":                          "added the new required request property %s with a default value",
 	"en.messages.new-required-request-property-with-default-description":              "required property with default value added to request",
+	"en.messages.openapi-schema-invalid":                                              "spec doesn't match the OpenAPI %s schema: %s: %s",
+	"en.messages.openapi-schema-invalid-description":                                  "spec doesn't match the OpenAPI JSON Schema",
 	"en.messages.optional-response-header-removed":                                    "the optional response header %s removed for the status %s",
 	"en.messages.optional-response-header-removed-description":                        "optional response header deleted",
+	"en.messages.parameter-default-invalid":                                           "default value %s of the %s parameter %q doesn't match its schema: %s: %s",
+	"en.messages.parameter-default-invalid-description":                               "parameter default value doesn't match the schema",
+	"en.messages.parameter-example-invalid":                                           "example %q of the %s parameter %q doesn't match its schema: %s: %s",
+	"en.messages.parameter-example-invalid-description":                               "parameter example doesn't match the schema",
+	"en.messages.parameter-schema-and-content":                                        "the %s parameter %q defines both schema and content, which are mutually exclusive: %s",
+	"en.messages.parameter-schema-and-content-description":                            "parameter defines both schema and content",
+	"en.messages.path-item-ref-siblings":                                              "path %q defines %s next to its $ref, which has undefined behavior: %s",
+	"en.messages.path-item-ref-siblings-comment":                                      "The OpenAPI specification doesn't define the behavior when a field appears both in a path item and in the path item that it references. Move these fields into the referenced path item.",
+	"en.messages.path-item-ref-siblings-description":                                  "path item defines fields next to its $ref",
+	"en.messages.path-param-duplicate":                                                "path parameter %q is defined both in path and in operation: %s",
+	"en.messages.path-param-duplicate-description":                                    "path parameter is defined both in the path and in the operation",
+	"en.messages.path-param-extra":                                                    "path parameter %q appears in the parameters section of the %s but is missing in the URL: %s",
+	"en.messages.path-param-extra-description":                                        "path parameter is defined but doesn't appear in the URL path",
+	"en.messages.path-param-missing":                                                  "path parameter %q appears in the URL path but is missing from the parameters section of the path and operation: %s",
+	"en.messages.path-param-missing-description":                                      "path parameter appears in the URL path but isn't defined",
+	"en.messages.path-param-not-required":                                             "path parameter %q should have required=true: %s",
+	"en.messages.path-param-not-required-description":                                 "path parameter is not required",
 	"en.messages.pattern-added-error-comment":                                         "This is a breaking change because adding a pattern restriction to a previously unrestricted parameter will reject values that were previously accepted, breaking existing clients",
 	"en.messages.pattern-changed-warn-comment":                                        "This is a warning because adding or changing a pattern may restrict the accepted values and break existing clients. For pattern changes, it is difficult to automatically analyze if the new pattern is a superset of the previous pattern (e.g. changed from '[0-9]+' to '[0-9]*')",
+	"en.messages.property-default-invalid":                                            "default value %s of the property %q doesn't match its schema: %s: %s",
+	"en.messages.property-default-invalid-description":                                "property default value doesn't match the schema",
 	"en.messages.request-body-added-optional":                                         "added optional request body",
 	"en.messages.request-body-added-optional-description":                             "optional request body added",
 	"en.messages.request-body-added-required":                                         "added required request body",
@@ -131,6 +198,8 @@ var localizations = map[string]string{
 	"en.messages.request-body-became-optional-description":                            "request body became optional",
 	"en.messages.request-body-became-required":                                        "request body became required",
 	"en.messages.request-body-became-required-description":                            "request body became required",
+	"en.messages.request-body-counterexample-found":                                   "the %s request body schema rejects a payload that the base schema accepts: %s",
+	"en.messages.request-body-counterexample-found-description":                       "a payload generated from the base request body schema is rejected by the revision schema",
 	"en.messages.request-body-default-value-added":                                    "the request body %s default value %s was added",
 	"en.messages.request-body-default-value-added-description":                        "request body default value set",
 	"en.messages.request-body-default-value-changed":                                  "the request body %s default value changed from %s to %s",
@@ -151,6 +220,8 @@ var localizations = map[string]string{
 	"en.messages.request-body-discriminator-removed-description":                      "request body discriminator deleted",
 	"en.messages.request-body-enum-value-removed":                                     "request body enum value removed %s",
 	"en.messages.request-body-enum-value-removed-description":                         "request body enum value deleted",
+	"en.messages.request-body-example-invalidated":                                    "the example %s of the %s request body no longer matches the request body schema: %s",
+	"en.messages.request-body-example-invalidated-description":                        "request body example no longer matches the schema",
 	"en.messages.request-body-list-of-types-narrowed":                                 "request body list-of-types was narrowed by removing types %s from media type %s",
 	"en.messages.request-body-list-of-types-widened":                                  "request body list-of-types was widened by adding types %s to media type %s",
 	"en.messages.request-body-max-decreased":                                          "the request's body max was decreased to %s",
@@ -228,6 +299,8 @@ var localizations = map[string]string{
 	"en.messages.request-parameter-enum-value-added-description":                      "request parameter enum value added",
 	"en.messages.request-parameter-enum-value-removed":                                "removed the enum value %s from the %s request parameter %s",
 	"en.messages.request-parameter-enum-value-removed-description":                    "request parameter enum value deleted",
+	"en.messages.request-parameter-example-invalidated":                               "the example %s of the %s request parameter %s no longer matches the parameter schema: %s",
+	"en.messages.request-parameter-example-invalidated-description":                   "request parameter example no longer matches the schema",
 	"en.messages.request-parameter-list-of-types-narrowed":                            "%s request parameter %s list-of-types was narrowed by removing types %s",
 	"en.messages.request-parameter-list-of-types-widened":                             "%s request parameter %s list-of-types was widened by adding types %s",
 	"en.messages.request-parameter-max-decreased":                                     "for the %s request parameter %s, the max was decreased from %s to %s",
@@ -410,6 +483,11 @@ var localizations = map[string]string{
 	"en.messages.request-required-property-became-read-only-description":              "request required property became read-only",
 	"en.messages.request-required-property-became-write-only":                         "the request required property %s became write-only",
 	"en.messages.request-required-property-became-write-only-description":             "request required property became write-only",
+	"en.messages.required-param-with-default":                                         "required %s parameter %q shouldn't have a default value: %s",
+	"en.messages.required-param-with-default-description":                             "required parameter has a default value",
+	"en.messages.required-property-with-default":                                      "required property %q shouldn't have a default value: %s",
+	"en.messages.required-property-with-default-comment":                              "The default value is never used because the client must always send a value for a required property.",
+	"en.messages.required-property-with-default-description":                          "required property has a default value",
 	"en.messages.required-response-header-removed":                                    "the mandatory response header %s removed for the status %s",
 	"en.messages.required-response-header-removed-description":                        "required response header removed",
 	"en.messages.response-body-all-of-added":                                          "added %s to the response body 'allOf' list for the response status %s",
@@ -422,6 +500,8 @@ var localizations = map[string]string{
 	"en.messages.response-body-any-of-removed-description":                            "sub-schema removed from anyOf in response body",
 	"en.messages.response-body-became-nullable":                                       "the response's body became nullable",
 	"en.messages.response-body-became-nullable-description":                           "response body became nullable",
+	"en.messages.response-body-counterexample-found":                                  "the %s response body for the status %s may contain a payload that the base schema rejects: %s",
+	"en.messages.response-body-counterexample-found-description":                      "a payload generated from the revision response body schema is rejected by the base schema",
 	"en.messages.response-body-default-value-added":                                   "the response body %s default value %s was added for the status %s",
 	"en.messages.response-body-default-value-added-description":                       "response body default value set",
 	"en.messages.response-body-default-value-changed":                                 "the response body %s default value changed from %s to %s for the status %s",
@@ -440,6 +520,8 @@ var localizations = map[string]string{
 	"en.messages.response-body-discriminator-property-name-changed-description":       "response body discriminator property name changed",
 	"en.messages.response-body-discriminator-removed":                                 "removed response discriminator for the response status %s",
 	"en.messages.response-body-discriminator-removed-description":                     "response body discriminator removed",
+	"en.messages.response-body-example-invalidated":                                   "the example %s of the %s response body for the status %s no longer matches the response schema: %s",
+	"en.messages.response-body-example-invalidated-description":                       "response body example no longer matches the schema",
 	"en.messages.response-body-list-of-types-narrowed":                                "response body list-of-types was narrowed by removing types %s from media type %s of response %s",
 	"en.messages.response-body-list-of-types-widened":                                 "response body list-of-types was widened by adding types %s to media type %s of response %s",
 	"en.messages.response-body-max-increased":                                         "the response's body max was increased from %s to %s",
@@ -585,10 +667,37 @@ var localizations = map[string]string{
 	"en.messages.response-write-only-property-became-required-description":            "response write-only property became required",
 	"en.messages.response-write-only-property-enum-value-added":                       "added the new %s enum value to the %s response write-only property for the response status %s",
 	"en.messages.response-write-only-property-enum-value-added-description":           "response write-only property enum value added",
+	"en.messages.schema-default-invalid":                                              "default value %s doesn't match its schema: %s: %s",
+	"en.messages.schema-default-invalid-description":                                  "schema default value doesn't match the schema",
+	"en.messages.schema-example-invalid":                                              "schema example doesn't match its schema: %s: %s",
+	"en.messages.schema-example-invalid-description":                                  "schema example doesn't match the schema",
+	"en.messages.style-collection-not-plural":                                         "collection %q in path %q should be plural: %s",
+	"en.messages.style-collection-not-plural-description":                             "collection name isn't plural",
+	"en.messages.style-error-schema":                                                  "the %s response of %s %s doesn't use the shared error schema %s: %s",
+	"en.messages.style-error-schema-description":                                      "error response doesn't use the shared error schema",
+	"en.messages.style-operation-id-duplicate":                                        "operationId %q of %s %s is already used by %s: %s",
+	"en.messages.style-operation-id-duplicate-description":                            "operationId is used by more than one operation",
+	"en.messages.style-operation-id-missing":                                          "%s %s doesn't have an operationId: %s",
+	"en.messages.style-operation-id-missing-description":                              "operation doesn't have an operationId",
+	"en.messages.style-operation-tag-missing":                                         "%s %s doesn't have a tag: %s",
+	"en.messages.style-operation-tag-missing-description":                             "operation doesn't have a tag",
+	"en.messages.style-path-casing":                                                   "path segment %q isn't %s in path %q: %s",
+	"en.messages.style-path-casing-description":                                       "path segment doesn't follow the casing convention",
+	"en.messages.style-path-trailing-slash":                                           "path %q ends with a slash: %s",
+	"en.messages.style-path-trailing-slash-description":                               "path ends with a slash",
+	"en.messages.style-property-casing":                                               "property %q isn't %s: %s",
+	"en.messages.style-property-casing-description":                                   "property name doesn't follow the casing convention",
+	"en.messages.style-tag-undeclared":                                                "tag %q of %s %s isn't declared in the tags of the spec: %s",
+	"en.messages.style-tag-undeclared-description":                                    "operation tag isn't declared in the tags of the spec",
 	"en.messages.sunset-deleted":                                                      "api sunset date deleted, but deprecated=true kept",
 	"en.messages.sunset-deleted-description":                                          "sunset deleted",
 	"en.messages.total-changes":                                                       "%d changes: %d %s, %d %s, %d %s\n",
 	"en.messages.total-errors":                                                        "%d breaking changes: %d %s, %d %s\n",
+	"en.messages.unresolved-ref":                                                      "reference %q can't be resolved: %s",
+	"en.messages.unresolved-ref-description":                                          "reference can't be resolved",
+	"es.messages.ambiguous-endpoints":                                                 "los endpoints %s %s y %s %s son ambiguos porque una solicitud puede coincidir con ambos: %s y %s",
+	"es.messages.ambiguous-endpoints-comment":                                         "La especificación OpenAPI prioriza las rutas concretas sobre las rutas con plantillas, pero los routers pueden resolver estas solicitudes de otra manera.",
+	"es.messages.ambiguous-endpoints-description":                                     "los endpoints pueden coincidir con la misma solicitud",
 	"es.messages.api-deprecated-sunset-missing":                                       "fecha de expiración faltante para la API deprecada",
 	"es.messages.api-deprecated-sunset-missing-description":                           "endpoint deprecado sin fecha de expiración",
 	"es.messages.api-deprecated-sunset-parse":                                         "fallo al parsear la fecha de expiración: %v",
@@ -657,13 +766,56 @@ var localizations = map[string]string{
 	"es.messages.api-tag-removed":                                                     "etiqueta de api %s removida",
 	"es.messages.api-tag-removed-description":                                         "etiqueta del endpoint removida",
 	"es.messages.at":                                                                  "en",
+	"es.messages.duplicate-endpoint":                                                  "el endpoint %s %s está definido más de una vez, en las líneas %d y %d",
+	"es.messages.duplicate-endpoint-comment":                                          "Solo se carga la última definición y las demás se ignoran silenciosamente.",
+	"es.messages.duplicate-endpoint-description":                                      "el endpoint está definido más de una vez",
+	"es.messages.duplicate-endpoint-param-names":                                      "los endpoints %s %s y %s %s difieren solo en los nombres de los parámetros de ruta: %s y %s",
+	"es.messages.duplicate-endpoint-param-names-comment":                              "oasdiff compara los endpoints sin tener en cuenta los nombres de los parámetros de ruta y no puede comparar endpoints duplicados. Agregue la extensión x-since-date para indicar cuál endpoint es más reciente, o use el flag --include-path-params para tener en cuenta también los nombres de los parámetros de ruta.",
+	"es.messages.duplicate-endpoint-param-names-description":                          "los endpoints difieren solo en los nombres de los parámetros de ruta",
+	"es.messages.duplicate-path":                                                      "la ruta %q está definida más de una vez, en las líneas %d y %d",
+	"es.messages.duplicate-path-comment":                                              "Solo se carga la última definición y las demás se ignoran silenciosamente.",
+	"es.messages.duplicate-path-description":                                          "la ruta está definida más de una vez",
+	"es.messages.duplicate-property":                                                  "la propiedad %q está definida más de una vez, en las líneas %d y %d: %s",
+	"es.messages.duplicate-property-comment":                                          "Solo se carga la última definición y las demás se ignoran silenciosamente.",
+	"es.messages.duplicate-property-description":                                      "la propiedad está definida más de una vez",
+	"es.messages.duplicate-required-property":                                         "la propiedad %q aparece más de una vez en la lista de requeridas: %s",
+	"es.messages.duplicate-required-property-description":                             "la propiedad aparece más de una vez en la lista de requeridas",
 	"es.messages.endpoint-added":                                                      "endpoint agregado",
 	"es.messages.endpoint-added-description":                                          "endpoint agregado",
 	"es.messages.endpoint-deprecated":                                                 "endpoint deprecado",
 	"es.messages.endpoint-deprecated-description":                                     "endpoint deprecado",
 	"es.messages.endpoint-reactivated":                                                "endpoint reactivado",
 	"es.messages.endpoint-reactivated-description":                                    "endpoint reactivado (deprecación establecida como falsa)",
+	"es.messages.extra_required_props":                                                "propiedades inexistentes %v definidas como requeridas",
+	"es.messages.extra_required_props-description":                                    "el esquema requiere propiedades que no están definidas",
+	"es.messages.header-default-invalid":                                              "el valor por defecto %s del header %q no coincide con su esquema: %s: %s",
+	"es.messages.header-default-invalid-description":                                  "el valor por defecto del header no coincide con el esquema",
+	"es.messages.header-example-invalid":                                              "el ejemplo %q del header %q no coincide con su esquema: %s: %s",
+	"es.messages.header-example-invalid-description":                                  "el ejemplo del header no coincide con el esquema",
+	"es.messages.header-schema-and-content":                                           "el header %q define tanto schema como content, que son mutuamente excluyentes: %s",
+	"es.messages.header-schema-and-content-description":                               "el header define tanto schema como content",
 	"es.messages.in":                                                                  "en",
+	"es.messages.info-invalid-contact-email":                                          "el email de contacto debe tener el formato de una dirección de email: %s",
+	"es.messages.info-invalid-contact-email-description":                              "el email de contacto no es una dirección de email válida",
+	"es.messages.info-invalid-contact-url":                                            "la URL de contacto debe tener el formato de una URL: %s",
+	"es.messages.info-invalid-contact-url-description":                                "la URL de contacto no es una URL válida",
+	"es.messages.info-invalid-license-url":                                            "la URL de la licencia debe tener el formato de una URL: %s",
+	"es.messages.info-invalid-license-url-description":                                "la URL de la licencia no es una URL válida",
+	"es.messages.info-invalid-terms-of-service":                                       "los términos de servicio deben tener el formato de una URL: %s",
+	"es.messages.info-invalid-terms-of-service-description":                           "los términos de servicio no son una URL válida",
+	"es.messages.info-missing":                                                        "falta el objeto info",
+	"es.messages.info-missing-comment":                                                "Es una buena práctica incluir información general sobre su API en la especificación. Los campos Title y Version son obligatorios.",
+	"es.messages.info-missing-description":                                            "falta el objeto info",
+	"es.messages.info-title-missing":                                                  "falta el título de la API",
+	"es.messages.info-title-missing-description":                                      "falta el título de la API",
+	"es.messages.info-version-missing":                                                "falta la versión de la API",
+	"es.messages.info-version-missing-description":                                    "falta la versión de la API",
+	"es.messages.invalid-regex-pattern":                                               "patrón de expresión regular inválido: %s",
+	"es.messages.invalid-regex-pattern-description":                                   "el patrón del esquema no es una expresión regular válida",
+	"es.messages.json-schema-dialect-invalid":                                         "jsonSchemaDialect debe tener el formato de una URI: %s: %s",
+	"es.messages.json-schema-dialect-invalid-description":                             "jsonSchemaDialect no es una URI válida",
+	"es.messages.media-type-example-invalid":                                          "el ejemplo %q del media type %q no coincide con su esquema: %s: %s",
+	"es.messages.media-type-example-invalid-description":                              "el ejemplo del media type no coincide con el esquema",
 	"es.messages.new-optional-request-default-parameter-to-existing-path":             "agregado el nuevo parámetro %s de solicitud opcional %s a todas las operaciones del path",
 	"es.messages.new-optional-request-default-parameter-to-existing-path-description": "parámetro opcional de solicitud agregado en el nivel del path",
 	"es.messages.new-optional-request-parameter":                                      "agregado el nuevo parámetro %s de solicitud opcional %s",
@@ -682,10 +834,31 @@ var localizations = map[string]string{
 	"es.messages.new-required-request-property-description":                           "propiedad requerida agregada a la solicitud",
 	"es.messages.new-required-request-property-with-default":                          "agregada la nueva propiedad de solicitud requerida %s con un valor por defecto",
 	"es.messages.new-required-request-property-with-default-description":              "propiedad requerida con valor por defecto agregada a la solicitud",
+	"es.messages.openapi-schema-invalid":                                              "la especificación no coincide con el esquema de OpenAPI %s: %s: %s",
+	"es.messages.openapi-schema-invalid-description":                                  "la especificación no coincide con el JSON Schema de OpenAPI",
 	"es.messages.optional-response-header-removed":                                    "removido el encabezado de respuesta opcional %s para el estado %s",
 	"es.messages.optional-response-header-removed-description":                        "encabezado de respuesta opcional removido",
+	"es.messages.parameter-default-invalid":                                           "el valor por defecto %s del parámetro %s %q no coincide con su esquema: %s: %s",
+	"es.messages.parameter-default-invalid-description":                               "el valor por defecto del parámetro no coincide con el esquema",
+	"es.messages.parameter-example-invalid":                                           "el ejemplo %q del parámetro %s %q no coincide con su esquema: %s: %s",
+	"es.messages.parameter-example-invalid-description":                               "el ejemplo del parámetro no coincide con el esquema",
+	"es.messages.parameter-schema-and-content":                                        "el parámetro %s %q define tanto schema como content, que son mutuamente excluyentes: %s",
+	"es.messages.parameter-schema-and-content-description":                            "el parámetro define tanto schema como content",
+	"es.messages.path-item-ref-siblings":                                              "la ruta %q define %s junto a su $ref, lo cual tiene un comportamiento indefinido: %s",
+	"es.messages.path-item-ref-siblings-comment":                                      "La especificación OpenAPI no define el comportamiento cuando un campo aparece tanto en un path item como en el path item al que hace referencia. Mueva estos campos al path item referenciado.",
+	"es.messages.path-item-ref-siblings-description":                                  "el path item define campos junto a su $ref",
+	"es.messages.path-param-duplicate":                                                "el parámetro de path %q está definido tanto en el path como en la operación: %s",
+	"es.messages.path-param-duplicate-description":                                    "el parámetro de path está definido tanto en el path como en la operación",
+	"es.messages.path-param-extra":                                                    "el parámetro de path %q aparece en la sección de parámetros de %s pero falta en la URL: %s",
+	"es.messages.path-param-extra-description":                                        "el parámetro de path está definido pero no aparece en la URL",
+	"es.messages.path-param-missing":                                                  "el parámetro de path %q aparece en la URL pero falta en la sección de parámetros del path y de la operación: %s",
+	"es.messages.path-param-missing-description":                                      "el parámetro de path aparece en la URL pero no está definido",
+	"es.messages.path-param-not-required":                                             "el parámetro de path %q debería tener required=true: %s",
+	"es.messages.path-param-not-required-description":                                 "el parámetro de path no es requerido",
 	"es.messages.pattern-added-error-comment":                                         "Este es un cambio crítico porque agregar una restricción de patrón a un parámetro previamente sin restricciones rechazará valores que anteriormente eran aceptados, rompiendo clientes existentes",
 	"es.messages.pattern-changed-warn-comment":                                        "Esta es una advertencia porque agregar o cambiar un patrón puede restringir los valores aceptados y romper clientes existentes. Para cambios de patrón, es difícil analizar automáticamente si el nuevo patrón es un superconjunto del patrón anterior (ej. cambiado de '[0-9]+' a '[0-9]*')",
+	"es.messages.property-default-invalid":                                            "el valor por defecto %s de la propiedad %q no coincide con su esquema: %s: %s",
+	"es.messages.property-default-invalid-description":                                "el valor por defecto de la propiedad no coincide con el esquema",
 	"es.messages.request-body-added-optional":                                         "agregado cuerpo de solicitud opcional",
 	"es.messages.request-body-added-optional-description":                             "cuerpo de solicitud opcional agregado",
 	"es.messages.request-body-added-required":                                         "agregado cuerpo de solicitud requerido",
@@ -708,6 +881,8 @@ var localizations = map[string]string{
 	"es.messages.request-body-became-optional-description":                            "el cuerpo de solicitud se volvió opcional",
 	"es.messages.request-body-became-required":                                        "el cuerpo de solicitud se volvió requerido",
 	"es.messages.request-body-became-required-description":                            "el cuerpo de solicitud se volvió requerido",
+	"es.messages.request-body-counterexample-found":                                   "el esquema del cuerpo de solicitud %s rechaza un contenido que el esquema base acepta: %s",
+	"es.messages.request-body-counterexample-found-description":                       "un contenido generado a partir del esquema base del cuerpo de solicitud es rechazado por el esquema de la revisión",
 	"es.messages.request-body-default-value-added":                                    "el valor por defecto %s fue agregado al cuerpo de solicitud",
 	"es.messages.request-body-default-value-added-description":                        "valor por defecto del cuerpo de solicitud establecido",
 	"es.messages.request-body-default-value-changed":                                  "el valor por defecto del cuerpo de solicitud fue cambiado de %s a %s",
@@ -728,6 +903,8 @@ var localizations = map[string]string{
 	"es.messages.request-body-discriminator-removed-description":                      "discriminador del cuerpo de solicitud removido",
 	"es.messages.request-body-enum-value-removed":                                     "removido el valor enum %s del cuerpo de solicitud",
 	"es.messages.request-body-enum-value-removed-description":                         "valor del enum del cuerpo de solicitud removido",
+	"es.messages.request-body-example-invalidated":                                    "el ejemplo %s del cuerpo de solicitud %s ya no coincide con el esquema del cuerpo de solicitud: %s",
+	"es.messages.request-body-example-invalidated-description":                        "el ejemplo del cuerpo de solicitud ya no coincide con el esquema",
 	"es.messages.request-body-list-of-types-narrowed":                                 "lista de tipos del cuerpo de solicitud fue reducida removiendo tipos %s del tipo de media %s",
 	"es.messages.request-body-list-of-types-widened":                                  "lista de tipos del cuerpo de solicitud fue ampliada agregando tipos %s al tipo de media %s",
 	"es.messages.request-body-max-decreased":                                          "el valor máximo del cuerpo de solicitud fue disminuido a %s",
@@ -805,6 +982,8 @@ var localizations = map[string]string{
 	"es.messages.request-parameter-enum-value-added-description":                      "valor del enum del parámetro de solicitud agregado",
 	"es.messages.request-parameter-enum-value-removed":                                "removido el valor enum %s del parámetro %s de solicitud %s",
 	"es.messages.request-parameter-enum-value-removed-description":                    "valor del enum del parámetro de solicitud removido",
+	"es.messages.request-parameter-example-invalidated":                               "el ejemplo %s del parámetro de solicitud %s %s ya no coincide con el esquema del parámetro: %s",
+	"es.messages.request-parameter-example-invalidated-description":                   "el ejemplo del parámetro de solicitud ya no coincide con el esquema",
 	"es.messages.request-parameter-list-of-types-narrowed":                            "lista de tipos del parámetro %s de solicitud %s fue reducida removiendo tipos %s",
 	"es.messages.request-parameter-list-of-types-widened":                             "lista de tipos del parámetro %s de solicitud %s fue ampliada agregando tipos %s",
 	"es.messages.request-parameter-max-decreased":                                     "para el parámetro %s de solicitud %s, el máximo fue disminuido de %s a %s",
@@ -987,6 +1166,11 @@ var localizations = map[string]string{
 	"es.messages.request-required-property-became-read-only-description":              "propiedad requerida de solicitud se volvió de solo lectura",
 	"es.messages.request-required-property-became-write-only":                         "la propiedad requerida de solicitud %s se volvió de solo escritura",
 	"es.messages.request-required-property-became-write-only-description":             "propiedad requerida de solicitud se volvió de solo escritura",
+	"es.messages.required-param-with-default":                                         "el parámetro %s requerido %q no debería tener un valor por defecto: %s",
+	"es.messages.required-param-with-default-description":                             "el parámetro requerido tiene un valor por defecto",
+	"es.messages.required-property-with-default":                                      "la propiedad requerida %q no debería tener un valor por defecto: %s",
+	"es.messages.required-property-with-default-comment":                              "El valor por defecto nunca se usa porque el cliente siempre debe enviar un valor para una propiedad requerida.",
+	"es.messages.required-property-with-default-description":                          "la propiedad requerida tiene un valor por defecto",
 	"es.messages.required-response-header-removed":                                    "removido el encabezado de respuesta requerido %s para el estado %s",
 	"es.messages.required-response-header-removed-description":                        "encabezado de respuesta requerido removido",
 	"es.messages.response-body-all-of-added":                                          "%s fue agregado a la lista 'allOf' del cuerpo de respuesta para el estado %s",
@@ -999,6 +1183,8 @@ var localizations = map[string]string{
 	"es.messages.response-body-any-of-removed-description":                            "subesquema removido del anyOf en el cuerpo de respuesta",
 	"es.messages.response-body-became-nullable":                                       "el cuerpo de respuesta se volvió nulable",
 	"es.messages.response-body-became-nullable-description":                           "cuerpo de respuesta se volvió nulable",
+	"es.messages.response-body-counterexample-found":                                  "el cuerpo de respuesta %s para el estado %s puede contener un contenido que el esquema base rechaza: %s",
+	"es.messages.response-body-counterexample-found-description":                      "un contenido generado a partir del esquema de la revisión del cuerpo de respuesta es rechazado por el esquema base",
 	"es.messages.response-body-default-value-added":                                   "el valor por defecto %s fue agregado al cuerpo de respuesta para el estado %s",
 	"es.messages.response-body-default-value-added-description":                       "valor por defecto del cuerpo de respuesta establecido",
 	"es.messages.response-body-default-value-changed":                                 "el valor por defecto del cuerpo de respuesta fue cambiado de %s a %s para el estado %s",
@@ -1017,6 +1203,8 @@ var localizations = map[string]string{
 	"es.messages.response-body-discriminator-property-name-changed-description":       "nombre de la propiedad del discriminador del cuerpo de respuesta cambiado",
 	"es.messages.response-body-discriminator-removed":                                 "removido discriminador de respuesta para el estado %s",
 	"es.messages.response-body-discriminator-removed-description":                     "discriminador del cuerpo de respuesta removido",
+	"es.messages.response-body-example-invalidated":                                   "el ejemplo %s del cuerpo de respuesta %s para el estado %s ya no coincide con el esquema de la respuesta: %s",
+	"es.messages.response-body-example-invalidated-description":                       "el ejemplo del cuerpo de respuesta ya no coincide con el esquema",
 	"es.messages.response-body-list-of-types-narrowed":                                "lista de tipos del cuerpo de respuesta fue reducida removiendo tipos %s del tipo de media %s de la respuesta %s",
 	"es.messages.response-body-list-of-types-widened":                                 "lista de tipos del cuerpo de respuesta fue ampliada agregando tipos %s al tipo de media %s de la respuesta %s",
 	"es.messages.response-body-max-increased":                                         "el valor máximo del cuerpo de respuesta fue aumentado de %s a %s",
@@ -1162,10 +1350,37 @@ var localizations = map[string]string{
 	"es.messages.response-write-only-property-became-required-description":            "propiedad de solo escritura de respuesta se volvió requerida",
 	"es.messages.response-write-only-property-enum-value-added":                       "agregado el nuevo valor enum %s a la propiedad de solo escritura %s para el estado %s",
 	"es.messages.response-write-only-property-enum-value-added-description":           "valor del enum de la propiedad de solo escritura de respuesta agregado",
+	"es.messages.schema-default-invalid":                                              "el valor por defecto %s no coincide con su esquema: %s: %s",
+	"es.messages.schema-default-invalid-description":                                  "el valor por defecto del esquema no coincide con el esquema",
+	"es.messages.schema-example-invalid":                                              "el ejemplo del esquema no coincide con su esquema: %s: %s",
+	"es.messages.schema-example-invalid-description":                                  "el ejemplo del esquema no coincide con el esquema",
+	"es.messages.style-collection-not-plural":                                         "la colección %q en la ruta %q debería estar en plural: %s",
+	"es.messages.style-collection-not-plural-description":                             "el nombre de la colección no está en plural",
+	"es.messages.style-error-schema":                                                  "la respuesta %s de %s %s no usa el esquema de error compartido %s: %s",
+	"es.messages.style-error-schema-description":                                      "la respuesta de error no usa el esquema de error compartido",
+	"es.messages.style-operation-id-duplicate":                                        "el operationId %q de %s %s ya es usado por %s: %s",
+	"es.messages.style-operation-id-duplicate-description":                            "el operationId es usado por más de una operación",
+	"es.messages.style-operation-id-missing":                                          "%s %s no tiene operationId: %s",
+	"es.messages.style-operation-id-missing-description":                              "la operación no tiene operationId",
+	"es.messages.style-operation-tag-missing":                                         "%s %s no tiene tag: %s",
+	"es.messages.style-operation-tag-missing-description":                             "la operación no tiene tag",
+	"es.messages.style-path-casing":                                                   "el segmento de ruta %q no está en %s en la ruta %q: %s",
+	"es.messages.style-path-casing-description":                                       "el segmento de ruta no sigue la convención de nombres",
+	"es.messages.style-path-trailing-slash":                                           "la ruta %q termina con una barra: %s",
+	"es.messages.style-path-trailing-slash-description":                               "la ruta termina con una barra",
+	"es.messages.style-property-casing":                                               "la propiedad %q no está en %s: %s",
+	"es.messages.style-property-casing-description":                                   "el nombre de la propiedad no sigue la convención de nombres",
+	"es.messages.style-tag-undeclared":                                                "el tag %q de %s %s no está declarado en los tags de la especificación: %s",
+	"es.messages.style-tag-undeclared-description":                                    "el tag de la operación no está declarado en los tags de la especificación",
 	"es.messages.sunset-deleted":                                                      "fecha de expiración de api eliminada, pero deprecated=true mantenido",
 	"es.messages.sunset-deleted-description":                                          "fecha de expiración removida",
 	"es.messages.total-changes":                                                       "%d cambios: %d %s, %d %s, %d %s\n",
 	"es.messages.total-errors":                                                        "%d cambios críticos: %d %s, %d %s\n",
+	"es.messages.unresolved-ref":                                                      "la referencia %q no se puede resolver: %s",
+	"es.messages.unresolved-ref-description":                                          "la referencia no se puede resolver",
+	"pt-br.messages.ambiguous-endpoints":                                              "os endpoints %s %s e %s %s são ambíguos porque uma requisição pode corresponder a ambos: %s e %s",
+	"pt-br.messages.ambiguous-endpoints-comment":                                      "A especificação OpenAPI prioriza paths concretos sobre paths com templates, mas os roteadores podem resolver essas requisições de outra forma.",
+	"pt-br.messages.ambiguous-endpoints-description":                                  "os endpoints podem corresponder à mesma requisição",
 	"pt-br.messages.api-deprecated-sunset-missing":                                    "data de expiração ausente para api depreciada",
 	"pt-br.messages.api-deprecated-sunset-missing-description":                        "endpoint depreciado sem data de expiração",
 	"pt-br.messages.api-deprecated-sunset-parse":                                      "falha ao analisar a data de depreciação: %v",
@@ -1234,13 +1449,56 @@ var localizations = map[string]string{
 	"pt-br.messages.api-tag-removed":                                                  "tag da api %s removida",
 	"pt-br.messages.api-tag-removed-description":                                      "tag do endpoint removida",
 	"pt-br.messages.at":                                                                  "em",
+	"pt-br.messages.duplicate-endpoint":                                                  "o endpoint %s %s está definido mais de uma vez, nas linhas %d e %d",
+	"pt-br.messages.duplicate-endpoint-comment":                                          "Apenas a última definição é carregada e as demais são ignoradas silenciosamente.",
+	"pt-br.messages.duplicate-endpoint-description":                                      "o endpoint está definido mais de uma vez",
+	"pt-br.messages.duplicate-endpoint-param-names":                                      "os endpoints %s %s e %s %s diferem apenas nos nomes dos parâmetros de path: %s e %s",
+	"pt-br.messages.duplicate-endpoint-param-names-comment":                              "O oasdiff associa endpoints sem considerar os nomes dos parâmetros de path e não consegue comparar endpoints duplicados. Adicione a extensão x-since-date para indicar qual endpoint é mais recente, ou use a flag --include-path-params para considerar também os nomes dos parâmetros de path.",
+	"pt-br.messages.duplicate-endpoint-param-names-description":                          "os endpoints diferem apenas nos nomes dos parâmetros de path",
+	"pt-br.messages.duplicate-path":                                                      "o path %q está definido mais de uma vez, nas linhas %d e %d",
+	"pt-br.messages.duplicate-path-comment":                                              "Apenas a última definição é carregada e as demais são ignoradas silenciosamente.",
+	"pt-br.messages.duplicate-path-description":                                          "o path está definido mais de uma vez",
+	"pt-br.messages.duplicate-property":                                                  "a propriedade %q está definida mais de uma vez, nas linhas %d e %d: %s",
+	"pt-br.messages.duplicate-property-comment":                                          "Apenas a última definição é carregada e as demais são ignoradas silenciosamente.",
+	"pt-br.messages.duplicate-property-description":                                      "a propriedade está definida mais de uma vez",
+	"pt-br.messages.duplicate-required-property":                                         "a propriedade %q aparece mais de uma vez na lista de obrigatórias: %s",
+	"pt-br.messages.duplicate-required-property-description":                             "a propriedade aparece mais de uma vez na lista de obrigatórias",
 	"pt-br.messages.endpoint-added":                                                      "endpoint adicionado",
 	"pt-br.messages.endpoint-added-description":                                          "endpoint adicionado",
 	"pt-br.messages.endpoint-deprecated":                                                 "endpoint depreciado",
 	"pt-br.messages.endpoint-deprecated-description":                                     "endpoint depreciado",
 	"pt-br.messages.endpoint-reactivated":                                                "endpoint reativado",
 	"pt-br.messages.endpoint-reactivated-description":                                    "endpoint reativado (depreciação definida como falsa)",
+	"pt-br.messages.extra_required_props":                                                "propriedades inexistentes %v definidas como obrigatórias",
+	"pt-br.messages.extra_required_props-description":                                    "o schema exige propriedades que não estão definidas",
+	"pt-br.messages.header-default-invalid":                                              "o valor padrão %s do header %q não corresponde ao seu schema: %s: %s",
+	"pt-br.messages.header-default-invalid-description":                                  "o valor padrão do header não corresponde ao schema",
+	"pt-br.messages.header-example-invalid":                                              "o exemplo %q do header %q não corresponde ao seu schema: %s: %s",
+	"pt-br.messages.header-example-invalid-description":                                  "o exemplo do header não corresponde ao schema",
+	"pt-br.messages.header-schema-and-content":                                           "o header %q define tanto schema quanto content, que são mutuamente exclusivos: %s",
+	"pt-br.messages.header-schema-and-content-description":                               "o header define tanto schema quanto content",
 	"pt-br.messages.in":                                                                  "em",
+	"pt-br.messages.info-invalid-contact-email":                                          "o email de contato deve estar no formato de um endereço de email: %s",
+	"pt-br.messages.info-invalid-contact-email-description":                              "o email de contato não é um endereço de email válido",
+	"pt-br.messages.info-invalid-contact-url":                                            "a URL de contato deve estar no formato de uma URL: %s",
+	"pt-br.messages.info-invalid-contact-url-description":                                "a URL de contato não é uma URL válida",
+	"pt-br.messages.info-invalid-license-url":                                            "a URL da licença deve estar no formato de uma URL: %s",
+	"pt-br.messages.info-invalid-license-url-description":                                "a URL da licença não é uma URL válida",
+	"pt-br.messages.info-invalid-terms-of-service":                                       "os termos de serviço devem estar no formato de uma URL: %s",
+	"pt-br.messages.info-invalid-terms-of-service-description":                           "os termos de serviço não são uma URL válida",
+	"pt-br.messages.info-missing":                                                        "o objeto info está ausente",
+	"pt-br.messages.info-missing-comment":                                                "É uma boa prática incluir informações gerais sobre sua API na especificação. Os campos Title e Version são obrigatórios.",
+	"pt-br.messages.info-missing-description":                                            "o objeto info está ausente",
+	"pt-br.messages.info-title-missing":                                                  "o título da API está ausente",
+	"pt-br.messages.info-title-missing-description":                                      "o título da API está ausente",
+	"pt-br.messages.info-version-missing":                                                "a versão da API está ausente",
+	"pt-br.messages.info-version-missing-description":                                    "a versão da API está ausente",
+	"pt-br.messages.invalid-regex-pattern":                                               "padrão de expressão regular inválido: %s",
+	"pt-br.messages.invalid-regex-pattern-description":                                   "o padrão do schema não é uma expressão regular válida",
+	"pt-br.messages.json-schema-dialect-invalid":                                         "jsonSchemaDialect deve estar no formato de uma URI: %s: %s",
+	"pt-br.messages.json-schema-dialect-invalid-description":                             "jsonSchemaDialect não é uma URI válida",
+	"pt-br.messages.media-type-example-invalid":                                          "o exemplo %q do media type %q não corresponde ao seu schema: %s: %s",
+	"pt-br.messages.media-type-example-invalid-description":                              "o exemplo do media type não corresponde ao schema",
 	"pt-br.messages.new-optional-request-default-parameter-to-existing-path":             "o novo parâmetro opcional de requisição %s foi adicionado a todas as operações do caminho",
 	"pt-br.messages.new-optional-request-default-parameter-to-existing-path-description": "parâmetro opcional de requisição adicionado no nível do caminho",
 	"pt-br.messages.new-optional-request-parameter":                                      "adicionado o novo parâmetro de requisição opcional %s %s",
@@ -1259,10 +1517,31 @@ var localizations = map[string]string{
 	"pt-br.messages.new-required-request-property-description":                           "propriedade obrigatória adicionada à requisição",
 	"pt-br.messages.new-required-request-property-with-default":                          "adicionada a nova propriedade de requisição obrigatória %s com valor padrão",
 	"pt-br.messages.new-required-request-property-with-default-description":              "propriedade obrigatória com valor padrão adicionada à requisição",
+	"pt-br.messages.openapi-schema-invalid":                                              "a especificação não corresponde ao schema do OpenAPI %s: %s: %s",
+	"pt-br.messages.openapi-schema-invalid-description":                                  "a especificação não corresponde ao JSON Schema do OpenAPI",
 	"pt-br.messages.optional-response-header-removed":                                    "o cabeçalho de resposta opcional %s foi removido para o status %s",
 	"pt-br.messages.optional-response-header-removed-description":                        "cabeçalho de resposta opcional removido",
+	"pt-br.messages.parameter-default-invalid":                                           "o valor padrão %s do parâmetro %s %q não corresponde ao seu schema: %s: %s",
+	"pt-br.messages.parameter-default-invalid-description":                               "o valor padrão do parâmetro não corresponde ao schema",
+	"pt-br.messages.parameter-example-invalid":                                           "o exemplo %q do parâmetro %s %q não corresponde ao seu schema: %s: %s",
+	"pt-br.messages.parameter-example-invalid-description":                               "o exemplo do parâmetro não corresponde ao schema",
+	"pt-br.messages.parameter-schema-and-content":                                        "o parâmetro %s %q define tanto schema quanto content, que são mutuamente exclusivos: %s",
+	"pt-br.messages.parameter-schema-and-content-description":                            "o parâmetro define tanto schema quanto content",
+	"pt-br.messages.path-item-ref-siblings":                                              "o path %q define %s junto ao seu $ref, o que tem comportamento indefinido: %s",
+	"pt-br.messages.path-item-ref-siblings-comment":                                      "A especificação OpenAPI não define o comportamento quando um campo aparece tanto em um path item quanto no path item que ele referencia. Mova esses campos para o path item referenciado.",
+	"pt-br.messages.path-item-ref-siblings-description":                                  "o path item define campos junto ao seu $ref",
+	"pt-br.messages.path-param-duplicate":                                                "o parâmetro de path %q está definido tanto no path quanto na operação: %s",
+	"pt-br.messages.path-param-duplicate-description":                                    "o parâmetro de path está definido tanto no path quanto na operação",
+	"pt-br.messages.path-param-extra":                                                    "o parâmetro de path %q aparece na seção de parâmetros de %s mas está ausente na URL: %s",
+	"pt-br.messages.path-param-extra-description":                                        "o parâmetro de path está definido mas não aparece na URL",
+	"pt-br.messages.path-param-missing":                                                  "o parâmetro de path %q aparece na URL mas está ausente da seção de parâmetros do path e da operação: %s",
+	"pt-br.messages.path-param-missing-description":                                      "o parâmetro de path aparece na URL mas não está definido",
+	"pt-br.messages.path-param-not-required":                                             "o parâmetro de path %q deveria ter required=true: %s",
+	"pt-br.messages.path-param-not-required-description":                                 "o parâmetro de path não é obrigatório",
 	"pt-br.messages.pattern-added-error-comment":                                         "Esta é uma alteração crítica porque adicionar uma restrição de padrão a um parâmetro anteriormente irrestrito rejeitará valores que eram aceitos anteriormente, quebrando clientes existentes",
 	"pt-br.messages.pattern-changed-warn-comment":                                        "Este é um aviso porque é difícil analisar automaticamente se o novo padrão é um superconjunto do padrão anterior (por exemplo, alterado de '[0-9]+' para '[0-9]*')",
+	"pt-br.messages.property-default-invalid":                                            "o valor padrão %s da propriedade %q não corresponde ao seu schema: %s: %s",
+	"pt-br.messages.property-default-invalid-description":                                "o valor padrão da propriedade não corresponde ao schema",
 	"pt-br.messages.request-body-added-optional":                                         "corpo da requisição opcional adicionado",
 	"pt-br.messages.request-body-added-optional-description":                             "corpo de requisição opcional adicionado",
 	"pt-br.messages.request-body-added-required":                                         "corpo da requisição obrigatório adicionado",
@@ -1285,6 +1564,8 @@ var localizations = map[string]string{
 	"pt-br.messages.request-body-became-optional-description":                            "o corpo da requisição tornou-se opcional",
 	"pt-br.messages.request-body-became-required":                                        "o corpo da requisição tornou-se obrigatório",
 	"pt-br.messages.request-body-became-required-description":                            "o corpo da requisição tornou-se obrigatório",
+	"pt-br.messages.request-body-counterexample-found":                                   "o esquema do corpo da requisição %s rejeita um conteúdo que o esquema base aceita: %s",
+	"pt-br.messages.request-body-counterexample-found-description":                       "um conteúdo gerado a partir do esquema base do corpo da requisição é rejeitado pelo esquema da revisão",
 	"pt-br.messages.request-body-default-value-added":                                    "o valor padrão %s foi adicionado ao corpo da requisição",
 	"pt-br.messages.request-body-default-value-added-description":                        "valor padrão do corpo da requisição definido",
 	"pt-br.messages.request-body-default-value-changed":                                  "o valor padrão do corpo da requisição %s foi alterado de %s para %s",
@@ -1305,6 +1586,8 @@ var localizations = map[string]string{
 	"pt-br.messages.request-body-discriminator-removed-description":                      "discriminador do corpo da requisição removido",
 	"pt-br.messages.request-body-enum-value-removed":                                     "valor %s do enum removido do corpo da requisição",
 	"pt-br.messages.request-body-enum-value-removed-description":                         "valor do enum do corpo da requisição removido",
+	"pt-br.messages.request-body-example-invalidated":                                    "o exemplo %s do corpo da requisição %s não corresponde mais ao esquema do corpo da requisição: %s",
+	"pt-br.messages.request-body-example-invalidated-description":                        "exemplo do corpo da requisição não corresponde mais ao esquema",
 	"pt-br.messages.request-body-list-of-types-narrowed":                                 "lista de tipos do corpo da requisição foi restringida removendo tipos %s do tipo de mídia %s",
 	"pt-br.messages.request-body-list-of-types-widened":                                  "lista de tipos do corpo da requisição foi expandida adicionando tipos %s ao tipo de mídia %s",
 	"pt-br.messages.request-body-max-decreased":                                          "o valor máximo do corpo da requisição foi reduzido para %s",
@@ -1382,6 +1665,8 @@ var localizations = map[string]string{
 	"pt-br.messages.request-parameter-enum-value-added-description":                      "valor do enum do parâmetro da requisição adicionado",
 	"pt-br.messages.request-parameter-enum-value-removed":                                "valor %s do enum removido do parâmetro de requisição do tipo %s e nome %s",
 	"pt-br.messages.request-parameter-enum-value-removed-description":                    "valor do enum do parâmetro da requisição removido",
+	"pt-br.messages.request-parameter-example-invalidated":                               "o exemplo %s do parâmetro de requisição %s %s não corresponde mais ao esquema do parâmetro: %s",
+	"pt-br.messages.request-parameter-example-invalidated-description":                   "exemplo do parâmetro da requisição não corresponde mais ao esquema",
 	"pt-br.messages.request-parameter-list-of-types-narrowed":                            "lista de tipos do parâmetro %s %s da requisição foi restringida removendo tipos %s",
 	"pt-br.messages.request-parameter-list-of-types-widened":                             "lista de tipos do parâmetro %s %s da requisição foi expandida adicionando tipos %s",
 	"pt-br.messages.request-parameter-max-decreased":                                     "no parâmetro de requisição do tipo %s e nome %s teve seu valor máximo foi reduzido de %s para %s",
@@ -1564,6 +1849,11 @@ var localizations = map[string]string{
 	"pt-br.messages.request-required-property-became-read-only-description":              "propriedade obrigatória da requisição tornou-se somente leitura",
 	"pt-br.messages.request-required-property-became-write-only":                         "a propriedade obrigatória de requisição %s tornou-se somente escrita",
 	"pt-br.messages.request-required-property-became-write-only-description":             "propriedade obrigatória da requisição tornou-se somente escrita",
+	"pt-br.messages.required-param-with-default":                                         "o parâmetro %s obrigatório %q não deveria ter um valor padrão: %s",
+	"pt-br.messages.required-param-with-default-description":                             "o parâmetro obrigatório tem um valor padrão",
+	"pt-br.messages.required-property-with-default":                                      "a propriedade obrigatória %q não deveria ter um valor padrão: %s",
+	"pt-br.messages.required-property-with-default-comment":                              "O valor padrão nunca é usado porque o cliente sempre deve enviar um valor para uma propriedade obrigatória.",
+	"pt-br.messages.required-property-with-default-description":                          "a propriedade obrigatória tem um valor padrão",
 	"pt-br.messages.required-response-header-removed":                                    "o cabeçalho de resposta obrigatório %s foi removido para o status %s",
 	"pt-br.messages.required-response-header-removed-description":                        "cabeçalho de resposta obrigatório removido",
 	"pt-br.messages.response-body-all-of-added":                                          "%s foi adicionado à lista 'allOf' do corpo da resposta para o status %s",
@@ -1576,6 +1866,8 @@ var localizations = map[string]string{
 	"pt-br.messages.response-body-any-of-removed-description":                            "subesquema removido do anyOf no corpo da resposta",
 	"pt-br.messages.response-body-became-nullable":                                       "o corpo da resposta tornou-se anulável",
 	"pt-br.messages.response-body-became-nullable-description":                           "corpo da resposta tornou-se anulável",
+	"pt-br.messages.response-body-counterexample-found":                                  "o corpo da resposta %s para o status %s pode conter um conteúdo que o esquema base rejeita: %s",
+	"pt-br.messages.response-body-counterexample-found-description":                      "um conteúdo gerado a partir do esquema da revisão do corpo da resposta é rejeitado pelo esquema base",
 	"pt-br.messages.response-body-default-value-added":                                   "o valor padrão %s foi adicionado ao corpo da resposta para o status %s",
 	"pt-br.messages.response-body-default-value-added-description":                       "valor padrão do corpo da resposta definido",
 	"pt-br.messages.response-body-default-value-changed":                                 "o valor padrão do corpo da resposta %s foi alterado de %s para %s para o status %s",
@@ -1594,6 +1886,8 @@ var localizations = map[string]string{
 	"pt-br.messages.response-body-discriminator-property-name-changed-description":       "nome da propriedade do discriminador do corpo da resposta alterado",
 	"pt-br.messages.response-body-discriminator-removed":                                 "discriminador de resposta removido para o status %s",
 	"pt-br.messages.response-body-discriminator-removed-description":                     "discriminador do corpo da resposta removido",
+	"pt-br.messages.response-body-example-invalidated":                                   "o exemplo %s do corpo da resposta %s para o status %s não corresponde mais ao esquema da resposta: %s",
+	"pt-br.messages.response-body-example-invalidated-description":                       "exemplo do corpo da resposta não corresponde mais ao esquema",
 	"pt-br.messages.response-body-list-of-types-narrowed":                                "lista de tipos do corpo da resposta foi restringida removendo tipos %s do tipo de mídia %s da resposta %s",
 	"pt-br.messages.response-body-list-of-types-widened":                                 "lista de tipos do corpo da resposta foi expandida adicionando tipos %s ao tipo de mídia %s da resposta %s",
 	"pt-br.messages.response-body-max-increased":                                         "o valor máximo do corpo da resposta foi aumentado de %s para %s",
@@ -1739,10 +2033,37 @@ var localizations = map[string]string{
 	"pt-br.messages.response-write-only-property-became-required-description":            "propriedade somente escrita da resposta tornou-se obrigatória",
 	"pt-br.messages.response-write-only-property-enum-value-added":                       "o novo valor %s do enum foi adicionado à propriedade somente escrita %s para o status %s",
 	"pt-br.messages.response-write-only-property-enum-value-added-description":           "valor do enum da propriedade somente escrita da resposta adicionado",
+	"pt-br.messages.schema-default-invalid":                                              "o valor padrão %s não corresponde ao seu schema: %s: %s",
+	"pt-br.messages.schema-default-invalid-description":                                  "o valor padrão do schema não corresponde ao schema",
+	"pt-br.messages.schema-example-invalid":                                              "o exemplo do schema não corresponde ao seu schema: %s: %s",
+	"pt-br.messages.schema-example-invalid-description":                                  "o exemplo do schema não corresponde ao schema",
+	"pt-br.messages.style-collection-not-plural":                                         "a coleção %q no path %q deveria estar no plural: %s",
+	"pt-br.messages.style-collection-not-plural-description":                             "o nome da coleção não está no plural",
+	"pt-br.messages.style-error-schema":                                                  "a resposta %s de %s %s não usa o schema de erro compartilhado %s: %s",
+	"pt-br.messages.style-error-schema-description":                                      "a resposta de erro não usa o schema de erro compartilhado",
+	"pt-br.messages.style-operation-id-duplicate":                                        "o operationId %q de %s %s já é usado por %s: %s",
+	"pt-br.messages.style-operation-id-duplicate-description":                            "o operationId é usado por mais de uma operação",
+	"pt-br.messages.style-operation-id-missing":                                          "%s %s não tem operationId: %s",
+	"pt-br.messages.style-operation-id-missing-description":                              "a operação não tem operationId",
+	"pt-br.messages.style-operation-tag-missing":                                         "%s %s não tem tag: %s",
+	"pt-br.messages.style-operation-tag-missing-description":                             "a operação não tem tag",
+	"pt-br.messages.style-path-casing":                                                   "o segmento de path %q não está em %s no path %q: %s",
+	"pt-br.messages.style-path-casing-description":                                       "o segmento de path não segue a convenção de nomes",
+	"pt-br.messages.style-path-trailing-slash":                                           "o path %q termina com uma barra: %s",
+	"pt-br.messages.style-path-trailing-slash-description":                               "o path termina com uma barra",
+	"pt-br.messages.style-property-casing":                                               "a propriedade %q não está em %s: %s",
+	"pt-br.messages.style-property-casing-description":                                   "o nome da propriedade não segue a convenção de nomes",
+	"pt-br.messages.style-tag-undeclared":                                                "a tag %q de %s %s não está declarada nas tags da especificação: %s",
+	"pt-br.messages.style-tag-undeclared-description":                                    "a tag da operação não está declarada nas tags da especificação",
 	"pt-br.messages.sunset-deleted":                                                      "data de expiração da api excluída, mas deprecated=true mantido",
 	"pt-br.messages.sunset-deleted-description":                                          "data de expiração removida",
 	"pt-br.messages.total-changes":                                                       "%d alterações: %d %s, %d %s, %d %s\n",
 	"pt-br.messages.total-errors":                                                        "%d alterações críticas: %d %s, %d %s\n",
+	"pt-br.messages.unresolved-ref":                                                      "a referência %q não pode ser resolvida: %s",
+	"pt-br.messages.unresolved-ref-description":                                          "a referência não pode ser resolvida",
+	"ru.messages.ambiguous-endpoints":                                                    "эндпоинты %s %s и %s %s неоднозначны, потому что запрос может соответствовать обоим: %s и %s",
+	"ru.messages.ambiguous-endpoints-comment":                                            "Спецификация OpenAPI сопоставляет конкретные пути раньше шаблонных, но маршрутизаторы могут разрешать такие запросы по-разному.",
+	"ru.messages.ambiguous-endpoints-description":                                        "эндпоинты могут соответствовать одному и тому же запросу",
 	"ru.messages.api-deprecated-sunset-missing":                                          "API устарел без даты прекращения действия",
 	"ru.messages.api-deprecated-sunset-missing-description":                              "эндпоинт устарел без даты прекращения действия",
 	"ru.messages.api-deprecated-sunset-parse":                                            "не удалось проанализировать дату заката: %v",
@@ -1814,13 +2135,56 @@ var localizations = map[string]string{
 	"ru.messages.api-tag-removed":                                                        "Тег API %s удален",
 	"ru.messages.api-tag-removed-description":                                            "тег эндпоинта удален",
 	"ru.messages.at":                                                                  "в",
+	"ru.messages.duplicate-endpoint":                                                  "эндпоинт %s %s определён более одного раза, в строках %d и %d",
+	"ru.messages.duplicate-endpoint-comment":                                          "Загружается только последнее определение, остальные молча игнорируются.",
+	"ru.messages.duplicate-endpoint-description":                                      "эндпоинт определён более одного раза",
+	"ru.messages.duplicate-endpoint-param-names":                                      "эндпоинты %s %s и %s %s отличаются только именами параметров пути: %s и %s",
+	"ru.messages.duplicate-endpoint-param-names-comment":                              "oasdiff сопоставляет эндпоинты без учёта имён параметров пути и не может сравнить дублирующиеся эндпоинты. Добавьте расширение x-since-date, чтобы указать, какой эндпоинт новее, или используйте флаг --include-path-params, чтобы учитывать имена параметров пути.",
+	"ru.messages.duplicate-endpoint-param-names-description":                          "эндпоинты отличаются только именами параметров пути",
+	"ru.messages.duplicate-path":                                                      "путь %q определён более одного раза, в строках %d и %d",
+	"ru.messages.duplicate-path-comment":                                              "Загружается только последнее определение, остальные молча игнорируются.",
+	"ru.messages.duplicate-path-description":                                          "путь определён более одного раза",
+	"ru.messages.duplicate-property":                                                  "свойство %q определено более одного раза, в строках %d и %d: %s",
+	"ru.messages.duplicate-property-comment":                                          "Загружается только последнее определение, остальные молча игнорируются.",
+	"ru.messages.duplicate-property-description":                                      "свойство определено более одного раза",
+	"ru.messages.duplicate-required-property":                                         "свойство %q встречается в списке обязательных более одного раза: %s",
+	"ru.messages.duplicate-required-property-description":                             "свойство встречается в списке обязательных более одного раза",
 	"ru.messages.endpoint-added":                                                      "эндпоинт добавлен",
 	"ru.messages.endpoint-added-description":                                          "эндпоинт добавлен",
 	"ru.messages.endpoint-deprecated":                                                 "эндпоинт устарел",
 	"ru.messages.endpoint-deprecated-description":                                     "эндпоинт объявлен устаревшим",
 	"ru.messages.endpoint-reactivated":                                                "эндпоинт реактивирован",
 	"ru.messages.endpoint-reactivated-description":                                    "эндпоинт реактивирован (устаревание установлено в false)",
+	"ru.messages.extra_required_props":                                                "несуществующие свойства %v объявлены обязательными",
+	"ru.messages.extra_required_props-description":                                    "схема требует свойства, которые не определены",
+	"ru.messages.header-default-invalid":                                              "значение по умолчанию %s заголовка %q не соответствует его схеме: %s: %s",
+	"ru.messages.header-default-invalid-description":                                  "значение по умолчанию заголовка не соответствует схеме",
+	"ru.messages.header-example-invalid":                                              "пример %q заголовка %q не соответствует его схеме: %s: %s",
+	"ru.messages.header-example-invalid-description":                                  "пример заголовка не соответствует схеме",
+	"ru.messages.header-schema-and-content":                                           "заголовок %q определяет и schema, и content, которые взаимоисключающие: %s",
+	"ru.messages.header-schema-and-content-description":                               "заголовок определяет и schema, и content",
 	"ru.messages.in":                                                                  "в",
+	"ru.messages.info-invalid-contact-email":                                          "контактный email должен быть в формате адреса электронной почты: %s",
+	"ru.messages.info-invalid-contact-email-description":                              "контактный email не является допустимым адресом электронной почты",
+	"ru.messages.info-invalid-contact-url":                                            "контактный URL должен быть в формате URL: %s",
+	"ru.messages.info-invalid-contact-url-description":                                "контактный URL не является допустимым URL",
+	"ru.messages.info-invalid-license-url":                                            "URL лицензии должен быть в формате URL: %s",
+	"ru.messages.info-invalid-license-url-description":                                "URL лицензии не является допустимым URL",
+	"ru.messages.info-invalid-terms-of-service":                                       "условия обслуживания должны быть в формате URL: %s",
+	"ru.messages.info-invalid-terms-of-service-description":                           "условия обслуживания не являются допустимым URL",
+	"ru.messages.info-missing":                                                        "отсутствует объект info",
+	"ru.messages.info-missing-comment":                                                "Рекомендуется включать в спецификацию общую информацию о вашем API. Поля Title и Version обязательны.",
+	"ru.messages.info-missing-description":                                            "отсутствует объект info",
+	"ru.messages.info-title-missing":                                                  "отсутствует название API",
+	"ru.messages.info-title-missing-description":                                      "отсутствует название API",
+	"ru.messages.info-version-missing":                                                "отсутствует версия API",
+	"ru.messages.info-version-missing-description":                                    "отсутствует версия API",
+	"ru.messages.invalid-regex-pattern":                                               "недопустимое регулярное выражение: %s",
+	"ru.messages.invalid-regex-pattern-description":                                   "шаблон схемы не является допустимым регулярным выражением",
+	"ru.messages.json-schema-dialect-invalid":                                         "jsonSchemaDialect должен быть в формате URI: %s: %s",
+	"ru.messages.json-schema-dialect-invalid-description":                             "jsonSchemaDialect не является допустимым URI",
+	"ru.messages.media-type-example-invalid":                                          "пример %q типа содержимого %q не соответствует его схеме: %s: %s",
+	"ru.messages.media-type-example-invalid-description":                              "пример типа содержимого не соответствует схеме",
 	"ru.messages.new-optional-request-default-parameter-to-existing-path":             "добавлен новый необязательный %s параметр запроса %s ко всем операциям пути",
 	"ru.messages.new-optional-request-default-parameter-to-existing-path-description": "необязательный параметр запроса добавлен на уровне пути",
 	"ru.messages.new-optional-request-parameter":                                      "добавлен новый необязательный %s параметр зароса %s",
@@ -1839,10 +2203,31 @@ var localizations = map[string]string{
 	"ru.messages.new-required-request-property-description":                           "обязательное свойство добавлено к запросу",
 	"ru.messages.new-required-request-property-with-default":                          "добавлено новое обязательное поле запроса %s со значением по умолчанию",
 	"ru.messages.new-required-request-property-with-default-description":              "обязательное свойство со значением по умолчанию добавлено к запросу",
+	"ru.messages.openapi-schema-invalid":                                              "спецификация не соответствует схеме OpenAPI %s: %s: %s",
+	"ru.messages.openapi-schema-invalid-description":                                  "спецификация не соответствует JSON Schema OpenAPI",
 	"ru.messages.optional-response-header-removed":                                    "удалён ранее необязательный заголовок ответа %s для ответа со статусом %s",
 	"ru.messages.optional-response-header-removed-description":                        "необязательный заголовок ответа удален",
+	"ru.messages.parameter-default-invalid":                                           "значение по умолчанию %s %s параметра %q не соответствует его схеме: %s: %s",
+	"ru.messages.parameter-default-invalid-description":                               "значение по умолчанию параметра не соответствует схеме",
+	"ru.messages.parameter-example-invalid":                                           "пример %q %s параметра %q не соответствует его схеме: %s: %s",
+	"ru.messages.parameter-example-invalid-description":                               "пример параметра не соответствует схеме",
+	"ru.messages.parameter-schema-and-content":                                        "%s параметр %q определяет и schema, и content, которые взаимоисключающие: %s",
+	"ru.messages.parameter-schema-and-content-description":                            "параметр определяет и schema, и content",
+	"ru.messages.path-item-ref-siblings":                                              "путь %q определяет %s рядом со своим $ref, что приводит к неопределённому поведению: %s",
+	"ru.messages.path-item-ref-siblings-comment":                                      "Спецификация OpenAPI не определяет поведение, когда поле присутствует и в элементе пути, и в элементе пути, на который он ссылается. Перенесите эти поля в элемент пути, на который указывает ссылка.",
+	"ru.messages.path-item-ref-siblings-description":                                  "элемент пути определяет поля рядом со своим $ref",
+	"ru.messages.path-param-duplicate":                                                "параметр пути %q определён и в пути, и в операции: %s",
+	"ru.messages.path-param-duplicate-description":                                    "параметр пути определён и в пути, и в операции",
+	"ru.messages.path-param-extra":                                                    "параметр пути %q указан в разделе параметров (%s), но отсутствует в URL: %s",
+	"ru.messages.path-param-extra-description":                                        "параметр пути определён, но отсутствует в URL",
+	"ru.messages.path-param-missing":                                                  "параметр пути %q указан в URL, но отсутствует в разделе параметров пути и операции: %s",
+	"ru.messages.path-param-missing-description":                                      "параметр пути указан в URL, но не определён",
+	"ru.messages.path-param-not-required":                                             "параметр пути %q должен иметь required=true: %s",
+	"ru.messages.path-param-not-required-description":                                 "параметр пути не является обязательным",
 	"ru.messages.pattern-added-error-comment":                                         "Это критическое изменение, потому что добавление ограничения шаблона к ранее неограниченному параметру отклонит значения, которые ранее принимались, сломав существующих клиентов",
 	"ru.messages.pattern-changed-warn-comment":                                        "Это предупреждение, потому что сложно автоматически проанализировать, является ли новый шаблон надмножеством предыдущего шаблона (например, изменен с '[0-9]+' на '[0-9]*').",
+	"ru.messages.property-default-invalid":                                            "значение по умолчанию %s свойства %q не соответствует его схеме: %s: %s",
+	"ru.messages.property-default-invalid-description":                                "значение по умолчанию свойства не соответствует схеме",
 	"ru.messages.request-body-added-optional":                                         "добавлено необязательное тело запроса",
 	"ru.messages.request-body-added-optional-description":                             "добавлено необязательное тело запроса",
 	"ru.messages.request-body-added-required":                                         "добавлено обязательное тело запроса",
@@ -1865,6 +2250,8 @@ var localizations = map[string]string{
 	"ru.messages.request-body-became-optional-description":                            "тело запроса стало необязательным",
 	"ru.messages.request-body-became-required":                                        "тело запроса стало обязательным",
 	"ru.messages.request-body-became-required-description":                            "тело запроса стало обязательным",
+	"ru.messages.request-body-counterexample-found":                                   "схема тела запроса %s отклоняет данные, которые принимает базовая схема: %s",
+	"ru.messages.request-body-counterexample-found-description":                       "данные, сгенерированные из базовой схемы тела запроса, отклоняются новой схемой",
 	"ru.messages.request-body-default-value-added":                                    "добавлено значение по умолчанию %s для тела запроса",
 	"ru.messages.request-body-default-value-added-description":                        "установлено значение по умолчанию тела запроса",
 	"ru.messages.request-body-default-value-changed":                                  "значение по умолчанию для тела запроса изменено с %s на %s",
@@ -1885,6 +2272,8 @@ var localizations = map[string]string{
 	"ru.messages.request-body-discriminator-removed-description":                      "удален дискриминатор тела запроса",
 	"ru.messages.request-body-enum-value-removed":                                     "значение перечисления тела запроса удалено %s",
 	"ru.messages.request-body-enum-value-removed-description":                         "удалено enum значение тела запроса",
+	"ru.messages.request-body-example-invalidated":                                    "пример %s тела запроса %s больше не соответствует схеме тела запроса: %s",
+	"ru.messages.request-body-example-invalidated-description":                        "пример тела запроса больше не соответствует схеме",
 	"ru.messages.request-body-list-of-types-narrowed":                                 "список типов тела запроса был сужен удалением типов %s из медиа-типа %s",
 	"ru.messages.request-body-list-of-types-widened":                                  "список типов тела запроса был расширен добавлением типов %s к медиа-типу %s",
 	"ru.messages.request-body-max-decreased":                                          "значение max у тела запроса уменьшено до %s",
@@ -1961,6 +2350,8 @@ var localizations = map[string]string{
 	"ru.messages.request-parameter-enum-value-added-description":                      "добавлено enum значение параметра запроса",
 	"ru.messages.request-parameter-enum-value-removed":                                "удалено значение enum %s у %s параметра запроса %s",
 	"ru.messages.request-parameter-enum-value-removed-description":                    "удалено enum значение параметра запроса",
+	"ru.messages.request-parameter-example-invalidated":                               "пример %s %s параметра запроса %s больше не соответствует схеме параметра: %s",
+	"ru.messages.request-parameter-example-invalidated-description":                   "пример параметра запроса больше не соответствует схеме",
 	"ru.messages.request-parameter-list-of-types-narrowed":                            "список типов %s параметра запроса %s был сужен удалением типов %s",
 	"ru.messages.request-parameter-list-of-types-widened":                             "список типов %s параметра запроса %s был расширен добавлением типов %s",
 	"ru.messages.request-parameter-max-decreased":                                     "в %s параметре запроса %s, max уменьшен с %s до %s",
@@ -2143,6 +2534,11 @@ var localizations = map[string]string{
 	"ru.messages.request-required-property-became-read-only-description":              "обязательное свойство запроса стало только для чтения",
 	"ru.messages.request-required-property-became-write-only":                         "обязательное поле запроса %s стало только для записи",
 	"ru.messages.request-required-property-became-write-only-description":             "обязательное свойство запроса стало только для записи",
+	"ru.messages.required-param-with-default":                                         "обязательный параметр %s %q не должен иметь значения по умолчанию: %s",
+	"ru.messages.required-param-with-default-description":                             "обязательный параметр имеет значение по умолчанию",
+	"ru.messages.required-property-with-default":                                      "обязательное свойство %q не должно иметь значения по умолчанию: %s",
+	"ru.messages.required-property-with-default-comment":                              "Значение по умолчанию никогда не используется, потому что клиент всегда должен передавать значение обязательного свойства.",
+	"ru.messages.required-property-with-default-description":                          "обязательное свойство имеет значение по умолчанию",
 	"ru.messages.required-response-header-removed":                                    "удалён ранее обязательный заголовок ответа %s для ответа со статусом %s",
 	"ru.messages.required-response-header-removed-description":                        "удален обязательный заголовок ответа",
 	"ru.messages.response-body-all-of-added":                                          "добавлено %s в список 'allOf' тела ответа для статуса ответа %s",
@@ -2155,6 +2551,8 @@ var localizations = map[string]string{
 	"ru.messages.response-body-any-of-removed-description":                            "подсхема удалена из anyOf в теле ответа",
 	"ru.messages.response-body-became-nullable":                                       "у тела ответа стало обнуляемым",
 	"ru.messages.response-body-became-nullable-description":                           "тело ответа стало обнуляемым",
+	"ru.messages.response-body-counterexample-found":                                  "тело ответа %s для статуса %s может содержать данные, которые отклоняет базовая схема: %s",
+	"ru.messages.response-body-counterexample-found-description":                      "данные, сгенерированные из новой схемы тела ответа, отклоняются базовой схемой",
 	"ru.messages.response-body-default-value-added":                                   "добавлено значение по умолчанию %s для тела ответа для статуса %s",
 	"ru.messages.response-body-default-value-added-description":                       "установлено значение по умолчанию тела ответа",
 	"ru.messages.response-body-default-value-changed":                                 "значение по умолчанию для тела ответа %s изменено с %s на %s для статуса %s",
@@ -2173,6 +2571,8 @@ var localizations = map[string]string{
 	"ru.messages.response-body-discriminator-property-name-changed-description":       "изменено имя свойства дискриминатора тела ответа",
 	"ru.messages.response-body-discriminator-removed":                                 "удален дискриминатор ответа для статуса ответа %s",
 	"ru.messages.response-body-discriminator-removed-description":                     "удален дискриминатор тела ответа",
+	"ru.messages.response-body-example-invalidated":                                   "пример %s тела ответа %s для статуса %s больше не соответствует схеме ответа: %s",
+	"ru.messages.response-body-example-invalidated-description":                       "пример тела ответа больше не соответствует схеме",
 	"ru.messages.response-body-list-of-types-narrowed":                                "список типов тела ответа был сужен удалением типов %s из медиа-типа %s ответа %s",
 	"ru.messages.response-body-list-of-types-widened":                                 "список типов тела ответа был расширен добавлением типов %s к медиа-типу %s ответа %s",
 	"ru.messages.response-body-max-increased":                                         "у тела ответа max увеличен с %s до %s",
@@ -2319,10 +2719,34 @@ var localizations = map[string]string{
 	"ru.messages.response-write-only-property-became-required-description":            "свойство ответа только для записи стало обязательным",
 	"ru.messages.response-write-only-property-enum-value-added":                       "добавлено значение enum %s для свойства только для записи %s в ответе со статусом %s",
 	"ru.messages.response-write-only-property-enum-value-added-description":           "добавлено enum значение свойства ответа только для записи",
+	"ru.messages.schema-default-invalid":                                              "значение по умолчанию %s не соответствует его схеме: %s: %s",
+	"ru.messages.schema-default-invalid-description":                                  "значение по умолчанию схемы не соответствует схеме",
+	"ru.messages.schema-example-invalid":                                              "пример схемы не соответствует его схеме: %s: %s",
+	"ru.messages.schema-example-invalid-description":                                  "пример схемы не соответствует схеме",
+	"ru.messages.style-collection-not-plural":                                         "коллекция %q в пути %q должна быть во множественном числе: %s",
+	"ru.messages.style-collection-not-plural-description":                             "имя коллекции не во множественном числе",
+	"ru.messages.style-error-schema":                                                  "ответ %s у %s %s не использует общую схему ошибки %s: %s",
+	"ru.messages.style-error-schema-description":                                      "ответ с ошибкой не использует общую схему ошибки",
+	"ru.messages.style-operation-id-duplicate":                                        "operationId %q у %s %s уже используется %s: %s",
+	"ru.messages.style-operation-id-duplicate-description":                            "operationId используется более чем одной операцией",
+	"ru.messages.style-operation-id-missing":                                          "у %s %s нет operationId: %s",
+	"ru.messages.style-operation-id-missing-description":                              "у операции нет operationId",
+	"ru.messages.style-operation-tag-missing":                                         "у %s %s нет тега: %s",
+	"ru.messages.style-operation-tag-missing-description":                             "у операции нет тега",
+	"ru.messages.style-path-casing":                                                   "сегмент пути %q не в стиле %s в пути %q: %s",
+	"ru.messages.style-path-casing-description":                                       "сегмент пути не соответствует соглашению об именовании",
+	"ru.messages.style-path-trailing-slash":                                           "путь %q заканчивается косой чертой: %s",
+	"ru.messages.style-path-trailing-slash-description":                               "путь заканчивается косой чертой",
+	"ru.messages.style-property-casing":                                               "свойство %q не в стиле %s: %s",
+	"ru.messages.style-property-casing-description":                                   "имя свойства не соответствует соглашению об именовании",
+	"ru.messages.style-tag-undeclared":                                                "тег %q у %s %s не объявлен в тегах спецификации: %s",
+	"ru.messages.style-tag-undeclared-description":                                    "тег операции не объявлен в тегах спецификации",
 	"ru.messages.sunset-deleted":                                                      "удалена дата sunset date у API, но сохранён deprecated=true",
 	"ru.messages.sunset-deleted-description":                                          "дата прекращения действия удалена",
 	"ru.messages.total-changes":                                                       "%d изменений: %d %s, %d %s, %d %s\n",
 	"ru.messages.total-errors":                                                        "%d критические изменения: %d %s, %d %s\n",
+	"ru.messages.unresolved-ref":                                                      "ссылку %q невозможно разрешить: %s",
+	"ru.messages.unresolved-ref-description":                                          "ссылку невозможно разрешить",
 }
 
 type Replacements map[string]interface{}
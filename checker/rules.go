@@ -14,6 +14,16 @@ const (
 	DirectionNone
 )
 
+var directionNames = map[Direction]string{
+	DirectionRequest:  "request",
+	DirectionResponse: "response",
+}
+
+// String returns the name of the direction as used in the check tags, or an empty string for DirectionNone
+func (direction Direction) String() string {
+	return directionNames[direction]
+}
+
 type Location int8
 
 const (
@@ -26,6 +36,20 @@ const (
 	LocationNone
 )
 
+var locationNames = map[Location]string{
+	LocationBody:       "body",
+	LocationParameters: "parameters",
+	LocationProperties: "properties",
+	LocationHeaders:    "headers",
+	LocationSecurity:   "security",
+	LocationComponents: "components",
+}
+
+// String returns the name of the location as used in the check tags, or an empty string for LocationNone
+func (location Location) String() string {
+	return locationNames[location]
+}
+
 type Action int8
 
 const (
@@ -40,6 +64,22 @@ const (
 	ActionNone
 )
 
+var actionNames = map[Action]string{
+	ActionAdd:        "add",
+	ActionRemove:     "remove",
+	ActionChange:     "change",
+	ActionGeneralize: "generalize",
+	ActionSpecialize: "specialize",
+	ActionIncrease:   "increase",
+	ActionDecrease:   "decrease",
+	ActionSet:        "set",
+}
+
+// String returns the name of the action as used in the check tags, or an empty string for ActionNone
+func (action Action) String() string {
+	return actionNames[action]
+}
+
 type BackwardCompatibilityRule struct {
 	Id          string
 	Level       Level
@@ -459,6 +499,13 @@ func GetAllRules() BackwardCompatibilityRules {
 		newBackwardCompatibilityRule(RequestParameterListOfTypesNarrowedId, ERR, RequestParameterListOfTypesChangedCheck, DirectionRequest, LocationParameters, ActionRemove),
 		newBackwardCompatibilityRule(RequestParameterPropertyListOfTypesWidenedId, INFO, RequestParameterListOfTypesChangedCheck, DirectionRequest, LocationParameters, ActionAdd),
 		newBackwardCompatibilityRule(RequestParameterPropertyListOfTypesNarrowedId, ERR, RequestParameterListOfTypesChangedCheck, DirectionRequest, LocationParameters, ActionRemove),
+		// ExamplesInvalidatedCheck
+		newBackwardCompatibilityRule(RequestParameterExampleInvalidatedId, ERR, ExamplesInvalidatedCheck, DirectionRequest, LocationParameters, ActionChange),
+		newBackwardCompatibilityRule(RequestBodyExampleInvalidatedId, ERR, ExamplesInvalidatedCheck, DirectionRequest, LocationBody, ActionChange),
+		newBackwardCompatibilityRule(ResponseBodyExampleInvalidatedId, WARN, ExamplesInvalidatedCheck, DirectionResponse, LocationBody, ActionChange),
+		// CounterexamplesCheck
+		newBackwardCompatibilityRule(RequestBodyCounterexampleFoundId, INFO, CounterexamplesCheck, DirectionRequest, LocationBody, ActionChange),   // optional
+		newBackwardCompatibilityRule(ResponseBodyCounterexampleFoundId, INFO, CounterexamplesCheck, DirectionResponse, LocationBody, ActionChange), // optional
 	}
 }
 
@@ -471,6 +518,8 @@ func GetOptionalRules() BackwardCompatibilityRules {
 		newBackwardCompatibilityRule(ResponsePropertyEnumValueRemovedId, INFO, ResponseParameterEnumValueRemovedCheck, DirectionResponse, LocationProperties, ActionRemove),
 		newBackwardCompatibilityRule(ResponseMediaTypeEnumValueRemovedId, INFO, ResponseMediaTypeEnumValueRemovedCheck, DirectionResponse, LocationBody, ActionRemove),
 		newBackwardCompatibilityRule(RequestBodyEnumValueRemovedId, INFO, RequestBodyEnumValueRemovedCheck, DirectionRequest, LocationBody, ActionRemove),
+		newBackwardCompatibilityRule(RequestBodyCounterexampleFoundId, INFO, CounterexamplesCheck, DirectionRequest, LocationBody, ActionChange),
+		newBackwardCompatibilityRule(ResponseBodyCounterexampleFoundId, INFO, CounterexamplesCheck, DirectionResponse, LocationBody, ActionChange),
 	}
 }
 
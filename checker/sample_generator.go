@@ -0,0 +1,374 @@
+package checker
+
+import (
+	"encoding/json"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oasdiff/oasdiff/utils"
+)
+
+const (
+	// maxSampleDepth limits the nesting of generated samples
+	maxSampleDepth = 8
+	// maxSamples limits the number of samples generated for each schema
+	maxSamples = 64
+	// maxSampleCalls limits the number of schemas visited while generating the samples of a payload
+	maxSampleCalls = 10000
+	// maxSampleLength limits the length of generated strings
+	maxSampleLength = 1024
+)
+
+var formatSamples = map[string]string{
+	"date":      "2024-01-01",
+	"date-time": "2024-01-01T00:00:00Z",
+	"time":      "00:00:00",
+	"email":     "user@example.com",
+	"hostname":  "example.com",
+	"ipv4":      "192.168.0.1",
+	"ipv6":      "::1",
+	"uri":       "https://example.com",
+	"url":       "https://example.com",
+	"uuid":      "00000000-0000-0000-0000-000000000000",
+	"byte":      "c2FtcGxl",
+}
+
+// generateSamples returns instances of the schema that are valid against it
+// request determines whether the samples are request payloads (without read-only properties) or response payloads (without write-only properties)
+func generateSamples(schema *openapi3.Schema, request bool) []any {
+	if schema == nil {
+		return nil
+	}
+
+	opts := getSampleValidationOptions(request)
+	result := []any{}
+	seen := utils.StringSet{}
+	for _, sample := range newSampleGenerator(request).generate(schema, 0) {
+		key, err := json.Marshal(sample)
+		if err != nil || seen.Contains(string(key)) {
+			continue
+		}
+		seen.Add(string(key))
+
+		if schema.VisitJSON(sample, opts...) != nil {
+			continue
+		}
+		result = append(result, sample)
+	}
+	return result
+}
+
+func getSampleValidationOptions(request bool) []openapi3.SchemaValidationOption {
+	if request {
+		return []openapi3.SchemaValidationOption{openapi3.VisitAsRequest()}
+	}
+	return []openapi3.SchemaValidationOption{openapi3.VisitAsResponse()}
+}
+
+// sampleGenerator generates candidate instances of a schema: enum values, boundary values and variants of composed schemas
+// candidates may be invalid, generateSamples filters them by validating against the schema
+type sampleGenerator struct {
+	request  bool
+	visiting map[*openapi3.Schema]bool // the schemas on the current path, a schema that references itself isn't expanded again
+	calls    int                       // the number of schemas visited so far, limited by maxSampleCalls
+}
+
+func newSampleGenerator(request bool) *sampleGenerator {
+	return &sampleGenerator{
+		request:  request,
+		visiting: map[*openapi3.Schema]bool{},
+	}
+}
+
+func (g *sampleGenerator) generate(schema *openapi3.Schema, depth int) []any {
+	if schema == nil || depth > maxSampleDepth || g.visiting[schema] || g.calls >= maxSampleCalls {
+		return nil
+	}
+	g.calls++
+	g.visiting[schema] = true
+	defer delete(g.visiting, schema)
+
+	result := []any{}
+	if schema.Example != nil {
+		result = append(result, schema.Example)
+	}
+	if schema.Default != nil {
+		result = append(result, schema.Default)
+	}
+	if schema.Nullable {
+		result = append(result, nil)
+	}
+	if len(schema.Enum) > 0 {
+		return limitSamples(append(result, schema.Enum...))
+	}
+
+	if len(schema.OneOf) > 0 || len(schema.AnyOf) > 0 {
+		// the properties of the parent are generated once and combined with each branch
+		parentSamples := g.generateByType(schema, depth)
+		for _, schemaRef := range schema.OneOf {
+			result = append(result, g.generateBranch(parentSamples, schemaRef, depth)...)
+		}
+		for _, schemaRef := range schema.AnyOf {
+			result = append(result, g.generateBranch(parentSamples, schemaRef, depth)...)
+		}
+	}
+
+	if len(schema.AllOf) > 0 {
+		result = append(result, g.generateAllOf(schema, depth)...)
+	} else {
+		result = append(result, g.generateByType(schema, depth)...)
+	}
+
+	return limitSamples(result)
+}
+
+// generateBranch generates samples of a oneOf/anyOf branch combined with the first sample of the parent schema
+func (g *sampleGenerator) generateBranch(parentSamples []any, branch *openapi3.SchemaRef, depth int) []any {
+	if branch == nil {
+		return nil
+	}
+
+	branchSamples := g.generate(branch.Value, depth+1)
+	if len(parentSamples) == 0 {
+		return branchSamples
+	}
+
+	result := make([]any, 0, len(branchSamples))
+	for _, sample := range branchSamples {
+		result = append(result, mergeSamples(parentSamples[0], sample))
+	}
+	return result
+}
+
+// generateAllOf merges the first sample of each subschema and then varies one subschema at a time
+func (g *sampleGenerator) generateAllOf(schema *openapi3.Schema, depth int) []any {
+	parts := [][]any{}
+	if own := g.generateByType(schema, depth); len(own) > 0 {
+		parts = append(parts, own)
+	}
+	for _, schemaRef := range schema.AllOf {
+		if schemaRef == nil {
+			continue
+		}
+		samples := g.generate(schemaRef.Value, depth+1)
+		if len(samples) == 0 {
+			continue
+		}
+		parts = append(parts, samples)
+	}
+
+	if len(parts) == 0 {
+		return nil
+	}
+
+	merge := func(variantPart int, variant any) any {
+		var result any
+		for i, part := range parts {
+			if i == variantPart {
+				result = mergeSamples(result, variant)
+			} else {
+				result = mergeSamples(result, part[0])
+			}
+		}
+		return result
+	}
+
+	result := []any{merge(-1, nil)}
+	for i, part := range parts {
+		for _, variant := range part[1:] {
+			result = append(result, merge(i, variant))
+		}
+	}
+	return result
+}
+
+func (g *sampleGenerator) generateByType(schema *openapi3.Schema, depth int) []any {
+	result := []any{}
+	for _, typ := range getSampleTypes(schema) {
+		switch typ {
+		case openapi3.TypeObject:
+			result = append(result, g.generateObject(schema, depth)...)
+		case openapi3.TypeArray:
+			result = append(result, g.generateArray(schema, depth)...)
+		case openapi3.TypeString:
+			result = append(result, generateStrings(schema)...)
+		case openapi3.TypeInteger:
+			result = append(result, generateNumbers(schema, true)...)
+		case openapi3.TypeNumber:
+			result = append(result, generateNumbers(schema, false)...)
+		case openapi3.TypeBoolean:
+			result = append(result, true, false)
+		case openapi3.TypeNull:
+			result = append(result, nil)
+		}
+	}
+	return result
+}
+
+func getSampleTypes(schema *openapi3.Schema) []string {
+	if schema.Type != nil {
+		return schema.Type.Slice()
+	}
+	if len(schema.Properties) > 0 {
+		return []string{openapi3.TypeObject}
+	}
+	if schema.Items != nil {
+		return []string{openapi3.TypeArray}
+	}
+	return nil
+}
+
+// generateObject generates an object with the required properties, an object with all properties, and variants of the latter with other property values
+func (g *sampleGenerator) generateObject(schema *openapi3.Schema, depth int) []any {
+	required := utils.StringList(schema.Required).ToStringSet()
+	minimal := map[string]any{}
+	full := map[string]any{}
+	variants := map[string][]any{}
+
+	names := make([]string, 0, len(schema.Properties))
+	for name := range schema.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		property := schema.Properties[name]
+		if property == nil || property.Value == nil {
+			continue
+		}
+		if g.request && property.Value.ReadOnly || !g.request && property.Value.WriteOnly {
+			continue
+		}
+
+		values := g.generate(property.Value, depth+1)
+		if len(values) == 0 {
+			if required.Contains(name) {
+				return nil
+			}
+			continue
+		}
+
+		variants[name] = values
+		full[name] = values[0]
+		if required.Contains(name) {
+			minimal[name] = values[0]
+		}
+	}
+
+	result := []any{minimal, full}
+	for _, name := range names {
+		if len(variants[name]) < 2 {
+			continue
+		}
+		for _, value := range variants[name][1:] {
+			variant := copySample(full)
+			variant[name] = value
+			result = append(result, variant)
+		}
+	}
+	return result
+}
+
+func (g *sampleGenerator) generateArray(schema *openapi3.Schema, depth int) []any {
+	result := []any{}
+	if schema.MinItems == 0 {
+		result = append(result, []any{})
+	}
+
+	if schema.Items == nil {
+		return result
+	}
+
+	length := max(schema.MinItems, 1)
+	for _, value := range g.generate(schema.Items.Value, depth+1) {
+		array := make([]any, length)
+		for i := range array {
+			array[i] = value
+		}
+		result = append(result, array)
+	}
+	return result
+}
+
+func generateStrings(schema *openapi3.Schema) []any {
+	if value, ok := formatSamples[schema.Format]; ok {
+		return []any{value}
+	}
+
+	result := []any{"sample"}
+	if schema.MinLength > 0 {
+		result = append(result, strings.Repeat("a", int(min(schema.MinLength, maxSampleLength))))
+	}
+	if schema.MaxLength != nil {
+		result = append(result, strings.Repeat("a", int(min(*schema.MaxLength, maxSampleLength))))
+	}
+	return result
+}
+
+func generateNumbers(schema *openapi3.Schema, integer bool) []any {
+	step := 0.5
+	if integer {
+		step = 1
+	}
+
+	result := []any{float64(0)}
+	if schema.Min != nil {
+		value := *schema.Min
+		if integer {
+			value = math.Ceil(value)
+		}
+		if schema.ExclusiveMin {
+			value += step
+		}
+		result = append(result, value)
+	}
+	if schema.Max != nil {
+		value := *schema.Max
+		if integer {
+			value = math.Floor(value)
+		}
+		if schema.ExclusiveMax {
+			value -= step
+		}
+		result = append(result, value)
+	}
+	if schema.MultipleOf != nil {
+		result = append(result, *schema.MultipleOf)
+	}
+	return result
+}
+
+// mergeSamples merges the properties of two object samples, if one of them isn't an object the non-nil one is returned
+func mergeSamples(a, b any) any {
+	aMap, aOk := a.(map[string]any)
+	bMap, bOk := b.(map[string]any)
+	if !aOk || !bOk {
+		if a == nil {
+			return b
+		}
+		return a
+	}
+
+	result := copySample(aMap)
+	for key, value := range bMap {
+		result[key] = mergeSamples(result[key], value)
+	}
+	return result
+}
+
+func copySample(sample map[string]any) map[string]any {
+	result := make(map[string]any, len(sample))
+	for key, value := range sample {
+		result[key] = value
+	}
+	return result
+}
+
+func limitSamples(samples []any) []any {
+	if len(samples) > maxSamples {
+		return samples[:maxSamples]
+	}
+	return samples
+}
@@ -0,0 +1,25 @@
+#!/bin/sh
+# an example plugin: reports a change for each endpoint in the diff that it was given
+request=$(cat)
+case "$request" in
+  *'"/v1/pets"'*) ;;
+  *) echo "unexpected request" >&2; exit 1 ;;
+esac
+case "$request" in
+  *'"openapi":"3.0.1"'*) ;;
+  *) echo "missing specs" >&2; exit 1 ;;
+esac
+cat <<'RESPONSE'
+{
+  "changes": [
+    {
+      "id": "v1-endpoint-changed",
+      "level": "warn",
+      "path": "/v1/pets",
+      "operation": "get",
+      "message": "v1 endpoints are frozen",
+      "location": "responses/200"
+    }
+  ]
+}
+RESPONSE
@@ -0,0 +1,4 @@
+#!/bin/sh
+cat > /dev/null
+echo "something went wrong" >&2
+exit 2
@@ -0,0 +1,3 @@
+#!/bin/sh
+cat > /dev/null
+echo '{"changes":[{"id":"my-change","level":"critical","message":"my change"}]}'
@@ -0,0 +1,16 @@
+package bundle
+
+import (
+	"context"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// Bundle adds the objects referenced by external $refs to the components of the spec and replaces the external $refs by local ones
+// Components are named after the file and the location of the referenced object, for example pets_Pet for pets.yaml#/components/schemas/Pet
+func Bundle(spec *openapi3.T) {
+	if spec == nil {
+		return
+	}
+	spec.InternalizeRefs(context.Background(), openapi3.DefaultRefNameResolver)
+}
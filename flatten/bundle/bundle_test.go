@@ -0,0 +1,26 @@
+package bundle_test
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oasdiff/oasdiff/load"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBundle(t *testing.T) {
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	spec, err := load.NewSpecInfo(loader, load.NewSource("../../data/flatten/bundle/openapi.yaml"), load.WithBundle())
+	require.NoError(t, err)
+
+	op := spec.Spec.Paths.Value("/pets").Get
+	require.Equal(t, "#/components/parameters/pets_Limit", op.Parameters[0].Ref)
+	require.Equal(t, "#/components/schemas/pets_Pet", op.Responses.Value("200").Value.Content["application/json"].Schema.Value.Items.Ref)
+
+	schemas := spec.Spec.Components.Schemas
+	require.Contains(t, schemas, "Error")
+	require.Equal(t, "#/components/schemas/pets_Owner", schemas["pets_Pet"].Value.Properties["owner"].Ref)
+	require.True(t, schemas["pets_Owner"].Value.Properties["name"].Value.Type.Is("string"))
+	require.Equal(t, "limit", spec.Spec.Components.Parameters["pets_Limit"].Value.Name)
+}
@@ -0,0 +1,5 @@
+/*
+Package bundle moves the objects that are referenced in external files into the components of the spec
+The result is a single document with local references only
+*/
+package bundle
@@ -0,0 +1,258 @@
+package deref
+
+import (
+	"slices"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// CircularRefExtension is the extension of the placeholder that replaces a circular schema reference
+// Its value is the original reference, for example #/components/schemas/Node
+const CircularRefExtension = "x-circular-ref"
+
+// Dereference replaces all $refs in the spec by copies of the objects that they reference
+// A schema that references itself, directly or indirectly, can't be expanded, so the circular reference is replaced by an empty schema with the CircularRefExtension
+func Dereference(spec *openapi3.T) {
+	if spec == nil {
+		return
+	}
+
+	d := dereferencer{}
+
+	if spec.Paths != nil {
+		for _, pathItem := range spec.Paths.Map() {
+			d.pathItem(pathItem)
+		}
+	}
+
+	if components := spec.Components; components != nil {
+		for name, schema := range components.Schemas {
+			components.Schemas[name] = d.schemaRef(schema)
+		}
+		for name, parameter := range components.Parameters {
+			components.Parameters[name] = d.parameterRef(parameter)
+		}
+		for name, header := range components.Headers {
+			components.Headers[name] = d.headerRef(header)
+		}
+		for name, requestBody := range components.RequestBodies {
+			components.RequestBodies[name] = d.requestBodyRef(requestBody)
+		}
+		for name, response := range components.Responses {
+			components.Responses[name] = d.responseRef(response)
+		}
+		for name, securityScheme := range components.SecuritySchemes {
+			if securityScheme != nil {
+				components.SecuritySchemes[name] = &openapi3.SecuritySchemeRef{Value: securityScheme.Value}
+			}
+		}
+		components.Examples = d.examples(components.Examples)
+		components.Links = d.links(components.Links)
+		components.Callbacks = d.callbacks(components.Callbacks)
+	}
+}
+
+// dereferencer keeps the schemas that are being expanded, to detect circular references
+type dereferencer struct {
+	schemas   []*openapi3.Schema
+	pathItems []*openapi3.PathItem
+}
+
+func (d *dereferencer) pathItem(pathItem *openapi3.PathItem) {
+	if pathItem == nil || slices.Contains(d.pathItems, pathItem) {
+		return
+	}
+	d.pathItems = append(d.pathItems, pathItem)
+	defer func() { d.pathItems = d.pathItems[:len(d.pathItems)-1] }()
+
+	pathItem.Ref = ""
+	pathItem.Parameters = d.parameters(pathItem.Parameters)
+	for _, op := range pathItem.Operations() {
+		op.Parameters = d.parameters(op.Parameters)
+		op.RequestBody = d.requestBodyRef(op.RequestBody)
+		if op.Responses != nil {
+			for status, response := range op.Responses.Map() {
+				op.Responses.Set(status, d.responseRef(response))
+			}
+		}
+		op.Callbacks = d.callbacks(op.Callbacks)
+	}
+}
+
+func (d *dereferencer) schemaRef(ref *openapi3.SchemaRef) *openapi3.SchemaRef {
+	if ref == nil || ref.Value == nil {
+		return ref
+	}
+
+	if slices.Contains(d.schemas, ref.Value) {
+		return &openapi3.SchemaRef{Value: &openapi3.Schema{Extensions: map[string]any{CircularRefExtension: ref.Ref}}}
+	}
+	d.schemas = append(d.schemas, ref.Value)
+	defer func() { d.schemas = d.schemas[:len(d.schemas)-1] }()
+
+	schema := *ref.Value
+	schema.OneOf = d.schemaRefs(schema.OneOf)
+	schema.AnyOf = d.schemaRefs(schema.AnyOf)
+	schema.AllOf = d.schemaRefs(schema.AllOf)
+	schema.Not = d.schemaRef(schema.Not)
+	schema.Items = d.schemaRef(schema.Items)
+	schema.AdditionalProperties.Schema = d.schemaRef(schema.AdditionalProperties.Schema)
+	if schema.Properties != nil {
+		properties := make(openapi3.Schemas, len(schema.Properties))
+		for name, property := range schema.Properties {
+			properties[name] = d.schemaRef(property)
+		}
+		schema.Properties = properties
+	}
+
+	return &openapi3.SchemaRef{Value: &schema}
+}
+
+func (d *dereferencer) schemaRefs(refs openapi3.SchemaRefs) openapi3.SchemaRefs {
+	if refs == nil {
+		return nil
+	}
+	result := make(openapi3.SchemaRefs, len(refs))
+	for i, ref := range refs {
+		result[i] = d.schemaRef(ref)
+	}
+	return result
+}
+
+func (d *dereferencer) parameters(parameters openapi3.Parameters) openapi3.Parameters {
+	if parameters == nil {
+		return nil
+	}
+	result := make(openapi3.Parameters, len(parameters))
+	for i, parameter := range parameters {
+		result[i] = d.parameterRef(parameter)
+	}
+	return result
+}
+
+func (d *dereferencer) parameterRef(ref *openapi3.ParameterRef) *openapi3.ParameterRef {
+	if ref == nil || ref.Value == nil {
+		return ref
+	}
+	return &openapi3.ParameterRef{Value: d.parameter(ref.Value)}
+}
+
+func (d *dereferencer) parameter(parameter *openapi3.Parameter) *openapi3.Parameter {
+	result := *parameter
+	result.Schema = d.schemaRef(result.Schema)
+	result.Content = d.content(result.Content)
+	result.Examples = d.examples(result.Examples)
+	return &result
+}
+
+func (d *dereferencer) headerRef(ref *openapi3.HeaderRef) *openapi3.HeaderRef {
+	if ref == nil || ref.Value == nil {
+		return ref
+	}
+	return &openapi3.HeaderRef{Value: &openapi3.Header{Parameter: *d.parameter(&ref.Value.Parameter)}}
+}
+
+func (d *dereferencer) headers(headers openapi3.Headers) openapi3.Headers {
+	if headers == nil {
+		return nil
+	}
+	result := make(openapi3.Headers, len(headers))
+	for name, header := range headers {
+		result[name] = d.headerRef(header)
+	}
+	return result
+}
+
+func (d *dereferencer) requestBodyRef(ref *openapi3.RequestBodyRef) *openapi3.RequestBodyRef {
+	if ref == nil || ref.Value == nil {
+		return ref
+	}
+	requestBody := *ref.Value
+	requestBody.Content = d.content(requestBody.Content)
+	return &openapi3.RequestBodyRef{Value: &requestBody}
+}
+
+func (d *dereferencer) responseRef(ref *openapi3.ResponseRef) *openapi3.ResponseRef {
+	if ref == nil || ref.Value == nil {
+		return ref
+	}
+	response := *ref.Value
+	response.Headers = d.headers(response.Headers)
+	response.Content = d.content(response.Content)
+	response.Links = d.links(response.Links)
+	return &openapi3.ResponseRef{Value: &response}
+}
+
+func (d *dereferencer) content(content openapi3.Content) openapi3.Content {
+	if content == nil {
+		return nil
+	}
+	result := make(openapi3.Content, len(content))
+	for mediaType, value := range content {
+		if value == nil {
+			continue
+		}
+		copied := *value
+		copied.Schema = d.schemaRef(copied.Schema)
+		copied.Examples = d.examples(copied.Examples)
+		if copied.Encoding != nil {
+			encodings := make(map[string]*openapi3.Encoding, len(copied.Encoding))
+			for name, encoding := range copied.Encoding {
+				if encoding != nil {
+					copiedEncoding := *encoding
+					copiedEncoding.Headers = d.headers(copiedEncoding.Headers)
+					encoding = &copiedEncoding
+				}
+				encodings[name] = encoding
+			}
+			copied.Encoding = encodings
+		}
+		result[mediaType] = &copied
+	}
+	return result
+}
+
+func (d *dereferencer) examples(examples openapi3.Examples) openapi3.Examples {
+	if examples == nil {
+		return nil
+	}
+	result := make(openapi3.Examples, len(examples))
+	for name, example := range examples {
+		if example != nil {
+			example = &openapi3.ExampleRef{Value: example.Value}
+		}
+		result[name] = example
+	}
+	return result
+}
+
+func (d *dereferencer) links(links openapi3.Links) openapi3.Links {
+	if links == nil {
+		return nil
+	}
+	result := make(openapi3.Links, len(links))
+	for name, link := range links {
+		if link != nil {
+			link = &openapi3.LinkRef{Value: link.Value}
+		}
+		result[name] = link
+	}
+	return result
+}
+
+func (d *dereferencer) callbacks(callbacks openapi3.Callbacks) openapi3.Callbacks {
+	if callbacks == nil {
+		return nil
+	}
+	result := make(openapi3.Callbacks, len(callbacks))
+	for name, callback := range callbacks {
+		if callback != nil && callback.Value != nil {
+			for _, pathItem := range callback.Value.Map() {
+				d.pathItem(pathItem)
+			}
+			callback = &openapi3.CallbackRef{Value: callback.Value}
+		}
+		result[name] = callback
+	}
+	return result
+}
@@ -0,0 +1,73 @@
+package deref_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oasdiff/oasdiff/flatten/deref"
+	"github.com/oasdiff/oasdiff/load"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDereference(t *testing.T) {
+	spec, err := load.NewSpecInfo(openapi3.NewLoader(), load.NewSource("../../data/flatten/deref.yaml"), load.WithDereference())
+	require.NoError(t, err)
+
+	data, err := json.Marshal(spec.Spec)
+	require.NoError(t, err)
+	require.NotContains(t, string(data), `"$ref"`)
+
+	pathItem := spec.Spec.Paths.Value("/nodes/{id}")
+	require.Equal(t, "id", pathItem.Parameters[0].Value.Name)
+
+	schema := pathItem.Get.Responses.Value("200").Value.Content["application/json"].Schema.Value
+	require.True(t, schema.Properties["name"].Value.Type.Is("string"))
+	require.Equal(t, "#/components/schemas/Node", schema.Properties["children"].Value.Items.Value.Extensions[deref.CircularRefExtension])
+}
+
+func TestDereference_Nil(t *testing.T) {
+	require.NotPanics(t, func() { deref.Dereference(nil) })
+}
+
+// deref doesn't flatten oneOf and discriminators, the mapping still refers to the components, see the discriminator package
+func TestDereference_Discriminator(t *testing.T) {
+	spec, err := openapi3.NewLoader().LoadFromData([]byte(`
+openapi: 3.0.0
+info:
+  title: pets
+  version: '1'
+paths: {}
+components:
+  schemas:
+    Pet:
+      oneOf:
+        - $ref: '#/components/schemas/Cat'
+        - $ref: '#/components/schemas/Dog'
+      discriminator:
+        propertyName: kind
+        mapping:
+          cat: '#/components/schemas/Cat'
+          dog: '#/components/schemas/Dog'
+    Cat:
+      type: object
+      properties:
+        kind:
+          type: string
+    Dog:
+      type: object
+      properties:
+        kind:
+          type: string
+`))
+	require.NoError(t, err)
+
+	deref.Dereference(spec)
+
+	pet := spec.Components.Schemas["Pet"].Value
+	require.Len(t, pet.OneOf, 2)
+	require.Empty(t, pet.OneOf[0].Ref)
+	require.True(t, pet.OneOf[0].Value.Type.Is("object"))
+	require.Equal(t, "#/components/schemas/Cat", pet.Discriminator.Mapping["cat"])
+	require.Contains(t, spec.Components.Schemas, "Cat")
+}
@@ -0,0 +1,5 @@
+/*
+Package deref replaces all $refs by the objects that they reference
+The result is a self-contained document that can be read without resolving references
+*/
+package deref
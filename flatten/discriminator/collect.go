@@ -0,0 +1,132 @@
+package discriminator
+
+import (
+	"maps"
+	"slices"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// collector lists the schemas of a spec in a stable order, each schema is listed once
+type collector struct {
+	schemas []*openapi3.Schema
+	visited map[*openapi3.Schema]bool
+}
+
+func newCollector() *collector {
+	return &collector{
+		visited: map[*openapi3.Schema]bool{},
+	}
+}
+
+func (c *collector) spec(spec *openapi3.T) {
+	if components := spec.Components; components != nil {
+		for _, name := range slices.Sorted(maps.Keys(components.Schemas)) {
+			c.schemaRef(components.Schemas[name])
+		}
+		for _, name := range slices.Sorted(maps.Keys(components.Parameters)) {
+			if parameter := components.Parameters[name]; parameter != nil {
+				c.parameter(parameter.Value)
+			}
+		}
+		for _, name := range slices.Sorted(maps.Keys(components.Headers)) {
+			if header := components.Headers[name]; header != nil && header.Value != nil {
+				c.parameter(&header.Value.Parameter)
+			}
+		}
+		for _, name := range slices.Sorted(maps.Keys(components.RequestBodies)) {
+			if requestBody := components.RequestBodies[name]; requestBody != nil && requestBody.Value != nil {
+				c.content(requestBody.Value.Content)
+			}
+		}
+		for _, name := range slices.Sorted(maps.Keys(components.Responses)) {
+			c.responseRef(components.Responses[name])
+		}
+	}
+
+	if spec.Paths == nil {
+		return
+	}
+	for _, path := range spec.Paths.InMatchingOrder() {
+		pathItem := spec.Paths.Value(path)
+		if pathItem == nil {
+			continue
+		}
+		c.parameters(pathItem.Parameters)
+		operations := pathItem.Operations()
+		for _, method := range slices.Sorted(maps.Keys(operations)) {
+			c.operation(operations[method])
+		}
+	}
+}
+
+func (c *collector) operation(op *openapi3.Operation) {
+	c.parameters(op.Parameters)
+	if op.RequestBody != nil && op.RequestBody.Value != nil {
+		c.content(op.RequestBody.Value.Content)
+	}
+	if op.Responses == nil {
+		return
+	}
+	responses := op.Responses.Map()
+	for _, status := range slices.Sorted(maps.Keys(responses)) {
+		c.responseRef(responses[status])
+	}
+}
+
+func (c *collector) responseRef(response *openapi3.ResponseRef) {
+	if response == nil || response.Value == nil {
+		return
+	}
+	for _, name := range slices.Sorted(maps.Keys(response.Value.Headers)) {
+		if header := response.Value.Headers[name]; header != nil && header.Value != nil {
+			c.parameter(&header.Value.Parameter)
+		}
+	}
+	c.content(response.Value.Content)
+}
+
+func (c *collector) parameters(parameters openapi3.Parameters) {
+	for _, parameter := range parameters {
+		if parameter != nil {
+			c.parameter(parameter.Value)
+		}
+	}
+}
+
+func (c *collector) parameter(parameter *openapi3.Parameter) {
+	if parameter == nil {
+		return
+	}
+	c.schemaRef(parameter.Schema)
+	c.content(parameter.Content)
+}
+
+func (c *collector) content(content openapi3.Content) {
+	for _, mediaType := range slices.Sorted(maps.Keys(content)) {
+		if content[mediaType] != nil {
+			c.schemaRef(content[mediaType].Schema)
+		}
+	}
+}
+
+func (c *collector) schemaRef(ref *openapi3.SchemaRef) {
+	if ref == nil || ref.Value == nil || c.visited[ref.Value] {
+		return
+	}
+	schema := ref.Value
+	c.visited[schema] = true
+	c.schemas = append(c.schemas, schema)
+
+	for _, name := range slices.Sorted(maps.Keys(schema.Properties)) {
+		c.schemaRef(schema.Properties[name])
+	}
+	c.schemaRef(schema.Items)
+	c.schemaRef(schema.AdditionalProperties.Schema)
+	c.schemaRef(schema.Not)
+	for _, refs := range []openapi3.SchemaRefs{schema.AllOf, schema.AnyOf, schema.OneOf} {
+		for _, ref := range refs {
+			c.schemaRef(ref)
+		}
+	}
+}
@@ -0,0 +1,164 @@
+package discriminator
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oasdiff/oasdiff/flatten/allof"
+)
+
+// Flatten replaces the subschemas of each oneOf and anyOf with a discriminator by self-contained schemas:
+// - the properties of the parent schema are merged into each subschema
+// - the discriminator property of each subschema is restricted to the values that map to it, by the discriminator mapping or by the name of the referenced component
+// - subschemas that inherit from the parent with allOf inherit only its properties, so the hierarchy isn't circular
+// The parent keeps only the oneOf or anyOf of the flattened subschemas, without the discriminator
+func Flatten(spec *openapi3.T) error {
+	if spec == nil {
+		return nil
+	}
+
+	c := newCollector()
+	c.spec(spec)
+
+	parents := []*openapi3.Schema{}
+	bases := map[*openapi3.Schema]*openapi3.Schema{}
+	for _, schema := range c.schemas {
+		if isDiscriminated(schema) {
+			parents = append(parents, schema)
+			bases[schema] = getBase(schema)
+		}
+	}
+	if len(parents) == 0 {
+		return nil
+	}
+
+	// subschemas that inherit from a parent refer to its properties rather than to its oneOf or anyOf
+	for _, schema := range c.schemas {
+		for i, ref := range schema.AllOf {
+			if ref != nil {
+				if base, ok := bases[ref.Value]; ok {
+					schema.AllOf[i] = &openapi3.SchemaRef{Value: base}
+				}
+			}
+		}
+	}
+
+	// the subschemas of all parents are merged before any parent is replaced, since a subschema may refer to another parent
+	oneOfs := make([]openapi3.SchemaRefs, len(parents))
+	anyOfs := make([]openapi3.SchemaRefs, len(parents))
+	for i, parent := range parents {
+		var err error
+		if oneOfs[i], err = flattenSubschemas(parent, bases[parent], parent.OneOf); err != nil {
+			return err
+		}
+		if anyOfs[i], err = flattenSubschemas(parent, bases[parent], parent.AnyOf); err != nil {
+			return err
+		}
+	}
+
+	for i, parent := range parents {
+		*parent = openapi3.Schema{
+			Extensions:  parent.Extensions,
+			Title:       parent.Title,
+			Description: parent.Description,
+			OneOf:       oneOfs[i],
+			AnyOf:       anyOfs[i],
+		}
+	}
+
+	return nil
+}
+
+func isDiscriminated(schema *openapi3.Schema) bool {
+	return schema.Discriminator != nil && schema.Discriminator.PropertyName != "" && (len(schema.OneOf) > 0 || len(schema.AnyOf) > 0)
+}
+
+// getBase returns a copy of the parent schema without its oneOf, anyOf, discriminator and annotations
+func getBase(parent *openapi3.Schema) *openapi3.Schema {
+	base := *parent
+	base.Title = ""
+	base.Description = ""
+	base.OneOf = nil
+	base.AnyOf = nil
+	base.Discriminator = nil
+	return &base
+}
+
+func flattenSubschemas(parent, base *openapi3.Schema, refs openapi3.SchemaRefs) (openapi3.SchemaRefs, error) {
+	if refs == nil {
+		return nil, nil
+	}
+
+	result := make(openapi3.SchemaRefs, len(refs))
+	for i, ref := range refs {
+		if ref == nil || ref.Value == nil {
+			result[i] = ref
+			continue
+		}
+
+		allOf := openapi3.SchemaRefs{}
+		if !base.IsEmpty() {
+			allOf = append(allOf, &openapi3.SchemaRef{Value: base})
+		}
+		allOf = append(allOf, ref)
+		if values := getDiscriminatorValues(parent.Discriminator, ref.Ref); len(values) > 0 {
+			allOf = append(allOf, getDiscriminatorSchema(parent.Discriminator.PropertyName, values))
+		}
+
+		merged, err := allof.Merge(openapi3.SchemaRef{Value: &openapi3.Schema{AllOf: allOf}})
+		if err != nil {
+			return nil, fmt.Errorf("failed to flatten discriminated subschema %q: %w", getName(ref, i), err)
+		}
+		if merged.Title == "" && ref.Ref != "" {
+			merged.Title = getComponentName(ref.Ref)
+		}
+		result[i] = &openapi3.SchemaRef{Value: merged}
+	}
+	return result, nil
+}
+
+// getDiscriminatorValues returns the values that map to the referenced subschema
+// Without an explicit mapping, the value is the name of the component, as defined by the OpenAPI specification
+func getDiscriminatorValues(discriminator *openapi3.Discriminator, ref string) []any {
+	if ref == "" {
+		return nil
+	}
+
+	result := []any{}
+	for _, value := range slices.Sorted(maps.Keys(discriminator.Mapping)) {
+		target := discriminator.Mapping[value]
+		if target == ref || (!strings.Contains(target, "/") && target == getComponentName(ref)) {
+			result = append(result, value)
+		}
+	}
+	if len(result) == 0 {
+		result = append(result, getComponentName(ref))
+	}
+	return result
+}
+
+func getDiscriminatorSchema(propertyName string, values []any) *openapi3.SchemaRef {
+	return &openapi3.SchemaRef{Value: &openapi3.Schema{
+		Properties: openapi3.Schemas{
+			propertyName: &openapi3.SchemaRef{Value: &openapi3.Schema{
+				Type: &openapi3.Types{openapi3.TypeString},
+				Enum: values,
+			}},
+		},
+		Required: []string{propertyName},
+	}}
+}
+
+func getComponentName(ref string) string {
+	return ref[strings.LastIndex(ref, "/")+1:]
+}
+
+func getName(ref *openapi3.SchemaRef, i int) string {
+	if ref.Ref != "" {
+		return ref.Ref
+	}
+	return fmt.Sprintf("#%d", i)
+}
@@ -0,0 +1,102 @@
+package discriminator_test
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oasdiff/oasdiff/flatten/discriminator"
+	"github.com/oasdiff/oasdiff/load"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFlatten_OneOf(t *testing.T) {
+	spec, err := load.NewSpecInfo(openapi3.NewLoader(), load.NewSource("../../data/flatten/discriminator.yaml"), load.WithFlattenDiscriminator())
+	require.NoError(t, err)
+
+	pet := spec.Spec.Components.Schemas["Pet"].Value
+	require.Nil(t, pet.Discriminator)
+	require.Empty(t, pet.Properties)
+	require.Equal(t, "a pet", pet.Description)
+	require.Len(t, pet.OneOf, 2)
+
+	// the mapping has two values for Cat, and Cat inherits the properties of Pet
+	cat := pet.OneOf[0].Value
+	require.Empty(t, pet.OneOf[0].Ref)
+	require.Equal(t, "Cat", cat.Title)
+	require.ElementsMatch(t, []string{"name", "kind", "indoor"}, keys(cat.Properties))
+	require.ElementsMatch(t, []any{"cat", "kitten"}, cat.Properties["kind"].Value.Enum)
+	require.ElementsMatch(t, []string{"name", "kind"}, cat.Required)
+
+	// Dog isn't in the mapping, so its discriminator value is the name of the component
+	dog := pet.OneOf[1].Value
+	require.ElementsMatch(t, []string{"name", "kind", "breed"}, keys(dog.Properties))
+	require.Equal(t, []any{"Dog"}, dog.Properties["kind"].Value.Enum)
+
+	// the Pet response refers to the flattened Pet
+	items := spec.Spec.Paths.Value("/pets").Get.Responses.Value("200").Value.Content["application/json"].Schema.Value.Items
+	require.Same(t, pet, items.Value)
+}
+
+func TestFlatten_Inheritance(t *testing.T) {
+	spec, err := load.NewSpecInfo(openapi3.NewLoader(), load.NewSource("../../data/flatten/discriminator.yaml"), load.WithFlattenDiscriminator())
+	require.NoError(t, err)
+
+	// Cat inherits the properties of Pet but not its oneOf, so the hierarchy isn't circular
+	cat := spec.Spec.Components.Schemas["Cat"].Value
+	require.Len(t, cat.AllOf, 2)
+	require.Empty(t, cat.AllOf[0].Ref)
+	require.Empty(t, cat.AllOf[0].Value.OneOf)
+	require.Nil(t, cat.AllOf[0].Value.Discriminator)
+	require.ElementsMatch(t, []string{"name", "kind"}, keys(cat.AllOf[0].Value.Properties))
+}
+
+func TestFlatten_AnyOf(t *testing.T) {
+	spec, err := load.NewSpecInfo(openapi3.NewLoader(), load.NewSource("../../data/flatten/discriminator.yaml"), load.WithFlattenDiscriminator())
+	require.NoError(t, err)
+
+	owners := spec.Spec.Paths.Value("/owners").Get.Responses.Value("200").Value.Content["application/json"].Schema.Value
+	require.Nil(t, owners.Discriminator)
+	require.Len(t, owners.AnyOf, 2)
+	require.Equal(t, []any{"Person"}, owners.AnyOf[0].Value.Properties["ownerType"].Value.Enum)
+	require.Equal(t, []any{"Company"}, owners.AnyOf[1].Value.Properties["ownerType"].Value.Enum)
+	require.Equal(t, []string{"ownerType"}, owners.AnyOf[1].Value.Required)
+
+	// the components aren't changed
+	require.Empty(t, spec.Spec.Components.Schemas["Person"].Value.Properties["ownerType"].Value.Enum)
+}
+
+func TestFlatten_Conflict(t *testing.T) {
+	spec, err := openapi3.NewLoader().LoadFromData([]byte(`
+openapi: 3.0.0
+info:
+  title: pets
+  version: '1'
+paths: {}
+components:
+  schemas:
+    Pet:
+      oneOf:
+        - $ref: '#/components/schemas/Cat'
+      discriminator:
+        propertyName: kind
+    Cat:
+      type: object
+      properties:
+        kind:
+          type: integer
+`))
+	require.NoError(t, err)
+	require.Error(t, discriminator.Flatten(spec))
+}
+
+func TestFlatten_Nil(t *testing.T) {
+	require.NoError(t, discriminator.Flatten(nil))
+}
+
+func keys(schemas openapi3.Schemas) []string {
+	result := []string{}
+	for name := range schemas {
+		result = append(result, name)
+	}
+	return result
+}
@@ -0,0 +1,5 @@
+/*
+Package discriminator flattens discriminated hierarchies of oneOf and anyOf
+Each subschema is merged with the properties of its parent and restricted to its discriminator values, so that it can be read without the discriminator
+*/
+package discriminator
@@ -0,0 +1,5 @@
+/*
+Package hoist moves inline object schemas into named components
+Named schemas make diffs and generated code easier to read
+*/
+package hoist
@@ -0,0 +1,170 @@
+package hoist
+
+import (
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/iancoleman/strcase"
+)
+
+// Hoist moves inline object schemas into the schemas of the components and replaces them by $refs
+// Names are derived from the location of the schema:
+// - request bodies: the operation followed by Request, like CreatePetRequest
+// - responses: the operation followed by Response and by the status if it isn't 2xx, like GetPetResponse or GetPet404Response
+// - properties, items and additional properties: the parent schema followed by the property name, Item or Value, like PetOwner
+// - subschemas of allOf, anyOf and oneOf: the parent schema followed by the keyword and a number, like PetOneOf1
+// The operation is its operationId, or the method and the path if there is none
+func Hoist(spec *openapi3.T) {
+	if spec == nil {
+		return
+	}
+
+	if spec.Components == nil {
+		spec.Components = &openapi3.Components{}
+	}
+	if spec.Components.Schemas == nil {
+		spec.Components.Schemas = openapi3.Schemas{}
+	}
+
+	h := hoister{
+		schemas: spec.Components.Schemas,
+		names:   map[*openapi3.Schema]string{},
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(h.schemas)) {
+		if schema := h.schemas[name]; schema != nil && schema.Ref == "" && schema.Value != nil {
+			h.names[schema.Value] = name
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(h.schemas)) {
+		if schema := h.schemas[name]; schema != nil && schema.Ref == "" && schema.Value != nil {
+			h.children(name, schema.Value)
+		}
+	}
+
+	if spec.Paths != nil {
+		for _, path := range spec.Paths.InMatchingOrder() {
+			pathItem := spec.Paths.Value(path)
+			if pathItem == nil {
+				continue
+			}
+			operations := pathItem.Operations()
+			for _, method := range slices.Sorted(maps.Keys(operations)) {
+				h.operation(getOperationName(operations[method], method, path), operations[method])
+			}
+		}
+	}
+}
+
+type hoister struct {
+	schemas openapi3.Schemas
+	// names of the schemas that are already components
+	names map[*openapi3.Schema]string
+}
+
+func (h *hoister) operation(name string, op *openapi3.Operation) {
+	if op.RequestBody != nil && op.RequestBody.Ref == "" && op.RequestBody.Value != nil {
+		h.content(name+"Request", op.RequestBody.Value.Content)
+	}
+
+	if op.Responses == nil {
+		return
+	}
+	responses := op.Responses.Map()
+	for _, status := range slices.Sorted(maps.Keys(responses)) {
+		response := responses[status]
+		if response == nil || response.Ref != "" || response.Value == nil {
+			continue
+		}
+		responseName := name + strcase.ToCamel(strings.ToLower(status)) + "Response"
+		if strings.HasPrefix(status, "2") {
+			responseName = name + "Response"
+		}
+		h.content(responseName, response.Value.Content)
+	}
+}
+
+func (h *hoister) content(name string, content openapi3.Content) {
+	for _, mediaType := range slices.Sorted(maps.Keys(content)) {
+		if content[mediaType] != nil {
+			h.schemaRef(name, content[mediaType].Schema)
+		}
+	}
+}
+
+// schemaRef moves an inline object schema into the components and replaces it by a $ref
+// Inline schemas that aren't objects are kept, but their subschemas may be moved
+func (h *hoister) schemaRef(name string, ref *openapi3.SchemaRef) {
+	if ref == nil || ref.Ref != "" || ref.Value == nil {
+		return
+	}
+
+	if existing, ok := h.names[ref.Value]; ok {
+		ref.Ref = "#/components/schemas/" + existing
+		return
+	}
+
+	if !isObject(ref.Value) {
+		h.children(name, ref.Value)
+		return
+	}
+
+	name = h.getUniqueName(name)
+	h.names[ref.Value] = name
+	h.schemas[name] = &openapi3.SchemaRef{Value: ref.Value}
+	ref.Ref = "#/components/schemas/" + name
+
+	h.children(name, ref.Value)
+}
+
+// children hoists the inline subschemas of a schema
+func (h *hoister) children(name string, schema *openapi3.Schema) {
+	for _, property := range slices.Sorted(maps.Keys(schema.Properties)) {
+		h.schemaRef(name+strcase.ToCamel(property), schema.Properties[property])
+	}
+	h.schemaRef(name+"Item", schema.Items)
+	h.schemaRef(name+"Value", schema.AdditionalProperties.Schema)
+	h.schemaRefs(name+"AllOf", schema.AllOf)
+	h.schemaRefs(name+"AnyOf", schema.AnyOf)
+	h.schemaRefs(name+"OneOf", schema.OneOf)
+}
+
+func (h *hoister) schemaRefs(name string, refs openapi3.SchemaRefs) {
+	for i, ref := range refs {
+		h.schemaRef(name+strconv.Itoa(i+1), ref)
+	}
+}
+
+func (h *hoister) getUniqueName(name string) string {
+	result := name
+	for i := 2; h.schemas[result] != nil; i++ {
+		result = name + strconv.Itoa(i)
+	}
+	return result
+}
+
+// isObject returns true if a schema describes an object with properties
+func isObject(schema *openapi3.Schema) bool {
+	return len(schema.Properties) > 0 && (schema.Type == nil || schema.Type.Includes(openapi3.TypeObject))
+}
+
+// getOperationName returns the operationId in pascal case, or the method and the path if there is no operationId, like GetPetsByPetId for GET /pets/{petId}
+func getOperationName(op *openapi3.Operation, method, path string) string {
+	if op.OperationID != "" {
+		return strcase.ToCamel(op.OperationID)
+	}
+
+	var result strings.Builder
+	result.WriteString(strcase.ToCamel(strings.ToLower(method)))
+	for _, segment := range strings.Split(path, "/") {
+		if strings.HasPrefix(segment, "{") {
+			result.WriteString("By")
+		}
+		result.WriteString(strcase.ToCamel(strings.NewReplacer("{", "", "}", "").Replace(segment)))
+	}
+	return result.String()
+}
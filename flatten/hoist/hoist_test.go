@@ -0,0 +1,46 @@
+package hoist_test
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oasdiff/oasdiff/load"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHoist(t *testing.T) {
+	spec, err := load.NewSpecInfo(openapi3.NewLoader(), load.NewSource("../../data/flatten/hoist.yaml"), load.WithHoist())
+	require.NoError(t, err)
+
+	schemas := spec.Spec.Components.Schemas
+	require.ElementsMatch(t, []string{
+		"CreatePet400Response",
+		"CreatePetRequest",
+		"CreatePetRequestOwner",
+		"GetPetsByPetIdResponseOneOf1",
+		"GetPetsByPetIdResponseOneOf2",
+		"Pet",
+		"PetTagsItem",
+	}, keys(schemas))
+
+	post := spec.Spec.Paths.Value("/pets").Post
+	require.Equal(t, "#/components/schemas/CreatePetRequest", post.RequestBody.Value.Content["application/json"].Schema.Ref)
+	require.Equal(t, "#/components/schemas/Pet", post.Responses.Value("201").Value.Content["application/json"].Schema.Ref)
+	require.Equal(t, "#/components/schemas/CreatePet400Response", post.Responses.Value("400").Value.Content["application/json"].Schema.Ref)
+	require.Equal(t, "#/components/schemas/CreatePetRequestOwner", schemas["CreatePetRequest"].Value.Properties["owner"].Ref)
+	require.Equal(t, "#/components/schemas/PetTagsItem", schemas["Pet"].Value.Properties["tags"].Value.Items.Ref)
+
+	get := spec.Spec.Paths.Value("/pets/{petId}").Get
+	schema := get.Responses.Value("200").Value.Content["application/json"].Schema
+	require.Empty(t, schema.Ref)
+	require.Equal(t, "#/components/schemas/GetPetsByPetIdResponseOneOf1", schema.Value.OneOf[0].Ref)
+	require.Equal(t, "#/components/schemas/GetPetsByPetIdResponseOneOf2", schema.Value.OneOf[1].Ref)
+}
+
+func keys(schemas openapi3.Schemas) []string {
+	result := []string{}
+	for name := range schemas {
+		result = append(result, name)
+	}
+	return result
+}
@@ -1,7 +1,10 @@
 package formatters
 
 import (
+	"fmt"
+
 	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/load"
 )
 
 type Change struct {
@@ -16,6 +19,7 @@ type Change struct {
 	Section     string         `json:"section,omitempty" yaml:"section,omitempty"`
 	IsBreaking  bool           `json:"-" yaml:"-"`
 	Attributes  map[string]any `json:"attributes,omitempty" yaml:"attributes,omitempty"`
+	Tags        []string       `json:"-" yaml:"-"` // tags of the operation, only set for custom templates
 }
 
 type Changes []Change
@@ -34,7 +38,42 @@ func NewChanges(originalChanges checker.Changes, l checker.Localizer) Changes {
 			Path:        change.GetPath(),
 			Source:      change.GetSource(),
 			Attributes:  change.GetAttributes(),
+			IsBreaking:  change.IsBreaking(),
 		}
 	}
 	return changes
 }
+
+// getChangeMessage returns the uncolorized text of the change prefixed by its endpoint, changes outside of the paths, like in the components, have no endpoint
+func getChangeMessage(change checker.Change, l checker.Localizer) string {
+	if change.GetPath() == "" {
+		return change.GetUncolorizedText(l)
+	}
+	return fmt.Sprintf("in API %s %s %s", change.GetOperation(), change.GetPath(), change.GetUncolorizedText(l))
+}
+
+// getChangeFile returns the spec file of the change, or its source (e.g. a URL) if it wasn't loaded from a file
+// Changes outside of the paths have no source, they fall back to the revision spec
+func getChangeFile(change checker.Change, specInfoPair *load.SpecInfoPair) string {
+	if file := change.GetSourceFile(); file != "" {
+		return file
+	}
+	if source := change.GetSource(); source != "" {
+		return source
+	}
+	if specInfoPair != nil && specInfoPair.Revision != nil {
+		return specInfoPair.Revision.Url
+	}
+	return ""
+}
+
+// getChangeSourceFile returns the local spec file of the change, changes outside of the paths fall back to the revision spec if it was loaded from a file
+func getChangeSourceFile(change checker.Change, specInfoPair *load.SpecInfoPair) string {
+	if file := change.GetSourceFile(); file != "" || change.GetSource() != "" {
+		return file
+	}
+	if specInfoPair != nil && specInfoPair.Revision != nil && load.NewSource(specInfoPair.Revision.Url).IsFile() {
+		return specInfoPair.Revision.Url
+	}
+	return ""
+}
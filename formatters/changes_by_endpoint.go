@@ -15,7 +15,7 @@ func GroupChanges(changes checker.Changes, l checker.Localizer) ChangesByEndpoin
 
 	for _, change := range changes {
 		switch change.(type) {
-		case checker.ApiChange:
+		case checker.ApiChange, checker.CustomChange:
 			ep := Endpoint{Path: change.GetPath(), Operation: change.GetOperation()}
 			if c, ok := apiChanges[ep]; ok {
 				*c = append(*c, Change{
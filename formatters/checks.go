@@ -1,9 +1,14 @@
 package formatters
 
+import "github.com/oasdiff/oasdiff/checker"
+
 type Check struct {
 	Id          string `json:"id" yaml:"id"`
 	Level       string `json:"level" yaml:"level"`
 	Description string `json:"description" yaml:"description"`
+	Direction   string `json:"direction,omitempty" yaml:"direction,omitempty"`
+	Location    string `json:"location,omitempty" yaml:"location,omitempty"`
+	Action      string `json:"action,omitempty" yaml:"action,omitempty"`
 }
 
 type Checks []Check
@@ -19,3 +24,13 @@ func (checks Checks) Less(i, j int) bool {
 func (checks Checks) Swap(i, j int) {
 	checks[i], checks[j] = checks[j], checks[i]
 }
+
+// localize returns a copy of the checks with localized descriptions
+func (checks Checks) localize(l checker.Localizer) Checks {
+	result := make(Checks, len(checks))
+	for i, check := range checks {
+		result[i] = check
+		result[i].Description = l(check.Description)
+	}
+	return result
+}
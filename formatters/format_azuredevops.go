@@ -0,0 +1,82 @@
+package formatters
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/oasdiff/oasdiff/checker"
+)
+
+// azureDevOpsIssueType maps levels to the issue types of Azure Pipelines, which only supports errors and warnings
+// INFO changes are written as plain log lines
+var azureDevOpsIssueType = map[checker.Level]string{
+	checker.ERR:  "error",
+	checker.WARN: "warning",
+}
+
+// azureDevOpsPropertyEscaper escapes property values of Azure Pipelines logging commands
+// See https://learn.microsoft.com/en-us/azure/devops/pipelines/scripts/logging-commands
+var azureDevOpsPropertyEscaper = strings.NewReplacer(
+	"%", "%AZP25",
+	";", "%3B",
+	"\r", "%0D",
+	"\n", "%0A",
+	"]", "%5D",
+)
+
+// azureDevOpsMessageEscaper escapes the message of Azure Pipelines logging commands
+var azureDevOpsMessageEscaper = strings.NewReplacer(
+	"%", "%AZP25",
+	"\r", "%0D",
+	"\n", "%0A",
+)
+
+type AzureDevOpsFormatter struct {
+	notImplementedFormatter
+	Localizer checker.Localizer
+}
+
+func newAzureDevOpsFormatter(l checker.Localizer) AzureDevOpsFormatter {
+	return AzureDevOpsFormatter{
+		Localizer: l,
+	}
+}
+
+func (f AzureDevOpsFormatter) RenderChangelog(changes checker.Changes, opts RenderOpts, _, _ string) ([]byte, error) {
+	var buf bytes.Buffer
+
+	for _, change := range changes {
+		message := azureDevOpsMessageEscaper.Replace(getChangeMessage(change, f.Localizer))
+
+		issueType, ok := azureDevOpsIssueType[change.GetLevel()]
+		if !ok {
+			buf.WriteString(fmt.Sprintf("%s [%s]\n", message, change.GetId()))
+			continue
+		}
+
+		// source file, line and column are optional
+		params := []string{
+			"type=" + issueType,
+		}
+		if file := getChangeSourceFile(change, opts.SpecInfoPair); file != "" {
+			params = append(params, "sourcepath="+azureDevOpsPropertyEscaper.Replace(file))
+		}
+		if change.GetSourceLine() != 0 {
+			params = append(params, "linenumber="+strconv.Itoa(change.GetSourceLine()+1))
+		}
+		if change.GetSourceColumn() != 0 {
+			params = append(params, "columnnumber="+strconv.Itoa(change.GetSourceColumn()+1))
+		}
+		params = append(params, "code="+azureDevOpsPropertyEscaper.Replace(change.GetId()))
+
+		buf.WriteString(fmt.Sprintf("##vso[task.logissue %s;]%s\n", strings.Join(params, ";"), message))
+	}
+
+	return buf.Bytes(), nil
+}
+
+func (f AzureDevOpsFormatter) SupportedOutputs() []Output {
+	return []Output{OutputChangelog}
+}
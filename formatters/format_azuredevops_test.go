@@ -0,0 +1,103 @@
+package formatters_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/formatters"
+	"github.com/oasdiff/oasdiff/load"
+	"github.com/stretchr/testify/require"
+)
+
+var azureDevOpsFormatter = formatters.AzureDevOpsFormatter{
+	Localizer: MockLocalizer,
+}
+
+func TestAzureDevOpsLookup(t *testing.T) {
+	f, err := formatters.Lookup(string(formatters.FormatAzureDevOps), formatters.DefaultFormatterOpts())
+	require.NoError(t, err)
+	require.IsType(t, formatters.AzureDevOpsFormatter{}, f)
+}
+
+func TestAzureDevOpsFormatter_RenderChangelog(t *testing.T) {
+	testChanges := checker.Changes{
+		checker.ApiChange{
+			Id:           "change_id",
+			Level:        checker.ERR,
+			Operation:    http.MethodGet,
+			Path:         "/api/test",
+			Source:       load.NewSource("openapi.yaml"),
+			SourceLine:   9,
+			SourceColumn: 4,
+		},
+		checker.ApiChange{
+			Id:        "warning_id",
+			Level:     checker.WARN,
+			Operation: http.MethodGet,
+			Path:      "/api/test",
+			Source:    load.NewSource("openapi.yaml"),
+		},
+		checker.ApiChange{
+			Id:        "notice_id",
+			Level:     checker.INFO,
+			Operation: http.MethodPost,
+			Path:      "/api/test",
+			Source:    load.NewSource("openapi.yaml"),
+		},
+	}
+
+	output, err := azureDevOpsFormatter.RenderChangelog(testChanges, formatters.NewRenderOpts(), "", "")
+	require.NoError(t, err)
+	expectedOutput := "##vso[task.logissue type=error;sourcepath=openapi.yaml;linenumber=10;columnnumber=5;code=change_id;]in API GET /api/test This is a breaking change.\n" +
+		"##vso[task.logissue type=warning;sourcepath=openapi.yaml;code=warning_id;]in API GET /api/test This is a warning.\n" +
+		"in API POST /api/test This is a notice. [notice_id]\n"
+	require.Equal(t, expectedOutput, string(output))
+}
+
+func TestAzureDevOpsFormatter_DontRenderHttpSource(t *testing.T) {
+	testChanges := checker.Changes{
+		checker.ApiChange{
+			Id:        "change_id",
+			Level:     checker.ERR,
+			Operation: http.MethodGet,
+			Path:      "/api/test",
+			Source:    load.NewSource("http://example.com/openapi.yaml"),
+		},
+	}
+
+	output, err := azureDevOpsFormatter.RenderChangelog(testChanges, formatters.NewRenderOpts(), "", "")
+	require.NoError(t, err)
+	require.Equal(t, "##vso[task.logissue type=error;code=change_id;]in API GET /api/test This is a breaking change.\n", string(output))
+}
+
+func TestAzureDevOpsFormatter_ComponentChange(t *testing.T) {
+	testChanges := checker.Changes{
+		checker.ComponentChange{
+			Id:        "change_id",
+			Level:     checker.ERR,
+			Component: "securitySchemes",
+		},
+	}
+
+	opts := formatters.NewRenderOpts()
+	opts.SpecInfoPair = load.NewSpecInfoPair(&load.SpecInfo{Url: "base.yaml"}, &load.SpecInfo{Url: "revision.yaml"})
+	output, err := azureDevOpsFormatter.RenderChangelog(testChanges, opts, "", "")
+	require.NoError(t, err)
+	require.Equal(t, "##vso[task.logissue type=error;sourcepath=revision.yaml;code=change_id;]This is a breaking change.\n", string(output))
+}
+
+func TestAzureDevOpsFormatter_NotImplemented(t *testing.T) {
+	var err error
+	_, err = azureDevOpsFormatter.RenderDiff(nil, formatters.NewRenderOpts())
+	require.Error(t, err)
+
+	_, err = azureDevOpsFormatter.RenderSummary(nil, formatters.NewRenderOpts())
+	require.Error(t, err)
+
+	_, err = azureDevOpsFormatter.RenderChecks(nil, formatters.NewRenderOpts())
+	require.Error(t, err)
+
+	_, err = azureDevOpsFormatter.RenderFlatten(nil, formatters.NewRenderOpts())
+	require.Error(t, err)
+}
@@ -0,0 +1,89 @@
+package formatters
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"github.com/oasdiff/oasdiff/checker"
+)
+
+var checkstyleSeverity = map[checker.Level]string{
+	checker.ERR:  "error",
+	checker.WARN: "warning",
+	checker.INFO: "info",
+}
+
+// CheckstyleReport is a report in the Checkstyle XML format, as consumed by Jenkins Warnings NG and other CI tools
+type CheckstyleReport struct {
+	XMLName xml.Name         `xml:"checkstyle"`
+	Version string           `xml:"version,attr"`
+	Files   []CheckstyleFile `xml:"file"`
+}
+
+type CheckstyleFile struct {
+	Name   string            `xml:"name,attr"`
+	Errors []CheckstyleError `xml:"error"`
+}
+
+type CheckstyleError struct {
+	Line     int    `xml:"line,attr,omitempty"`
+	Column   int    `xml:"column,attr,omitempty"`
+	Severity string `xml:"severity,attr"`
+	Message  string `xml:"message,attr"`
+	Source   string `xml:"source,attr"`
+}
+
+type CheckstyleFormatter struct {
+	notImplementedFormatter
+	Localizer checker.Localizer
+}
+
+func newCheckstyleFormatter(l checker.Localizer) CheckstyleFormatter {
+	return CheckstyleFormatter{
+		Localizer: l,
+	}
+}
+
+// RenderChangelog groups the changes by spec file, in the order in which the files first appear
+func (f CheckstyleFormatter) RenderChangelog(changes checker.Changes, opts RenderOpts, _, _ string) ([]byte, error) {
+	report := CheckstyleReport{
+		Version: "4.3",
+		Files:   []CheckstyleFile{},
+	}
+
+	fileIndex := map[string]int{}
+	for _, change := range changes {
+		file := getChangeFile(change, opts.SpecInfoPair)
+		i, ok := fileIndex[file]
+		if !ok {
+			i = len(report.Files)
+			fileIndex[file] = i
+			report.Files = append(report.Files, CheckstyleFile{Name: file})
+		}
+
+		checkstyleError := CheckstyleError{
+			Severity: checkstyleSeverity[change.GetLevel()],
+			Message:  getChangeMessage(change, f.Localizer),
+			Source:   "oasdiff." + change.GetId(),
+		}
+		// the line is omitted when it is unknown
+		if change.GetSourceLine() != 0 {
+			checkstyleError.Line = change.GetSourceLine() + 1
+		}
+		if change.GetSourceColumn() != 0 {
+			checkstyleError.Column = change.GetSourceColumn() + 1
+		}
+		report.Files[i].Errors = append(report.Files[i].Errors, checkstyleError)
+	}
+
+	output, err := xml.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal checkstyle XML: %w", err)
+	}
+
+	return []byte(xml.Header + string(output)), nil
+}
+
+func (f CheckstyleFormatter) SupportedOutputs() []Output {
+	return []Output{OutputChangelog}
+}
@@ -0,0 +1,95 @@
+package formatters_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/formatters"
+	"github.com/oasdiff/oasdiff/load"
+	"github.com/stretchr/testify/require"
+)
+
+var checkstyleFormatter = formatters.CheckstyleFormatter{
+	Localizer: MockLocalizer,
+}
+
+func TestCheckstyleLookup(t *testing.T) {
+	f, err := formatters.Lookup(string(formatters.FormatCheckstyle), formatters.DefaultFormatterOpts())
+	require.NoError(t, err)
+	require.IsType(t, formatters.CheckstyleFormatter{}, f)
+}
+
+func TestCheckstyleFormatter_RenderChangelog(t *testing.T) {
+	testChanges := checker.Changes{
+		checker.ApiChange{
+			Id:           "change_id",
+			Level:        checker.ERR,
+			Operation:    http.MethodGet,
+			Path:         "/api/test",
+			Source:       load.NewSource("openapi.yaml"),
+			SourceLine:   9,
+			SourceColumn: 4,
+		},
+		checker.ApiChange{
+			Id:        "warning_id",
+			Level:     checker.WARN,
+			Operation: http.MethodGet,
+			Path:      "/api/test",
+			Source:    load.NewSource("other.yaml"),
+		},
+		checker.ApiChange{
+			Id:        "notice_id",
+			Level:     checker.INFO,
+			Operation: http.MethodPost,
+			Path:      "/api/test",
+			Source:    load.NewSource("openapi.yaml"),
+		},
+	}
+
+	output, err := checkstyleFormatter.RenderChangelog(testChanges, formatters.NewRenderOpts(), "", "")
+	require.NoError(t, err)
+	expectedOutput := `<?xml version="1.0" encoding="UTF-8"?>
+<checkstyle version="4.3">
+  <file name="openapi.yaml">
+    <error line="10" column="5" severity="error" message="in API GET /api/test This is a breaking change." source="oasdiff.change_id"></error>
+    <error severity="info" message="in API POST /api/test This is a notice." source="oasdiff.notice_id"></error>
+  </file>
+  <file name="other.yaml">
+    <error severity="warning" message="in API GET /api/test This is a warning." source="oasdiff.warning_id"></error>
+  </file>
+</checkstyle>`
+	require.Equal(t, expectedOutput, string(output))
+}
+
+func TestCheckstyleFormatter_ComponentChange(t *testing.T) {
+	testChanges := checker.Changes{
+		checker.ComponentChange{
+			Id:        "change_id",
+			Level:     checker.ERR,
+			Component: "securitySchemes",
+		},
+	}
+
+	opts := formatters.NewRenderOpts()
+	opts.SpecInfoPair = load.NewSpecInfoPair(&load.SpecInfo{Url: "base.yaml"}, &load.SpecInfo{Url: "revision.yaml"})
+	output, err := checkstyleFormatter.RenderChangelog(testChanges, opts, "", "")
+	require.NoError(t, err)
+	require.Contains(t, string(output), `<file name="revision.yaml">`)
+	require.Contains(t, string(output), `<error severity="error" message="This is a breaking change." source="oasdiff.change_id"></error>`)
+}
+
+func TestCheckstyleFormatter_NotImplemented(t *testing.T) {
+	var err error
+	_, err = checkstyleFormatter.RenderDiff(nil, formatters.NewRenderOpts())
+	require.Error(t, err)
+
+	_, err = checkstyleFormatter.RenderSummary(nil, formatters.NewRenderOpts())
+	require.Error(t, err)
+
+	_, err = checkstyleFormatter.RenderChecks(nil, formatters.NewRenderOpts())
+	require.Error(t, err)
+
+	_, err = checkstyleFormatter.RenderFlatten(nil, formatters.NewRenderOpts())
+	require.Error(t, err)
+}
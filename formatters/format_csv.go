@@ -0,0 +1,130 @@
+package formatters
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/diff"
+)
+
+var (
+	csvChangelogHeader = []string{"id", "level", "section", "method", "path", "operationId", "source", "text", "comment"}
+	csvChecksHeader    = []string{"id", "level", "description"}
+	csvSummaryHeader   = []string{"detail", "added", "deleted", "modified"}
+)
+
+// CSVFormatter renders tables with a header row, separated by commas (csv) or tabs (tsv)
+type CSVFormatter struct {
+	notImplementedFormatter
+	Localizer checker.Localizer
+	Separator rune
+}
+
+func newCSVFormatter(l checker.Localizer, separator rune) CSVFormatter {
+	return CSVFormatter{
+		Localizer: l,
+		Separator: separator,
+	}
+}
+
+func (f CSVFormatter) RenderSummary(diff *diff.Diff, opts RenderOpts) ([]byte, error) {
+	records := [][]string{csvSummaryHeader}
+	for _, row := range newSummaryData(diff).Rows {
+		records = append(records, []string{string(row.Detail), strconv.Itoa(row.Added), strconv.Itoa(row.Deleted), strconv.Itoa(row.Modified)})
+	}
+
+	return f.write(records)
+}
+
+// RenderChangelog renders a row per change, with a column for each of the requested attributes, even if no change has it, so that the header is stable
+func (f CSVFormatter) RenderChangelog(changes checker.Changes, opts RenderOpts, _, _ string) ([]byte, error) {
+	attributes := opts.Attributes
+
+	records := [][]string{append(append([]string{}, csvChangelogHeader...), attributes...)}
+	for _, change := range changes {
+		record := []string{
+			change.GetId(),
+			change.GetLevel().String(),
+			change.GetSection(),
+			change.GetOperation(),
+			change.GetPath(),
+			change.GetOperationId(),
+			change.GetSource(),
+			change.GetUncolorizedText(f.Localizer),
+			change.GetComment(f.Localizer),
+		}
+		for _, attribute := range attributes {
+			value, err := formatAttributeValue(change.GetAttributes()[attribute])
+			if err != nil {
+				return nil, err
+			}
+			record = append(record, value)
+		}
+		records = append(records, record)
+	}
+
+	return f.write(records)
+}
+
+func (f CSVFormatter) RenderChecks(checks Checks, opts RenderOpts) ([]byte, error) {
+	records := [][]string{csvChecksHeader}
+	for _, check := range checks {
+		records = append(records, []string{check.Id, check.Level, check.Description})
+	}
+
+	return f.write(records)
+}
+
+func (f CSVFormatter) SupportedOutputs() []Output {
+	return []Output{OutputSummary, OutputChangelog, OutputChecks}
+}
+
+func (f CSVFormatter) write(records [][]string) ([]byte, error) {
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	if f.Separator != 0 {
+		writer.Comma = f.Separator
+	}
+
+	for _, record := range records {
+		for i, cell := range record {
+			record[i] = escapeCSVFormula(cell)
+		}
+	}
+
+	if err := writer.WriteAll(records); err != nil {
+		return nil, fmt.Errorf("failed to write CSV: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// escapeCSVFormula prefixes cells that spreadsheet applications would evaluate as formulas with a quote, so that they are displayed as text
+// See https://owasp.org/www-community/attacks/CSV_Injection
+func escapeCSVFormula(cell string) string {
+	if cell != "" && strings.ContainsRune("=+-@", rune(cell[0])) {
+		return "'" + cell
+	}
+	return cell
+}
+
+// formatAttributeValue returns strings as is and encodes other values as JSON
+func formatAttributeValue(value any) (string, error) {
+	switch value := value.(type) {
+	case nil:
+		return "", nil
+	case string:
+		return value, nil
+	}
+
+	data, err := json.Marshal(value)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal attribute value: %w", err)
+	}
+	return string(data), nil
+}
@@ -0,0 +1,174 @@
+package formatters_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/diff"
+	"github.com/oasdiff/oasdiff/formatters"
+	"github.com/oasdiff/oasdiff/load"
+	"github.com/stretchr/testify/require"
+)
+
+var csvFormatter = formatters.CSVFormatter{
+	Localizer: MockLocalizer,
+	Separator: ',',
+}
+
+var tsvFormatter = formatters.CSVFormatter{
+	Localizer: MockLocalizer,
+	Separator: '\t',
+}
+
+func TestCSVLookup(t *testing.T) {
+	f, err := formatters.Lookup(string(formatters.FormatCSV), formatters.DefaultFormatterOpts())
+	require.NoError(t, err)
+	require.IsType(t, formatters.CSVFormatter{}, f)
+	require.Equal(t, ',', f.(formatters.CSVFormatter).Separator)
+}
+
+func TestTSVLookup(t *testing.T) {
+	f, err := formatters.Lookup(string(formatters.FormatTSV), formatters.DefaultFormatterOpts())
+	require.NoError(t, err)
+	require.IsType(t, formatters.CSVFormatter{}, f)
+	require.Equal(t, '\t', f.(formatters.CSVFormatter).Separator)
+}
+
+func TestCSVFormatter_RenderChangelog(t *testing.T) {
+	testChanges := checker.Changes{
+		checker.ApiChange{
+			Id:          "change_id",
+			Level:       checker.ERR,
+			Operation:   http.MethodGet,
+			OperationId: "getTest",
+			Path:        "/api/test",
+			Source:      load.NewSource("openapi.yaml"),
+			CommonChange: checker.CommonChange{
+				Attributes: map[string]any{"x-team": "payments, billing"},
+			},
+		},
+		checker.ComponentChange{
+			Id:    "notice_id",
+			Level: checker.INFO,
+			CommonChange: checker.CommonChange{
+				Attributes: map[string]any{"x-owners": []any{"a", "b"}},
+			},
+		},
+	}
+
+	opts := formatters.NewRenderOpts()
+	opts.Attributes = []string{"x-team", "x-owners", "x-audience"}
+	out, err := csvFormatter.RenderChangelog(testChanges, opts, "", "")
+	require.NoError(t, err)
+	require.Equal(t, `id,level,section,method,path,operationId,source,text,comment,x-team,x-owners,x-audience
+change_id,error,paths,GET,/api/test,getTest,openapi.yaml,This is a breaking change.,,"payments, billing",,
+notice_id,info,components,,,,,This is a notice.,,,"[""a"",""b""]",
+`, string(out))
+}
+
+func TestCSVFormatter_RenderChangelogWithoutAttributes(t *testing.T) {
+	testChanges := checker.Changes{
+		checker.ApiChange{
+			Id:        "change_id",
+			Level:     checker.ERR,
+			Operation: http.MethodGet,
+			Path:      "/api/test",
+			CommonChange: checker.CommonChange{
+				Attributes: map[string]any{"usage": "none"},
+			},
+		},
+	}
+
+	out, err := csvFormatter.RenderChangelog(testChanges, formatters.NewRenderOpts(), "", "")
+	require.NoError(t, err)
+	require.Equal(t, `id,level,section,method,path,operationId,source,text,comment
+change_id,error,paths,GET,/api/test,,,This is a breaking change.,
+`, string(out))
+}
+
+func TestCSVFormatter_RenderChangelogFormulas(t *testing.T) {
+	testChanges := checker.Changes{
+		checker.ApiChange{
+			Id:        "change_id",
+			Level:     checker.ERR,
+			Operation: http.MethodGet,
+			Path:      "/api/test",
+			CommonChange: checker.CommonChange{
+				Attributes: map[string]any{"x-a": "=HYPERLINK(\"http://evil\")", "x-b": "+1", "x-c": "-1", "x-d": "@SUM(A1)", "x-e": "a=b"},
+			},
+		},
+	}
+
+	opts := formatters.NewRenderOpts()
+	opts.Attributes = []string{"x-a", "x-b", "x-c", "x-d", "x-e"}
+	out, err := csvFormatter.RenderChangelog(testChanges, opts, "", "")
+	require.NoError(t, err)
+	require.Equal(t, `id,level,section,method,path,operationId,source,text,comment,x-a,x-b,x-c,x-d,x-e
+change_id,error,paths,GET,/api/test,,,This is a breaking change.,,"'=HYPERLINK(""http://evil"")",'+1,'-1,'@SUM(A1),a=b
+`, string(out))
+}
+
+func TestTSVFormatter_RenderChangelog(t *testing.T) {
+	testChanges := checker.Changes{
+		checker.ComponentChange{
+			Id:    "change_id",
+			Level: checker.WARN,
+		},
+	}
+
+	out, err := tsvFormatter.RenderChangelog(testChanges, formatters.NewRenderOpts(), "", "")
+	require.NoError(t, err)
+	require.Equal(t, "id\tlevel\tsection\tmethod\tpath\toperationId\tsource\ttext\tcomment\nchange_id\twarning\tcomponents\t\t\t\t\tThis is a breaking change.\t\n", string(out))
+}
+
+func TestCSVFormatter_RenderChecks(t *testing.T) {
+	checks := formatters.Checks{
+		{
+			Id:          "change_id",
+			Level:       "info",
+			Description: "This is a \"breaking\" change.",
+		},
+	}
+
+	out, err := csvFormatter.RenderChecks(checks, formatters.NewRenderOpts())
+	require.NoError(t, err)
+	require.Equal(t, "id,level,description\nchange_id,info,\"This is a \"\"breaking\"\" change.\"\n", string(out))
+}
+
+func TestCSVFormatter_RenderSummary(t *testing.T) {
+	out, err := csvFormatter.RenderSummary(getTestDiff(t), formatters.NewRenderOpts())
+	require.NoError(t, err)
+	require.Contains(t, string(out), "detail,added,deleted,modified\n")
+	require.Contains(t, string(out), "\npaths,")
+}
+
+func TestCSVFormatter_RenderSummaryNoDiff(t *testing.T) {
+	out, err := csvFormatter.RenderSummary(nil, formatters.NewRenderOpts())
+	require.NoError(t, err)
+	require.Equal(t, "detail,added,deleted,modified\n", string(out))
+}
+
+func TestCSVFormatter_NotImplemented(t *testing.T) {
+	var err error
+	_, err = csvFormatter.RenderDiff(nil, formatters.NewRenderOpts())
+	require.Error(t, err)
+
+	_, err = csvFormatter.RenderFlatten(nil, formatters.NewRenderOpts())
+	require.Error(t, err)
+}
+
+func getTestDiff(t *testing.T) *diff.Diff {
+	t.Helper()
+
+	loader := openapi3.NewLoader()
+	s1, err := load.NewSpecInfo(loader, load.NewSource("../data/openapi-test1.yaml"))
+	require.NoError(t, err)
+	s2, err := load.NewSpecInfo(loader, load.NewSource("../data/openapi-test3.yaml"))
+	require.NoError(t, err)
+	d, _, err := diff.GetWithOperationsSourcesMap(diff.NewConfig(), s1, s2)
+	require.NoError(t, err)
+	return d
+}
@@ -68,8 +68,7 @@ func (f GitHubActionsFormatter) RenderChangelog(changes checker.Changes, opts Re
 }
 
 func getMessage(change checker.Change, l checker.Localizer) string {
-	message := strings.ReplaceAll(change.GetUncolorizedText(l), "\n", "%0A")
-	return fmt.Sprintf("in API %s %s %s", change.GetOperation(), change.GetPath(), message)
+	return strings.ReplaceAll(getChangeMessage(change, l), "\n", "%0A")
 }
 
 func (f GitHubActionsFormatter) SupportedOutputs() []Output {
@@ -0,0 +1,97 @@
+package formatters
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+
+	"github.com/oasdiff/oasdiff/checker"
+)
+
+var gitLabCodeQualitySeverity = map[checker.Level]string{
+	checker.ERR:  "critical",
+	checker.WARN: "major",
+	checker.INFO: "info",
+}
+
+// GitLabCodeQualityIssue is an issue in the GitLab Code Quality report format
+// See https://docs.gitlab.com/ee/ci/testing/code_quality.html#code-quality-report-format
+type GitLabCodeQualityIssue struct {
+	Description string                    `json:"description"`
+	CheckName   string                    `json:"check_name"`
+	Fingerprint string                    `json:"fingerprint"`
+	Severity    string                    `json:"severity"`
+	Location    GitLabCodeQualityLocation `json:"location"`
+}
+
+// GitLabCodeQualityLocation is the location of an issue, Lines is nil when the line of the change is unknown
+type GitLabCodeQualityLocation struct {
+	Path  string                  `json:"path"`
+	Lines *GitLabCodeQualityLines `json:"lines,omitempty"`
+}
+
+type GitLabCodeQualityLines struct {
+	Begin int `json:"begin"`
+	End   int `json:"end,omitempty"`
+}
+
+type GitLabCodeQualityFormatter struct {
+	notImplementedFormatter
+	Localizer checker.Localizer
+}
+
+func newGitLabCodeQualityFormatter(l checker.Localizer) GitLabCodeQualityFormatter {
+	return GitLabCodeQualityFormatter{
+		Localizer: l,
+	}
+}
+
+func (f GitLabCodeQualityFormatter) RenderChangelog(changes checker.Changes, opts RenderOpts, _, _ string) ([]byte, error) {
+	issues := make([]GitLabCodeQualityIssue, 0, len(changes))
+	for _, change := range changes {
+		issues = append(issues, GitLabCodeQualityIssue{
+			Description: getChangeMessage(change, f.Localizer),
+			CheckName:   change.GetId(),
+			Fingerprint: getFingerprint(change, f.Localizer),
+			Severity:    gitLabCodeQualitySeverity[change.GetLevel()],
+			Location: GitLabCodeQualityLocation{
+				Path:  getChangeFile(change, opts.SpecInfoPair),
+				Lines: getGitLabLines(change),
+			},
+		})
+	}
+
+	output, err := json.MarshalIndent(issues, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal gitlab code quality JSON: %w", err)
+	}
+
+	return output, nil
+}
+
+func (f GitLabCodeQualityFormatter) SupportedOutputs() []Output {
+	return []Output{OutputChangelog}
+}
+
+// getGitLabLines converts the zero-based source lines of the change to the one-based lines expected by GitLab
+// It returns nil if the line is unknown, rather than reporting the first line of the file
+func getGitLabLines(change checker.Change) *GitLabCodeQualityLines {
+	if change.GetSourceLine() == 0 {
+		return nil
+	}
+
+	lines := &GitLabCodeQualityLines{
+		Begin: change.GetSourceLine() + 1,
+	}
+	if change.GetSourceLineEnd() > change.GetSourceLine() {
+		lines.End = change.GetSourceLineEnd() + 1
+	}
+	return lines
+}
+
+// getFingerprint returns a stable identifier of the change which GitLab uses to compare the issues of the source and target branches
+func getFingerprint(change checker.Change, l checker.Localizer) string {
+	hash := sha256.Sum256([]byte(change.GetId() + " " + change.GetOperation() + " " + change.GetPath() + " " + change.GetUncolorizedText(l)))
+	return hex.EncodeToString(hash[:])
+}
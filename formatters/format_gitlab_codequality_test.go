@@ -0,0 +1,121 @@
+package formatters_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/formatters"
+	"github.com/oasdiff/oasdiff/load"
+	"github.com/stretchr/testify/require"
+)
+
+var gitLabCodeQualityFormatter = formatters.GitLabCodeQualityFormatter{
+	Localizer: MockLocalizer,
+}
+
+func TestGitLabCodeQualityLookup(t *testing.T) {
+	f, err := formatters.Lookup(string(formatters.FormatGitLabCodeQuality), formatters.DefaultFormatterOpts())
+	require.NoError(t, err)
+	require.IsType(t, formatters.GitLabCodeQualityFormatter{}, f)
+}
+
+func TestGitLabCodeQualityFormatter_RenderChangelog(t *testing.T) {
+	testChanges := checker.Changes{
+		checker.ApiChange{
+			Id:            "change_id",
+			Level:         checker.ERR,
+			Operation:     http.MethodGet,
+			Path:          "/api/test",
+			Source:        load.NewSource("openapi.yaml"),
+			SourceLine:    9,
+			SourceLineEnd: 11,
+		},
+		checker.ApiChange{
+			Id:        "notice_id",
+			Level:     checker.INFO,
+			Operation: http.MethodPost,
+			Path:      "/api/test",
+			Source:    load.NewSource("openapi.yaml"),
+		},
+	}
+
+	output, err := gitLabCodeQualityFormatter.RenderChangelog(testChanges, formatters.NewRenderOpts(), "", "")
+	require.NoError(t, err)
+
+	var issues []formatters.GitLabCodeQualityIssue
+	require.NoError(t, json.Unmarshal(output, &issues))
+	require.Len(t, issues, 2)
+
+	require.Equal(t, "in API GET /api/test This is a breaking change.", issues[0].Description)
+	require.Equal(t, "change_id", issues[0].CheckName)
+	require.Equal(t, "critical", issues[0].Severity)
+	require.Equal(t, formatters.GitLabCodeQualityLocation{Path: "openapi.yaml", Lines: &formatters.GitLabCodeQualityLines{Begin: 10, End: 12}}, issues[0].Location)
+
+	require.Equal(t, "info", issues[1].Severity)
+	require.Nil(t, issues[1].Location.Lines)
+
+	require.Len(t, issues[0].Fingerprint, 64)
+	require.NotEqual(t, issues[0].Fingerprint, issues[1].Fingerprint)
+}
+
+func TestGitLabCodeQualityFormatter_ComponentChange(t *testing.T) {
+	testChanges := checker.Changes{
+		checker.ComponentChange{
+			Id:        "change_id",
+			Level:     checker.ERR,
+			Component: "securitySchemes",
+		},
+	}
+
+	opts := formatters.NewRenderOpts()
+	opts.SpecInfoPair = load.NewSpecInfoPair(&load.SpecInfo{Url: "base.yaml"}, &load.SpecInfo{Url: "revision.yaml"})
+	output, err := gitLabCodeQualityFormatter.RenderChangelog(testChanges, opts, "", "")
+	require.NoError(t, err)
+
+	var issues []formatters.GitLabCodeQualityIssue
+	require.NoError(t, json.Unmarshal(output, &issues))
+	require.Len(t, issues, 1)
+	require.Equal(t, "This is a breaking change.", issues[0].Description)
+	require.Equal(t, "revision.yaml", issues[0].Location.Path)
+}
+
+func TestGitLabCodeQualityFormatter_StableFingerprint(t *testing.T) {
+	testChanges := checker.Changes{
+		checker.ApiChange{
+			Id:        "change_id",
+			Level:     checker.ERR,
+			Operation: http.MethodGet,
+			Path:      "/api/test",
+			Source:    load.NewSource("openapi.yaml"),
+		},
+	}
+
+	output1, err := gitLabCodeQualityFormatter.RenderChangelog(testChanges, formatters.NewRenderOpts(), "", "")
+	require.NoError(t, err)
+	output2, err := gitLabCodeQualityFormatter.RenderChangelog(testChanges, formatters.NewRenderOpts(), "", "")
+	require.NoError(t, err)
+	require.Equal(t, output1, output2)
+}
+
+func TestGitLabCodeQualityFormatter_NoChanges(t *testing.T) {
+	output, err := gitLabCodeQualityFormatter.RenderChangelog(checker.Changes{}, formatters.NewRenderOpts(), "", "")
+	require.NoError(t, err)
+	require.Equal(t, "[]", string(output))
+}
+
+func TestGitLabCodeQualityFormatter_NotImplemented(t *testing.T) {
+	var err error
+	_, err = gitLabCodeQualityFormatter.RenderDiff(nil, formatters.NewRenderOpts())
+	require.Error(t, err)
+
+	_, err = gitLabCodeQualityFormatter.RenderSummary(nil, formatters.NewRenderOpts())
+	require.Error(t, err)
+
+	_, err = gitLabCodeQualityFormatter.RenderChecks(nil, formatters.NewRenderOpts())
+	require.Error(t, err)
+
+	_, err = gitLabCodeQualityFormatter.RenderFlatten(nil, formatters.NewRenderOpts())
+	require.Error(t, err)
+}
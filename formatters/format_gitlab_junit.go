@@ -0,0 +1,105 @@
+package formatters
+
+import (
+	"encoding/xml"
+	"fmt"
+
+	"github.com/oasdiff/oasdiff/checker"
+)
+
+// GitLabJUnitTestSuites is a JUnit report with the attributes that GitLab displays in merge request test summaries
+// See https://docs.gitlab.com/ee/ci/testing/unit_test_reports.html
+type GitLabJUnitTestSuites struct {
+	XMLName    xml.Name               `xml:"testsuites"`
+	Tests      int                    `xml:"tests,attr"`
+	Failures   int                    `xml:"failures,attr"`
+	TestSuites []GitLabJUnitTestSuite `xml:"testsuite"`
+}
+
+type GitLabJUnitTestSuite struct {
+	Name      string                `xml:"name,attr"`
+	Tests     int                   `xml:"tests,attr"`
+	Failures  int                   `xml:"failures,attr"`
+	TestCases []GitLabJUnitTestCase `xml:"testcase"`
+}
+
+type GitLabJUnitTestCase struct {
+	Name      string              `xml:"name,attr"`
+	Classname string              `xml:"classname,attr"`
+	File      string              `xml:"file,attr,omitempty"`
+	Failure   *GitLabJUnitFailure `xml:"failure,omitempty"`
+	SystemOut string              `xml:"system-out,omitempty"`
+}
+
+type GitLabJUnitFailure struct {
+	Message string `xml:"message,attr"`
+	Type    string `xml:"type,attr"`
+	Text    string `xml:",chardata"`
+}
+
+// GitLabJUnitFormatter renders changes as a JUnit report for GitLab merge requests
+// Each endpoint is a test class and each change is a test case, breaking changes (ERR and WARN) are reported as failures
+type GitLabJUnitFormatter struct {
+	notImplementedFormatter
+	Localizer checker.Localizer
+}
+
+func newGitLabJUnitFormatter(l checker.Localizer) GitLabJUnitFormatter {
+	return GitLabJUnitFormatter{
+		Localizer: l,
+	}
+}
+
+// getJUnitClassname returns the endpoint of the change, or its section, like components, if it has no endpoint
+func getJUnitClassname(change checker.Change) string {
+	if change.GetPath() == "" {
+		return change.GetSection()
+	}
+	return change.GetOperation() + " " + change.GetPath()
+}
+
+func (f GitLabJUnitFormatter) RenderChangelog(changes checker.Changes, opts RenderOpts, _, _ string) ([]byte, error) {
+	testSuite := GitLabJUnitTestSuite{
+		Name:      "oasdiff",
+		Tests:     len(changes),
+		TestCases: []GitLabJUnitTestCase{},
+	}
+
+	for _, change := range changes {
+		testCase := GitLabJUnitTestCase{
+			Name:      change.GetId(),
+			Classname: getJUnitClassname(change),
+			File:      getChangeFile(change, opts.SpecInfoPair),
+		}
+
+		message := getChangeMessage(change, f.Localizer)
+		if change.IsBreaking() {
+			testCase.Failure = &GitLabJUnitFailure{
+				Message: change.GetUncolorizedText(f.Localizer),
+				Type:    change.GetLevel().String(),
+				Text:    message,
+			}
+			testSuite.Failures++
+		} else {
+			testCase.SystemOut = message
+		}
+
+		testSuite.TestCases = append(testSuite.TestCases, testCase)
+	}
+
+	testSuites := GitLabJUnitTestSuites{
+		Tests:      testSuite.Tests,
+		Failures:   testSuite.Failures,
+		TestSuites: []GitLabJUnitTestSuite{testSuite},
+	}
+	output, err := xml.MarshalIndent(testSuites, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal gitlab junit XML: %w", err)
+	}
+
+	return []byte(xml.Header + string(output)), nil
+}
+
+func (f GitLabJUnitFormatter) SupportedOutputs() []Output {
+	return []Output{OutputChangelog}
+}
@@ -0,0 +1,97 @@
+package formatters_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/formatters"
+	"github.com/oasdiff/oasdiff/load"
+	"github.com/stretchr/testify/require"
+)
+
+var gitLabJUnitFormatter = formatters.GitLabJUnitFormatter{
+	Localizer: MockLocalizer,
+}
+
+func TestGitLabJUnitLookup(t *testing.T) {
+	f, err := formatters.Lookup(string(formatters.FormatGitLabJUnit), formatters.DefaultFormatterOpts())
+	require.NoError(t, err)
+	require.IsType(t, formatters.GitLabJUnitFormatter{}, f)
+}
+
+func TestGitLabJUnitFormatter_RenderChangelog(t *testing.T) {
+	testChanges := checker.Changes{
+		checker.ApiChange{
+			Id:        "change_id",
+			Level:     checker.ERR,
+			Operation: http.MethodGet,
+			Path:      "/api/test",
+			Source:    load.NewSource("openapi.yaml"),
+		},
+		checker.ApiChange{
+			Id:        "notice_id",
+			Level:     checker.INFO,
+			Operation: http.MethodPost,
+			Path:      "/api/test",
+			Source:    load.NewSource("openapi.yaml"),
+		},
+	}
+
+	output, err := gitLabJUnitFormatter.RenderChangelog(testChanges, formatters.NewRenderOpts(), "", "")
+	require.NoError(t, err)
+	expectedOutput := `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites tests="2" failures="1">
+  <testsuite name="oasdiff" tests="2" failures="1">
+    <testcase name="change_id" classname="GET /api/test" file="openapi.yaml">
+      <failure message="This is a breaking change." type="error">in API GET /api/test This is a breaking change.</failure>
+    </testcase>
+    <testcase name="notice_id" classname="POST /api/test" file="openapi.yaml">
+      <system-out>in API POST /api/test This is a notice.</system-out>
+    </testcase>
+  </testsuite>
+</testsuites>`
+	require.Equal(t, expectedOutput, string(output))
+}
+
+func TestGitLabJUnitFormatter_ComponentChange(t *testing.T) {
+	testChanges := checker.Changes{
+		checker.ComponentChange{
+			Id:        "change_id",
+			Level:     checker.ERR,
+			Component: "securitySchemes",
+		},
+	}
+
+	opts := formatters.NewRenderOpts()
+	opts.SpecInfoPair = load.NewSpecInfoPair(&load.SpecInfo{Url: "base.yaml"}, &load.SpecInfo{Url: "revision.yaml"})
+	output, err := gitLabJUnitFormatter.RenderChangelog(testChanges, opts, "", "")
+	require.NoError(t, err)
+	require.Contains(t, string(output), `<testcase name="change_id" classname="components" file="revision.yaml">`)
+	require.Contains(t, string(output), `<failure message="This is a breaking change." type="error">This is a breaking change.</failure>`)
+}
+
+func TestGitLabJUnitFormatter_NoChanges(t *testing.T) {
+	output, err := gitLabJUnitFormatter.RenderChangelog(checker.Changes{}, formatters.NewRenderOpts(), "", "")
+	require.NoError(t, err)
+	expectedOutput := `<?xml version="1.0" encoding="UTF-8"?>
+<testsuites tests="0" failures="0">
+  <testsuite name="oasdiff" tests="0" failures="0"></testsuite>
+</testsuites>`
+	require.Equal(t, expectedOutput, string(output))
+}
+
+func TestGitLabJUnitFormatter_NotImplemented(t *testing.T) {
+	var err error
+	_, err = gitLabJUnitFormatter.RenderDiff(nil, formatters.NewRenderOpts())
+	require.Error(t, err)
+
+	_, err = gitLabJUnitFormatter.RenderSummary(nil, formatters.NewRenderOpts())
+	require.Error(t, err)
+
+	_, err = gitLabJUnitFormatter.RenderChecks(nil, formatters.NewRenderOpts())
+	require.Error(t, err)
+
+	_, err = gitLabJUnitFormatter.RenderFlatten(nil, formatters.NewRenderOpts())
+	require.Error(t, err)
+}
@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"fmt"
 	"html/template"
-	"os"
 
 	_ "embed"
 
@@ -25,6 +24,10 @@ func newHTMLFormatter(l checker.Localizer) HTMLFormatter {
 }
 
 func (f HTMLFormatter) RenderDiff(diff *diff.Diff, opts RenderOpts) ([]byte, error) {
+	if opts.TemplatePath != "" {
+		return f.renderCustomTemplate(opts.TemplatePath, newDiffTemplateData(diff, opts))
+	}
+
 	reportAsString, err := report.GetHTMLReportAsString(diff)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate HTML report: %w", err)
@@ -37,28 +40,31 @@ func (f HTMLFormatter) RenderDiff(diff *diff.Diff, opts RenderOpts) ([]byte, err
 var changelogHtml string
 
 func (f HTMLFormatter) RenderChangelog(changes checker.Changes, opts RenderOpts, baseVersion, revisionVersion string) ([]byte, error) {
-	var tmpl *template.Template
-	var err error
-
 	if opts.TemplatePath != "" {
-		tmpl, err = f.loadCustomTemplate(opts.TemplatePath)
-		if err != nil {
-			return nil, fmt.Errorf("failed to load custom template: %w", err)
-		}
-	} else {
-		tmpl = template.Must(template.New("changelog").Parse(changelogHtml))
+		return f.renderCustomTemplate(opts.TemplatePath, newChangelogTemplateData(changes, opts, baseVersion, revisionVersion, f.Localizer))
 	}
 
+	tmpl := template.Must(template.New("changelog").Parse(changelogHtml))
 	return ExecuteHtmlTemplate(tmpl, GroupChanges(changes, f.Localizer), baseVersion, revisionVersion)
 }
 
+// renderCustomTemplate renders the data with a user-provided template, see docs/TEMPLATES.md
+func (f HTMLFormatter) renderCustomTemplate(templatePath string, data TemplateData) ([]byte, error) {
+	tmpl, err := f.loadCustomTemplate(templatePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load custom template: %w", err)
+	}
+
+	return executeHtmlTemplate(tmpl, data)
+}
+
 func (f HTMLFormatter) loadCustomTemplate(templatePath string) (*template.Template, error) {
-	templateContent, err := os.ReadFile(templatePath)
+	templateContent, err := readTemplate(templatePath)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read template file %s: %w", templatePath, err)
+		return nil, err
 	}
 
-	tmpl, err := template.New("custom-changelog").Parse(string(templateContent))
+	tmpl, err := template.New("custom").Funcs(getTemplateFuncs(f.Localizer)).Parse(templateContent)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse template: %w", err)
 	}
@@ -68,14 +74,46 @@ func (f HTMLFormatter) loadCustomTemplate(templatePath string) (*template.Templa
 
 func ExecuteHtmlTemplate(tmpl *template.Template, changes ChangesByEndpoint, baseVersion, revisionVersion string) ([]byte, error) {
 	var out bytes.Buffer
-	if err := tmpl.Execute(&out, TemplateData{changes, baseVersion, revisionVersion}); err != nil {
+	if err := tmpl.Execute(&out, TemplateData{APIChanges: changes, BaseVersion: baseVersion, RevisionVersion: revisionVersion}); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+//go:embed templates/summary.html
+var summaryHtml string
+
+func (f HTMLFormatter) RenderSummary(diff *diff.Diff, opts RenderOpts) ([]byte, error) {
+	if opts.TemplatePath != "" {
+		return f.renderCustomTemplate(opts.TemplatePath, newDiffTemplateData(diff, opts))
+	}
+
+	tmpl := template.Must(template.New("summary").Parse(summaryHtml))
+	return executeHtmlTemplate(tmpl, newSummaryData(diff))
+}
+
+//go:embed templates/checks.html
+var checksHtml string
+
+func (f HTMLFormatter) RenderChecks(checks Checks, opts RenderOpts) ([]byte, error) {
+	if opts.TemplatePath != "" {
+		return f.renderCustomTemplate(opts.TemplatePath, newChecksTemplateData(checks, opts, f.Localizer))
+	}
+
+	tmpl := template.Must(template.New("checks").Parse(checksHtml))
+	return executeHtmlTemplate(tmpl, checks.localize(f.Localizer))
+}
+
+func executeHtmlTemplate(tmpl *template.Template, data any) ([]byte, error) {
+	var out bytes.Buffer
+	if err := tmpl.Execute(&out, data); err != nil {
 		return nil, err
 	}
 	return out.Bytes(), nil
 }
 
 func (f HTMLFormatter) SupportedOutputs() []Output {
-	return []Output{OutputDiff, OutputChangelog}
+	return []Output{OutputDiff, OutputSummary, OutputChangelog, OutputChecks}
 }
 
 func (f HTMLFormatter) SupportsTemplate() bool {
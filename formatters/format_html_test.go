@@ -80,17 +80,42 @@ func TestHtmlFormatter_RenderChangelog_WithBaseAndRevisionVersion(t *testing.T)
 	require.Contains(t, string(out), "<div class=\"title\">API Changelog 1.0.0 vs. 2.0.0</div>")
 }
 
+func TestHtmlFormatter_RenderSummary(t *testing.T) {
+	out, err := htmlFormatter.RenderSummary(getTestDiff(t), formatters.NewRenderOpts())
+	require.NoError(t, err)
+	require.Contains(t, string(out), "API Summary")
+	require.Contains(t, string(out), "paths")
+}
+
+func TestHtmlFormatter_RenderSummaryNoChanges(t *testing.T) {
+	out, err := htmlFormatter.RenderSummary(nil, formatters.NewRenderOpts())
+	require.NoError(t, err)
+	require.Contains(t, string(out), "No changes")
+}
+
+func TestHtmlFormatter_RenderChecks(t *testing.T) {
+	checks := formatters.Checks{
+		{
+			Id:          "change_id",
+			Level:       "error",
+			Description: "This is a breaking change.",
+			Direction:   "request",
+			Location:    "body",
+			Action:      "remove",
+		},
+	}
+
+	out, err := htmlFormatter.RenderChecks(checks, formatters.NewRenderOpts())
+	require.NoError(t, err)
+	require.Contains(t, string(out), "change_id")
+	require.Contains(t, string(out), "remove")
+}
+
 func TestHtmlFormatter_NotImplemented(t *testing.T) {
 	var err error
 
-	_, err = htmlFormatter.RenderChecks(formatters.Checks{}, formatters.NewRenderOpts())
-	assert.Error(t, err)
-
 	_, err = htmlFormatter.RenderFlatten(nil, formatters.NewRenderOpts())
 	assert.Error(t, err)
-
-	_, err = htmlFormatter.RenderSummary(nil, formatters.NewRenderOpts())
-	assert.Error(t, err)
 }
 
 //go:embed templates/changelog.html
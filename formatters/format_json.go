@@ -60,7 +60,10 @@ func printJSON(output interface{}) ([]byte, error) {
 
 func adaptStructure(output any, wrapInObject bool) any {
 	if wrapInObject {
-		output = map[string]any{"changes": output}
+		output = map[string]any{
+			"schemaVersion": SchemaVersion,
+			"changes":       output,
+		}
 	}
 
 	return output
@@ -31,6 +31,19 @@ func TestJsonFormatter_RenderChangelog(t *testing.T) {
 	require.Equal(t, "[{\"id\":\"change_id\",\"text\":\"This is a breaking change.\",\"level\":3,\"section\":\"components\"}]", string(out))
 }
 
+func TestJsonFormatter_RenderChangelogWithWrapInObject(t *testing.T) {
+	testChanges := checker.Changes{
+		checker.ComponentChange{
+			Id:    "change_id",
+			Level: checker.ERR,
+		},
+	}
+
+	out, err := jsonFormatter.RenderChangelog(testChanges, formatters.RenderOpts{WrapInObject: true}, "", "")
+	require.NoError(t, err)
+	require.Equal(t, "{\"changes\":[{\"id\":\"change_id\",\"text\":\"This is a breaking change.\",\"level\":3,\"section\":\"components\"}],\"schemaVersion\":\""+formatters.SchemaVersion+"\"}", string(out))
+}
+
 func TestJsonFormatter_RenderChecks(t *testing.T) {
 	checks := formatters.Checks{
 		{
@@ -0,0 +1,74 @@
+package formatters
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/yaml"
+	"github.com/wI2L/jsondiff"
+)
+
+// EmptyJSONPatch is the JSON Patch between identical documents
+const EmptyJSONPatch = "[]"
+
+// JSONPatchFormatter renders the diff as an RFC 6902 JSON Patch that transforms the base spec into the revision spec
+type JSONPatchFormatter struct {
+	notImplementedFormatter
+	Localizer checker.Localizer
+}
+
+func newJSONPatchFormatter(l checker.Localizer) JSONPatchFormatter {
+	return JSONPatchFormatter{
+		Localizer: l,
+	}
+}
+
+// RenderDocumentPatch compares the raw base and revision documents, in yaml or json, so applying the patch to the base document yields the revision document
+func (f JSONPatchFormatter) RenderDocumentPatch(base, revision []byte, opts RenderOpts) ([]byte, error) {
+	baseJson, err := yaml.YAMLToJSON(base)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse base document: %w", err)
+	}
+
+	revisionJson, err := yaml.YAMLToJSON(revision)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse revision document: %w", err)
+	}
+
+	return renderJSONPatch(baseJson, revisionJson)
+}
+
+// RenderPatch compares the JSON representations of loaded specs, which are normalized by the loader
+// Use RenderDocumentPatch to get a patch that applies to the original base document
+func (f JSONPatchFormatter) RenderPatch(base, revision *openapi3.T, opts RenderOpts) ([]byte, error) {
+	baseJson, err := json.Marshal(base)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal base spec: %w", err)
+	}
+
+	revisionJson, err := json.Marshal(revision)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal revision spec: %w", err)
+	}
+
+	return renderJSONPatch(baseJson, revisionJson)
+}
+
+func renderJSONPatch(base, revision []byte) ([]byte, error) {
+	patch, err := jsondiff.CompareJSON(base, revision)
+	if err != nil {
+		return nil, fmt.Errorf("failed to compare specs: %w", err)
+	}
+
+	if patch == nil {
+		patch = jsondiff.Patch{}
+	}
+
+	return printJSON(patch)
+}
+
+func (f JSONPatchFormatter) SupportedOutputs() []Output {
+	return []Output{OutputPatch}
+}
@@ -0,0 +1,113 @@
+package formatters_test
+
+import (
+	"encoding/json"
+	"os"
+	"testing"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oasdiff/oasdiff/formatters"
+	"github.com/oasdiff/yaml"
+	"github.com/stretchr/testify/require"
+)
+
+var jsonPatchFormatter = formatters.JSONPatchFormatter{
+	Localizer: MockLocalizer,
+}
+
+func TestJSONPatchLookup(t *testing.T) {
+	f, err := formatters.Lookup(string(formatters.FormatJSONPatch), formatters.DefaultFormatterOpts())
+	require.NoError(t, err)
+	require.IsType(t, formatters.JSONPatchFormatter{}, f)
+}
+
+func readJSONDocument(t *testing.T, file string) ([]byte, []byte) {
+	t.Helper()
+
+	document, err := os.ReadFile(file)
+	require.NoError(t, err)
+	documentJson, err := yaml.YAMLToJSON(document)
+	require.NoError(t, err)
+	return document, documentJson
+}
+
+func TestJSONPatchFormatter_RoundTrip(t *testing.T) {
+	base, baseJson := readJSONDocument(t, "../data/openapi-test1.yaml")
+	revision, revisionJson := readJSONDocument(t, "../data/openapi-test3.yaml")
+
+	out, err := jsonPatchFormatter.RenderDocumentPatch(base, revision, formatters.NewRenderOpts())
+	require.NoError(t, err)
+
+	patch, err := jsonpatch.DecodePatch(out)
+	require.NoError(t, err)
+	require.NotEmpty(t, patch)
+
+	patched, err := patch.Apply(baseJson)
+	require.NoError(t, err)
+	require.JSONEq(t, string(revisionJson), string(patched))
+}
+
+// the loader drops empty tags, but the patch between the raw documents keeps them
+func TestJSONPatchFormatter_RawDocument(t *testing.T) {
+	base := []byte("openapi: 3.0.0\ninfo:\n  title: test\n  version: '1'\npaths: {}\n")
+	revision := []byte("openapi: 3.0.0\ninfo:\n  title: test\n  version: '1'\npaths: {}\ntags: []\n")
+
+	out, err := jsonPatchFormatter.RenderDocumentPatch(base, revision, formatters.NewRenderOpts())
+	require.NoError(t, err)
+	require.JSONEq(t, `[{"op":"add","path":"/tags","value":[]}]`, string(out))
+}
+
+func TestJSONPatchFormatter_Specs(t *testing.T) {
+	loader := openapi3.NewLoader()
+	base, err := loader.LoadFromFile("../data/openapi-test1.yaml")
+	require.NoError(t, err)
+	revision, err := loader.LoadFromFile("../data/openapi-test3.yaml")
+	require.NoError(t, err)
+
+	out, err := jsonPatchFormatter.RenderPatch(base, revision, formatters.NewRenderOpts())
+	require.NoError(t, err)
+
+	patch, err := jsonpatch.DecodePatch(out)
+	require.NoError(t, err)
+
+	baseJson, err := json.Marshal(base)
+	require.NoError(t, err)
+	revisionJson, err := json.Marshal(revision)
+	require.NoError(t, err)
+
+	patched, err := patch.Apply(baseJson)
+	require.NoError(t, err)
+	require.JSONEq(t, string(revisionJson), string(patched))
+}
+
+func TestJSONPatchFormatter_NoChanges(t *testing.T) {
+	document, _ := readJSONDocument(t, "../data/openapi-test1.yaml")
+
+	out, err := jsonPatchFormatter.RenderDocumentPatch(document, document, formatters.NewRenderOpts())
+	require.NoError(t, err)
+	require.Equal(t, formatters.EmptyJSONPatch, string(out))
+}
+
+func TestJSONPatchFormatter_InvalidDocument(t *testing.T) {
+	_, err := jsonPatchFormatter.RenderDocumentPatch([]byte("a: [b"), []byte("{}"), formatters.NewRenderOpts())
+	require.ErrorContains(t, err, "failed to parse base document")
+}
+
+func TestJSONPatchFormatter_NotImplemented(t *testing.T) {
+	var err error
+	_, err = jsonPatchFormatter.RenderDiff(nil, formatters.NewRenderOpts())
+	require.Error(t, err)
+
+	_, err = jsonPatchFormatter.RenderSummary(nil, formatters.NewRenderOpts())
+	require.Error(t, err)
+
+	_, err = jsonPatchFormatter.RenderChangelog(nil, formatters.NewRenderOpts(), "", "")
+	require.Error(t, err)
+
+	_, err = jsonPatchFormatter.RenderChecks(nil, formatters.NewRenderOpts())
+	require.Error(t, err)
+
+	_, err = jsonPatchFormatter.RenderFlatten(nil, formatters.NewRenderOpts())
+	require.Error(t, err)
+}
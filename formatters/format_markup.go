@@ -3,7 +3,7 @@ package formatters
 import (
 	"bytes"
 	"fmt"
-	"os"
+	"strings"
 	"text/template"
 
 	_ "embed"
@@ -25,6 +25,10 @@ func newMarkupFormatter(l checker.Localizer) MarkupFormatter {
 }
 
 func (f MarkupFormatter) RenderDiff(diff *diff.Diff, opts RenderOpts) ([]byte, error) {
+	if opts.TemplatePath != "" {
+		return f.renderCustomTemplate(opts.TemplatePath, newDiffTemplateData(diff, opts))
+	}
+
 	return []byte(report.GetTextReportAsString(diff)), nil
 }
 
@@ -32,28 +36,31 @@ func (f MarkupFormatter) RenderDiff(diff *diff.Diff, opts RenderOpts) ([]byte, e
 var changelogMarkdown string
 
 func (f MarkupFormatter) RenderChangelog(changes checker.Changes, opts RenderOpts, baseVersion, revisionVersion string) ([]byte, error) {
-	var tmpl *template.Template
-	var err error
-
 	if opts.TemplatePath != "" {
-		tmpl, err = f.loadCustomTemplate(opts.TemplatePath)
-		if err != nil {
-			return nil, fmt.Errorf("failed to load custom template: %w", err)
-		}
-	} else {
-		tmpl = template.Must(template.New("changelog").Parse(changelogMarkdown))
+		return f.renderCustomTemplate(opts.TemplatePath, newChangelogTemplateData(changes, opts, baseVersion, revisionVersion, f.Localizer))
 	}
 
+	tmpl := template.Must(template.New("changelog").Parse(changelogMarkdown))
 	return ExecuteTextTemplate(tmpl, GroupChanges(changes, f.Localizer), baseVersion, revisionVersion)
 }
 
+// renderCustomTemplate renders the data with a user-provided template, see docs/TEMPLATES.md
+func (f MarkupFormatter) renderCustomTemplate(templatePath string, data TemplateData) ([]byte, error) {
+	tmpl, err := f.loadCustomTemplate(templatePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load custom template: %w", err)
+	}
+
+	return executeTextTemplate(tmpl, data)
+}
+
 func (f MarkupFormatter) loadCustomTemplate(templatePath string) (*template.Template, error) {
-	templateContent, err := os.ReadFile(templatePath)
+	templateContent, err := readTemplate(templatePath)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read template file %s: %w", templatePath, err)
+		return nil, err
 	}
 
-	tmpl, err := template.New("custom-changelog").Parse(string(templateContent))
+	tmpl, err := template.New("custom").Funcs(getTemplateFuncs(f.Localizer)).Parse(templateContent)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse template: %w", err)
 	}
@@ -63,14 +70,51 @@ func (f MarkupFormatter) loadCustomTemplate(templatePath string) (*template.Temp
 
 func ExecuteTextTemplate(tmpl *template.Template, changes ChangesByEndpoint, baseVersion, revisionVersion string) ([]byte, error) {
 	var out bytes.Buffer
-	if err := tmpl.Execute(&out, TemplateData{changes, baseVersion, revisionVersion}); err != nil {
+	if err := tmpl.Execute(&out, TemplateData{APIChanges: changes, BaseVersion: baseVersion, RevisionVersion: revisionVersion}); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+//go:embed templates/summary.md
+var summaryMarkdown string
+
+func (f MarkupFormatter) RenderSummary(diff *diff.Diff, opts RenderOpts) ([]byte, error) {
+	if opts.TemplatePath != "" {
+		return f.renderCustomTemplate(opts.TemplatePath, newDiffTemplateData(diff, opts))
+	}
+
+	tmpl := template.Must(template.New("summary").Parse(summaryMarkdown))
+	return executeTextTemplate(tmpl, newSummaryData(diff))
+}
+
+//go:embed templates/checks.md
+var checksMarkdown string
+
+func (f MarkupFormatter) RenderChecks(checks Checks, opts RenderOpts) ([]byte, error) {
+	if opts.TemplatePath != "" {
+		return f.renderCustomTemplate(opts.TemplatePath, newChecksTemplateData(checks, opts, f.Localizer))
+	}
+
+	tmpl := template.Must(template.New("checks").Funcs(template.FuncMap{"cell": markdownCell}).Parse(checksMarkdown))
+	return executeTextTemplate(tmpl, checks.localize(f.Localizer))
+}
+
+// markdownCell escapes text for a markdown table cell
+func markdownCell(text string) string {
+	return strings.NewReplacer("|", "\\|", "\n", " ").Replace(text)
+}
+
+func executeTextTemplate(tmpl *template.Template, data any) ([]byte, error) {
+	var out bytes.Buffer
+	if err := tmpl.Execute(&out, data); err != nil {
 		return nil, err
 	}
 	return out.Bytes(), nil
 }
 
 func (f MarkupFormatter) SupportedOutputs() []Output {
-	return []Output{OutputDiff, OutputChangelog}
+	return []Output{OutputDiff, OutputSummary, OutputChangelog, OutputChecks}
 }
 
 func (f MarkupFormatter) SupportsTemplate() bool {
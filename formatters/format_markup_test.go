@@ -75,17 +75,42 @@ func TestMarkupFormatter_RenderChangelog_WithVersions(t *testing.T) {
 	require.Contains(t, string(out), "# API Changelog 1.0.0 vs. 2.0.0")
 }
 
+func TestMarkupFormatter_RenderSummary(t *testing.T) {
+	out, err := markupFormatter.RenderSummary(getTestDiff(t), formatters.NewRenderOpts())
+	require.NoError(t, err)
+	require.Contains(t, string(out), "# API Summary")
+	require.Contains(t, string(out), "paths")
+}
+
+func TestMarkupFormatter_RenderSummaryNoChanges(t *testing.T) {
+	out, err := markupFormatter.RenderSummary(nil, formatters.NewRenderOpts())
+	require.NoError(t, err)
+	require.Contains(t, string(out), "No changes")
+}
+
+func TestMarkupFormatter_RenderChecks(t *testing.T) {
+	checks := formatters.Checks{
+		{
+			Id:          "change_id",
+			Level:       "error",
+			Description: "This is a breaking change.",
+			Direction:   "request",
+			Location:    "body",
+			Action:      "remove",
+		},
+	}
+
+	out, err := markupFormatter.RenderChecks(checks, formatters.NewRenderOpts())
+	require.NoError(t, err)
+	require.Contains(t, string(out), "change_id")
+	require.Contains(t, string(out), "remove")
+}
+
 func TestMarkupFormatter_NotImplemented(t *testing.T) {
 	var err error
 
-	_, err = markupFormatter.RenderChecks(formatters.Checks{}, formatters.NewRenderOpts())
-	assert.Error(t, err)
-
 	_, err = markupFormatter.RenderFlatten(nil, formatters.NewRenderOpts())
 	assert.Error(t, err)
-
-	_, err = markupFormatter.RenderSummary(nil, formatters.NewRenderOpts())
-	assert.Error(t, err)
 }
 
 func TestExecuteMarkupTemplate_Err(t *testing.T) {
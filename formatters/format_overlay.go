@@ -0,0 +1,40 @@
+package formatters
+
+import (
+	"fmt"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/overlay"
+	"gopkg.in/yaml.v3"
+)
+
+// OverlayFormatter renders the diff as an OpenAPI Overlay document that transforms the base spec into the revision spec
+type OverlayFormatter struct {
+	notImplementedFormatter
+	Localizer checker.Localizer
+}
+
+func newOverlayFormatter(l checker.Localizer) OverlayFormatter {
+	return OverlayFormatter{
+		Localizer: l,
+	}
+}
+
+func (f OverlayFormatter) RenderPatch(base, revision *openapi3.T, opts RenderOpts) ([]byte, error) {
+	result, err := overlay.Generate(base, revision)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate overlay: %w", err)
+	}
+
+	output, err := yaml.Marshal(result)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal overlay: %w", err)
+	}
+
+	return output, nil
+}
+
+func (f OverlayFormatter) SupportedOutputs() []Output {
+	return []Output{OutputPatch}
+}
@@ -0,0 +1,55 @@
+package formatters_test
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oasdiff/oasdiff/formatters"
+	"github.com/oasdiff/oasdiff/overlay"
+	"github.com/stretchr/testify/require"
+)
+
+var overlayFormatter = formatters.OverlayFormatter{
+	Localizer: MockLocalizer,
+}
+
+func TestOverlayLookup(t *testing.T) {
+	f, err := formatters.Lookup(string(formatters.FormatOverlay), formatters.DefaultFormatterOpts())
+	require.NoError(t, err)
+	require.IsType(t, formatters.OverlayFormatter{}, f)
+}
+
+func TestOverlayFormatter_RenderPatch(t *testing.T) {
+	loader := openapi3.NewLoader()
+	base, err := loader.LoadFromFile("../data/overlay/base.yaml")
+	require.NoError(t, err)
+	revision, err := loader.LoadFromFile("../data/overlay/revision.yaml")
+	require.NoError(t, err)
+
+	out, err := overlayFormatter.RenderPatch(base, revision, formatters.NewRenderOpts())
+	require.NoError(t, err)
+
+	o, err := overlay.Parse(out)
+	require.NoError(t, err)
+	require.Equal(t, overlay.Version, o.Overlay)
+	require.Equal(t, "Changes from 1.0.0 to 1.1.0", o.Info.Title)
+	require.Contains(t, o.Actions, overlay.Action{Target: "$.paths['/admin/pets']", Remove: true})
+}
+
+func TestOverlayFormatter_NotImplemented(t *testing.T) {
+	var err error
+	_, err = overlayFormatter.RenderDiff(nil, formatters.NewRenderOpts())
+	require.Error(t, err)
+
+	_, err = overlayFormatter.RenderSummary(nil, formatters.NewRenderOpts())
+	require.Error(t, err)
+
+	_, err = overlayFormatter.RenderChangelog(nil, formatters.NewRenderOpts(), "", "")
+	require.Error(t, err)
+
+	_, err = overlayFormatter.RenderChecks(nil, formatters.NewRenderOpts())
+	require.Error(t, err)
+
+	_, err = overlayFormatter.RenderFlatten(nil, formatters.NewRenderOpts())
+	require.Error(t, err)
+}
@@ -0,0 +1,212 @@
+package formatters
+
+import (
+	"fmt"
+	"html"
+	"sort"
+	"strings"
+
+	"github.com/oasdiff/oasdiff/checker"
+)
+
+const (
+	// PRCommentMarker is a hidden line at the top of the pr-comment output
+	// Bots can search for it to update an existing comment rather than add a new one
+	PRCommentMarker = "<!-- oasdiff:pr-comment -->"
+
+	// DefaultPRCommentMaxSize is the default byte budget of the pr-comment output, GitHub comments are limited to 65536 characters
+	DefaultPRCommentMaxSize = 65536
+
+	GroupByEndpoint = "endpoint"
+	GroupByTag      = "tag"
+
+	prCommentFooter = "_...and %d more changes_\n"
+	untaggedGroup   = "untagged"
+)
+
+func GetSupportedGroupBy() []string {
+	return []string{GroupByEndpoint, GroupByTag}
+}
+
+var prCommentIcons = map[checker.Level]string{
+	checker.ERR:  ":x:",
+	checker.WARN: ":warning:",
+	checker.INFO: ":information_source:",
+}
+
+// prCommentTextEscaper escapes the text of changes, which may contain names and values from the specs, so that it can't break the markdown and HTML layout of the comment
+var prCommentTextEscaper = strings.NewReplacer(
+	"&", "&amp;",
+	"<", "&lt;",
+	">", "&gt;",
+	"|", "\\|",
+	"`", "\\`",
+)
+
+// PRCommentFormatter renders the changelog as a markdown comment for pull requests
+// Changes are grouped into collapsible sections and the output is truncated to fit the byte budget in RenderOpts.MaxSize
+type PRCommentFormatter struct {
+	notImplementedFormatter
+	Localizer checker.Localizer
+}
+
+func newPRCommentFormatter(l checker.Localizer) PRCommentFormatter {
+	return PRCommentFormatter{
+		Localizer: l,
+	}
+}
+
+func (f PRCommentFormatter) RenderChangelog(changes checker.Changes, opts RenderOpts, baseVersion, revisionVersion string) ([]byte, error) {
+	maxSize := opts.MaxSize
+	if maxSize <= 0 {
+		maxSize = DefaultPRCommentMaxSize
+	}
+
+	var out strings.Builder
+	out.WriteString(PRCommentMarker + "\n")
+	out.WriteString(f.getHeadline(changes, baseVersion, revisionVersion))
+
+	if len(changes) == 0 {
+		return []byte(out.String()), nil
+	}
+
+	footerSize := len(fmt.Sprintf(prCommentFooter, len(changes)))
+	omitted := 0
+	for _, group := range f.groupChanges(changes, opts) {
+		if omitted > 0 {
+			omitted += len(group.changes)
+			continue
+		}
+
+		open := fmt.Sprintf("<details>\n<summary>%s (%d)</summary>\n\n", group.title, len(group.changes))
+		if group.level == checker.ERR {
+			open = strings.Replace(open, "<details>", "<details open>", 1)
+		}
+		const close = "\n</details>\n\n"
+
+		var lines strings.Builder
+		count := 0
+		for _, change := range group.changes {
+			line := fmt.Sprintf("- %s %s\n", prCommentIcons[change.GetLevel()], f.getText(change, opts.GroupBy))
+			if out.Len()+len(open)+lines.Len()+len(line)+len(close)+footerSize > maxSize {
+				break
+			}
+			lines.WriteString(line)
+			count++
+		}
+
+		omitted += len(group.changes) - count
+		if count == 0 {
+			continue
+		}
+
+		out.WriteString(open)
+		out.WriteString(lines.String())
+		out.WriteString(close)
+	}
+
+	if omitted > 0 {
+		out.WriteString(fmt.Sprintf(prCommentFooter, omitted))
+	}
+
+	return []byte(out.String()), nil
+}
+
+// getHeadline returns the verdict followed by the number of changes per level
+func (f PRCommentFormatter) getHeadline(changes checker.Changes, baseVersion, revisionVersion string) string {
+	title := "API Changelog"
+	if version := (TemplateData{BaseVersion: baseVersion, RevisionVersion: revisionVersion}).GetVersionTitle(); version != "" {
+		title += " " + version
+	}
+
+	counts := changes.GetLevelCount()
+
+	var verdict string
+	switch {
+	case len(changes) == 0:
+		verdict = ":white_check_mark: No changes"
+	case counts[checker.ERR] > 0:
+		verdict = ":x: Breaking changes"
+	case counts[checker.WARN] > 0:
+		verdict = ":warning: Potentially breaking changes"
+	default:
+		verdict = ":white_check_mark: No breaking changes"
+	}
+
+	result := fmt.Sprintf("## %s\n\n**%s**\n\n", title, verdict)
+	if len(changes) == 0 {
+		return result
+	}
+
+	return result + fmt.Sprintf("| %s %s | %s %s | %s %s |\n| ---: | ---: | ---: |\n| %d | %d | %d |\n\n",
+		prCommentIcons[checker.ERR], checker.ERR,
+		prCommentIcons[checker.WARN], checker.WARN,
+		prCommentIcons[checker.INFO], checker.INFO,
+		counts[checker.ERR], counts[checker.WARN], counts[checker.INFO])
+}
+
+// getText returns the text of a change, prefixed by its endpoint when the changes are grouped by tag
+func (f PRCommentFormatter) getText(change checker.Change, groupBy string) string {
+	text := prCommentTextEscaper.Replace(change.GetUncolorizedText(f.Localizer))
+	if groupBy == GroupByTag && change.GetPath() != "" {
+		return fmt.Sprintf("`%s %s` %s", change.GetOperation(), change.GetPath(), text)
+	}
+	return text
+}
+
+type prCommentGroup struct {
+	title   string
+	level   checker.Level // the highest level of the changes in the group
+	changes checker.Changes
+}
+
+// groupChanges groups the changes by endpoint or by the first tag of the operation
+// Changes outside of paths are grouped by their section, e.g. components
+// Groups are sorted by their highest level so that truncation drops the least important changes
+func (f PRCommentFormatter) groupChanges(changes checker.Changes, opts RenderOpts) []*prCommentGroup {
+	groups := map[string]*prCommentGroup{}
+	for _, change := range changes {
+		title := getPRCommentGroupTitle(change, opts)
+		group, ok := groups[title]
+		if !ok {
+			group = &prCommentGroup{title: title}
+			groups[title] = group
+		}
+		group.changes = append(group.changes, change)
+		if change.GetLevel() > group.level {
+			group.level = change.GetLevel()
+		}
+	}
+
+	result := make([]*prCommentGroup, 0, len(groups))
+	for _, group := range groups {
+		result = append(result, group)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if result[i].level != result[j].level {
+			return result[i].level > result[j].level
+		}
+		return result[i].title < result[j].title
+	})
+
+	return result
+}
+
+func getPRCommentGroupTitle(change checker.Change, opts RenderOpts) string {
+	if change.GetPath() == "" {
+		return html.EscapeString(change.GetSection())
+	}
+
+	if opts.GroupBy == GroupByTag {
+		if tags := getOperationTags(opts.SpecInfoPair, change.GetPath(), change.GetOperation()); len(tags) > 0 {
+			return html.EscapeString(tags[0])
+		}
+		return untaggedGroup
+	}
+
+	return fmt.Sprintf("<code>%s %s</code>", html.EscapeString(change.GetOperation()), html.EscapeString(change.GetPath()))
+}
+
+func (f PRCommentFormatter) SupportedOutputs() []Output {
+	return []Output{OutputChangelog}
+}
@@ -0,0 +1,143 @@
+package formatters_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/formatters"
+	"github.com/oasdiff/oasdiff/load"
+	"github.com/stretchr/testify/require"
+)
+
+var prCommentFormatter = formatters.PRCommentFormatter{
+	Localizer: MockLocalizer,
+}
+
+func TestPRCommentLookup(t *testing.T) {
+	f, err := formatters.Lookup(string(formatters.FormatPRComment), formatters.DefaultFormatterOpts())
+	require.NoError(t, err)
+	require.IsType(t, formatters.PRCommentFormatter{}, f)
+}
+
+func getPRCommentTestChanges() checker.Changes {
+	return checker.Changes{
+		checker.ApiChange{
+			Id:        "change_id",
+			Level:     checker.INFO,
+			Operation: "GET",
+			Path:      "/info",
+		},
+		checker.ApiChange{
+			Id:        "change_id",
+			Level:     checker.ERR,
+			Operation: "POST",
+			Path:      "/error",
+		},
+		checker.ApiChange{
+			Id:        "change_id",
+			Level:     checker.WARN,
+			Operation: "GET",
+			Path:      "/warning",
+		},
+	}
+}
+
+func TestPRCommentFormatter_RenderChangelog(t *testing.T) {
+	out, err := prCommentFormatter.RenderChangelog(getPRCommentTestChanges(), formatters.NewRenderOpts(), "1.0.0", "2.0.0")
+	require.NoError(t, err)
+
+	output := string(out)
+	require.True(t, strings.HasPrefix(output, formatters.PRCommentMarker+"\n"))
+	require.Contains(t, output, "## API Changelog 1.0.0 vs. 2.0.0")
+	require.Contains(t, output, "**:x: Breaking changes**")
+	require.Contains(t, output, "| 1 | 1 | 1 |")
+	require.Contains(t, output, "<details open>\n<summary><code>POST /error</code> (1)</summary>")
+	require.Contains(t, output, "<details>\n<summary><code>GET /warning</code> (1)</summary>")
+	require.NotContains(t, output, "more changes")
+
+	// groups are sorted by level
+	require.Less(t, strings.Index(output, "/error"), strings.Index(output, "/warning"))
+	require.Less(t, strings.Index(output, "/warning"), strings.Index(output, "/info"))
+}
+
+func TestPRCommentFormatter_RenderChangelogByTag(t *testing.T) {
+	opts := formatters.NewRenderOpts()
+	opts.GroupBy = formatters.GroupByTag
+	revision := newTaggedSpecInfo(map[string][]string{
+		"GET /info":    {"pets"},
+		"POST /error":  {"stores", "pets"},
+		"GET /warning": nil,
+	})
+	opts.SpecInfoPair = load.NewSpecInfoPair(revision, revision)
+
+	out, err := prCommentFormatter.RenderChangelog(getPRCommentTestChanges(), opts, "", "")
+	require.NoError(t, err)
+
+	output := string(out)
+	require.Contains(t, output, "<summary>stores (1)</summary>")
+	require.Contains(t, output, "<summary>pets (1)</summary>")
+	require.Contains(t, output, "<summary>untagged (1)</summary>")
+	require.Contains(t, output, "- :x: `POST /error` This is a breaking change.")
+}
+
+func TestPRCommentFormatter_RenderChangelogNoChanges(t *testing.T) {
+	out, err := prCommentFormatter.RenderChangelog(checker.Changes{}, formatters.NewRenderOpts(), "", "")
+	require.NoError(t, err)
+	require.Equal(t, formatters.PRCommentMarker+"\n## API Changelog\n\n**:white_check_mark: No changes**\n\n", string(out))
+}
+
+func TestPRCommentFormatter_RenderChangelogNonBreaking(t *testing.T) {
+	out, err := prCommentFormatter.RenderChangelog(getPRCommentTestChanges()[:1], formatters.NewRenderOpts(), "", "")
+	require.NoError(t, err)
+	require.Contains(t, string(out), "**:white_check_mark: No breaking changes**")
+}
+
+func TestPRCommentFormatter_RenderChangelogTruncated(t *testing.T) {
+	changes := checker.Changes{}
+	for i := 0; i < 100; i++ {
+		changes = append(changes, checker.ApiChange{
+			Id:        "change_id",
+			Level:     checker.ERR,
+			Operation: "GET",
+			Path:      "/test",
+		})
+	}
+
+	opts := formatters.NewRenderOpts()
+	opts.MaxSize = 1000
+
+	out, err := prCommentFormatter.RenderChangelog(changes, opts, "", "")
+	require.NoError(t, err)
+	require.LessOrEqual(t, len(out), opts.MaxSize)
+	require.Contains(t, string(out), "<summary><code>GET /test</code> (100)</summary>")
+	require.Regexp(t, `_\.\.\.and \d+ more changes_\n$`, string(out))
+}
+
+func TestPRCommentFormatter_RenderChangelogEscaping(t *testing.T) {
+	testChanges := checker.Changes{
+		checker.CustomChange{
+			Id:        "custom_id",
+			Text:      "added </details> | `x` & more",
+			Level:     checker.ERR,
+			Operation: "GET",
+			Path:      "/test",
+		},
+	}
+
+	out, err := prCommentFormatter.RenderChangelog(testChanges, formatters.NewRenderOpts(), "", "")
+	require.NoError(t, err)
+	require.Contains(t, string(out), "- :x: added &lt;/details&gt; \\| \\`x\\` &amp; more\n")
+}
+
+func TestPRCommentFormatter_NotImplemented(t *testing.T) {
+	var err error
+	_, err = prCommentFormatter.RenderDiff(nil, formatters.NewRenderOpts())
+	require.Error(t, err)
+
+	_, err = prCommentFormatter.RenderSummary(nil, formatters.NewRenderOpts())
+	require.Error(t, err)
+
+	_, err = prCommentFormatter.RenderChecks(nil, formatters.NewRenderOpts())
+	require.Error(t, err)
+}
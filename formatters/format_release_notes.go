@@ -0,0 +1,286 @@
+package formatters
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/load"
+	"github.com/oasdiff/oasdiff/utils"
+)
+
+type ReleaseNotesStyle int8
+
+const (
+	ReleaseNotesKeepAChangelog ReleaseNotesStyle = iota
+	ReleaseNotesConventionalCommits
+)
+
+// ReleaseSection is a section of the release notes as defined by https://keepachangelog.com
+type ReleaseSection string
+
+const (
+	ReleaseSectionAdded      ReleaseSection = "Added"
+	ReleaseSectionChanged    ReleaseSection = "Changed"
+	ReleaseSectionDeprecated ReleaseSection = "Deprecated"
+	ReleaseSectionRemoved    ReleaseSection = "Removed"
+	ReleaseSectionSecurity   ReleaseSection = "Security"
+)
+
+// releaseSections are the sections in the order that they appear in the release notes
+var releaseSections = []ReleaseSection{
+	ReleaseSectionAdded,
+	ReleaseSectionChanged,
+	ReleaseSectionDeprecated,
+	ReleaseSectionRemoved,
+	ReleaseSectionSecurity,
+}
+
+// conventionalCommitTypes maps the release sections to conventional commit types, see https://www.conventionalcommits.org
+// Removals aren't features, they are reported as refactorings that are marked with ! when they are breaking
+var conventionalCommitTypes = map[ReleaseSection]string{
+	ReleaseSectionAdded:      "feat",
+	ReleaseSectionChanged:    "feat",
+	ReleaseSectionDeprecated: "chore",
+	ReleaseSectionRemoved:    "refactor",
+	ReleaseSectionSecurity:   "fix",
+}
+
+// ReleaseNotesFormatter renders the changelog as a release in the style of Keep a Changelog or Conventional Commits
+type ReleaseNotesFormatter struct {
+	notImplementedFormatter
+	Localizer checker.Localizer
+	Style     ReleaseNotesStyle
+}
+
+func newReleaseNotesFormatter(l checker.Localizer, style ReleaseNotesStyle) ReleaseNotesFormatter {
+	return ReleaseNotesFormatter{
+		Localizer: l,
+		Style:     style,
+	}
+}
+
+func (f ReleaseNotesFormatter) RenderChangelog(changes checker.Changes, opts RenderOpts, _, revisionVersion string) ([]byte, error) {
+	var out strings.Builder
+	out.WriteString(getReleaseHeading(revisionVersion, opts.ReleaseDate) + "\n")
+
+	if len(changes) == 0 {
+		out.WriteString("\nNo changes\n")
+		return []byte(out.String()), nil
+	}
+
+	sections := classifyChanges(changes)
+
+	if f.Style == ReleaseNotesConventionalCommits {
+		out.WriteString("\n")
+		for _, section := range releaseSections {
+			for _, change := range sections[section] {
+				out.WriteString(fmt.Sprintf("- %s: %s\n", getConventionalCommitPrefix(section, change, opts.SpecInfoPair), f.getText(change)))
+			}
+		}
+		return []byte(out.String()), nil
+	}
+
+	for _, section := range releaseSections {
+		if len(sections[section]) == 0 {
+			continue
+		}
+		out.WriteString(fmt.Sprintf("\n### %s\n", section))
+		for _, change := range sections[section] {
+			breaking := ""
+			if change.IsBreaking() {
+				breaking = "**Breaking:** "
+			}
+			out.WriteString(fmt.Sprintf("- %s%s\n", breaking, f.getText(change)))
+		}
+	}
+
+	return []byte(out.String()), nil
+}
+
+// getReleaseHeading returns the heading of a release with the revision version and the optional release date, or the heading of unreleased changes if there is no version
+func getReleaseHeading(version, date string) string {
+	if version == "" || version == "n/a" {
+		return "## [Unreleased]"
+	}
+	if date == "" {
+		return fmt.Sprintf("## [%s]", version)
+	}
+	return fmt.Sprintf("## [%s] - %s", version, date)
+}
+
+func (f ReleaseNotesFormatter) getText(change checker.Change) string {
+	text := change.GetUncolorizedText(f.Localizer)
+	if change.GetPath() == "" {
+		return text
+	}
+	return fmt.Sprintf("`%s %s` %s", change.GetOperation(), change.GetPath(), text)
+}
+
+// getConventionalCommitPrefix returns the type, the optional scope and the optional breaking change indicator, for example feat(pets)!
+func getConventionalCommitPrefix(section ReleaseSection, change checker.Change, specInfoPair *load.SpecInfoPair) string {
+	result := conventionalCommitTypes[section]
+	if tags := getOperationTags(specInfoPair, change.GetPath(), change.GetOperation()); len(tags) > 0 {
+		result += "(" + tags[0] + ")"
+	}
+	if change.IsBreaking() {
+		result += "!"
+	}
+	return result
+}
+
+// classifyChanges assigns each change to a release section according to the metadata of its rule
+func classifyChanges(changes checker.Changes) map[ReleaseSection]checker.Changes {
+	rules := map[string]checker.BackwardCompatibilityRule{}
+	for _, rule := range checker.GetAllRules() {
+		rules[rule.Id] = rule
+	}
+
+	result := map[ReleaseSection]checker.Changes{}
+	for _, change := range changes {
+		section := getReleaseSection(change.GetId(), rules)
+		result[section] = append(result[section], change)
+	}
+	return result
+}
+
+// deprecationIds are the rules that report deprecations, their actions are "change" so they are listed explicitly
+var deprecationIds = utils.StringList{
+	checker.PropertyDeprecatedId,
+	checker.EndpointDeprecatedId,
+	checker.APIDeprecatedSunsetParseId,
+	checker.APIDeprecatedSunsetMissingId,
+	checker.APISunsetDateTooSmallId,
+	checker.RequestParameterDeprecatedId,
+	checker.RequestParameterDeprecatedSunsetMissingId,
+	checker.RequestParameterSunsetDateTooSmallId,
+}.ToStringSet()
+
+// getReleaseSection classifies a change by the location, action and direction of its rule:
+// - changes to security requirements are reported under Security
+// - deprecations are reported under Deprecated
+// - additions are reported under Added, except for breaking additions to the request, like a new required parameter, which change the API for existing clients
+// - removals are reported under Removed
+// - other changes are reported under Changed
+// Changes without a built-in rule, like custom rules and plugins, are reported under Changed
+func getReleaseSection(id string, rules map[string]checker.BackwardCompatibilityRule) ReleaseSection {
+	rule, ok := rules[id]
+	if !ok {
+		return ReleaseSectionChanged
+	}
+
+	if rule.Location == checker.LocationSecurity {
+		return ReleaseSectionSecurity
+	}
+
+	if deprecationIds.Contains(id) {
+		return ReleaseSectionDeprecated
+	}
+
+	switch rule.Action {
+	case checker.ActionAdd:
+		if rule.Direction == checker.DirectionRequest && rule.Level != checker.INFO {
+			return ReleaseSectionChanged
+		}
+		return ReleaseSectionAdded
+	case checker.ActionRemove:
+		return ReleaseSectionRemoved
+	default:
+		return ReleaseSectionChanged
+	}
+}
+
+// PrependRelease inserts a release into an existing changelog file before its first release, keeping the title and the unreleased section at the top
+// An unreleased release is merged into the unreleased section of the changelog, if there is one
+// If the changelog has no releases, the release is appended to its end
+func PrependRelease(changelog, release []byte) []byte {
+	if len(strings.TrimSpace(string(changelog))) == 0 {
+		return []byte("# Changelog\n\n" + string(release))
+	}
+
+	lines := strings.SplitAfter(string(changelog), "\n")
+	unreleased := -1
+	for i, line := range lines {
+		if !strings.HasPrefix(line, "## ") {
+			continue
+		}
+		if isUnreleasedHeading(line) {
+			unreleased = i
+			continue
+		}
+		if unreleased != -1 && isUnreleasedHeading(string(release)) {
+			return []byte(strings.Join(lines[:unreleased+1], "") + strings.Join(mergeRelease(lines[unreleased+1:i], release), "") + strings.Join(lines[i:], ""))
+		}
+		return []byte(strings.Join(lines[:i], "") + string(release) + "\n" + strings.Join(lines[i:], ""))
+	}
+
+	if unreleased != -1 && isUnreleasedHeading(string(release)) {
+		return []byte(strings.Join(lines[:unreleased+1], "") + strings.Join(mergeRelease(lines[unreleased+1:], release), ""))
+	}
+
+	result := string(changelog)
+	if !strings.HasSuffix(result, "\n") {
+		result += "\n"
+	}
+	return []byte(result + "\n" + string(release))
+}
+
+func isUnreleasedHeading(line string) bool {
+	return strings.HasPrefix(strings.ToLower(line), "## [unreleased]")
+}
+
+// mergeRelease adds the entries of a release to the lines of an existing section
+// Entries are added to the end of the subsection with the same heading, or to a new subsection, and entries that are already listed are skipped
+func mergeRelease(section []string, release []byte) []string {
+	section = slices.Clone(section)
+	heading := ""
+	for _, line := range strings.SplitAfter(string(release), "\n")[1:] {
+		switch {
+		case strings.HasPrefix(line, "### "):
+			heading = line
+		case strings.HasPrefix(line, "- ") && !slices.Contains(section, line):
+			section = insertEntry(section, heading, line)
+		}
+	}
+	return section
+}
+
+// insertEntry inserts an entry after the last entry of the subsection with the given heading, or under the section heading if the heading is empty
+// If the subsection doesn't exist, it is added to the end of the section
+func insertEntry(section []string, heading, entry string) []string {
+	start := 0
+	if heading != "" {
+		start = slices.Index(section, heading)
+		if start == -1 {
+			end := getContentEnd(section, len(section))
+			return slices.Concat(section[:end], []string{"\n", heading, entry}, section[end:])
+		}
+		start++
+	}
+
+	end := len(section)
+	for i := start; i < len(section); i++ {
+		if strings.HasPrefix(section[i], "### ") {
+			end = i
+			break
+		}
+	}
+	end = max(getContentEnd(section, end), start)
+	if end == 0 {
+		return slices.Concat([]string{"\n", entry}, section)
+	}
+	return slices.Insert(section, end, entry)
+}
+
+// getContentEnd returns the index after the last non-blank line before end
+func getContentEnd(section []string, end int) int {
+	for end > 0 && strings.TrimSpace(section[end-1]) == "" {
+		end--
+	}
+	return end
+}
+
+func (f ReleaseNotesFormatter) SupportedOutputs() []Output {
+	return []Output{OutputChangelog}
+}
@@ -0,0 +1,146 @@
+package formatters_test
+
+import (
+	"testing"
+
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/formatters"
+	"github.com/oasdiff/oasdiff/load"
+	"github.com/stretchr/testify/require"
+)
+
+var keepAChangelogFormatter = formatters.ReleaseNotesFormatter{
+	Localizer: MockLocalizer,
+	Style:     formatters.ReleaseNotesKeepAChangelog,
+}
+
+var conventionalCommitsFormatter = formatters.ReleaseNotesFormatter{
+	Localizer: MockLocalizer,
+	Style:     formatters.ReleaseNotesConventionalCommits,
+}
+
+func TestReleaseNotesLookup(t *testing.T) {
+	f, err := formatters.Lookup(string(formatters.FormatKeepAChangelog), formatters.DefaultFormatterOpts())
+	require.NoError(t, err)
+	require.Equal(t, formatters.ReleaseNotesKeepAChangelog, f.(formatters.ReleaseNotesFormatter).Style)
+
+	f, err = formatters.Lookup(string(formatters.FormatConventional), formatters.DefaultFormatterOpts())
+	require.NoError(t, err)
+	require.Equal(t, formatters.ReleaseNotesConventionalCommits, f.(formatters.ReleaseNotesFormatter).Style)
+}
+
+func getReleaseNotesTestChanges() checker.Changes {
+	return checker.Changes{
+		checker.ApiChange{Id: checker.EndpointAddedId, Level: checker.INFO, Operation: "GET", Path: "/added"},
+		checker.ApiChange{Id: checker.RequestParameterMaxLengthIncreasedId, Level: checker.INFO, Operation: "GET", Path: "/changed"},
+		checker.ApiChange{Id: checker.NewRequiredRequestDefaultParameterToExistingPathId, Level: checker.ERR, Operation: "GET", Path: "/required"},
+		checker.ApiChange{Id: checker.EndpointDeprecatedId, Level: checker.INFO, Operation: "GET", Path: "/deprecated"},
+		checker.ApiChange{Id: checker.APIPathRemovedWithoutDeprecationId, Level: checker.ERR, Operation: "GET", Path: "/removed"},
+		checker.ApiChange{Id: checker.APISecurityRemovedCheckId, Level: checker.INFO, Operation: "GET", Path: "/security"},
+		checker.ComponentChange{Id: checker.APIComponentsSecurityAddedId, Level: checker.INFO, Component: "securitySchemes"},
+		checker.CustomChange{Id: "custom-rule", Text: "custom text", Level: checker.WARN, Operation: "GET", Path: "/custom"},
+	}
+}
+
+func TestReleaseNotesFormatter_KeepAChangelog(t *testing.T) {
+	opts := formatters.NewRenderOpts()
+	opts.ReleaseDate = "2024-05-01"
+
+	out, err := keepAChangelogFormatter.RenderChangelog(getReleaseNotesTestChanges(), opts, "1.0.0", "2.0.0")
+	require.NoError(t, err)
+	require.Equal(t, `## [2.0.0] - 2024-05-01
+
+### Added
+- `+"`GET /added`"+` endpoint-added
+- api-security-component-added
+
+### Changed
+- `+"`GET /changed`"+` request-parameter-max-length-increased
+- **Breaking:** `+"`GET /required`"+` new-required-request-default-parameter-to-existing-path
+- **Breaking:** `+"`GET /custom`"+` custom text
+
+### Deprecated
+- `+"`GET /deprecated`"+` endpoint-deprecated
+
+### Removed
+- **Breaking:** `+"`GET /removed`"+` api-path-removed-without-deprecation
+
+### Security
+- `+"`GET /security`"+` api-security-removed
+`, string(out))
+}
+
+func TestReleaseNotesFormatter_ConventionalCommits(t *testing.T) {
+	opts := formatters.NewRenderOpts()
+	opts.SpecInfoPair = load.NewSpecInfoPair(
+		newTaggedSpecInfo(map[string][]string{"GET /removed": {"pets"}}),
+		newTaggedSpecInfo(map[string][]string{"GET /added": {"pets"}}),
+	)
+
+	out, err := conventionalCommitsFormatter.RenderChangelog(getReleaseNotesTestChanges(), opts, "1.0.0", "")
+	require.NoError(t, err)
+	require.Equal(t, `## [Unreleased]
+
+- feat(pets): `+"`GET /added`"+` endpoint-added
+- feat: api-security-component-added
+- feat: `+"`GET /changed`"+` request-parameter-max-length-increased
+- feat!: `+"`GET /required`"+` new-required-request-default-parameter-to-existing-path
+- feat!: `+"`GET /custom`"+` custom text
+- chore: `+"`GET /deprecated`"+` endpoint-deprecated
+- refactor(pets)!: `+"`GET /removed`"+` api-path-removed-without-deprecation
+- fix: `+"`GET /security`"+` api-security-removed
+`, string(out))
+}
+
+func TestReleaseNotesFormatter_NoReleaseDate(t *testing.T) {
+	out, err := keepAChangelogFormatter.RenderChangelog(checker.Changes{}, formatters.NewRenderOpts(), "1.0.0", "2.0.0")
+	require.NoError(t, err)
+	require.Equal(t, "## [2.0.0]\n\nNo changes\n", string(out))
+}
+
+func TestReleaseNotesFormatter_NoChanges(t *testing.T) {
+	out, err := keepAChangelogFormatter.RenderChangelog(checker.Changes{}, formatters.NewRenderOpts(), "", "")
+	require.NoError(t, err)
+	require.Equal(t, "## [Unreleased]\n\nNo changes\n", string(out))
+}
+
+func TestPrependRelease(t *testing.T) {
+	release := []byte("## [2.0.0] - 2024-01-01\n\n### Added\n- new endpoint\n")
+
+	require.Equal(t, "# Changelog\n\n"+string(release), string(formatters.PrependRelease(nil, release)))
+
+	changelog := "# Changelog\n\nAll notable changes.\n\n## [Unreleased]\n\n## [1.0.0] - 2023-01-01\n\n### Added\n- first release\n"
+	require.Equal(t, "# Changelog\n\nAll notable changes.\n\n## [Unreleased]\n\n"+string(release)+"\n## [1.0.0] - 2023-01-01\n\n### Added\n- first release\n", string(formatters.PrependRelease([]byte(changelog), release)))
+
+	require.Equal(t, "# Changelog\n\n"+string(release), string(formatters.PrependRelease([]byte("# Changelog"), release)))
+}
+
+func TestPrependRelease_Unreleased(t *testing.T) {
+	release := []byte("## [Unreleased]\n\n### Added\n- new endpoint\n\n### Removed\n- old endpoint\n")
+
+	// merged into the existing unreleased section, entries that are already listed are skipped
+	changelog := "# Changelog\n\n## [Unreleased]\n\n### Added\n- manual entry\n- new endpoint\n\n## [1.0.0] - 2023-01-01\n\n### Added\n- first release\n"
+	require.Equal(t, "# Changelog\n\n## [Unreleased]\n\n### Added\n- manual entry\n- new endpoint\n\n### Removed\n- old endpoint\n\n## [1.0.0] - 2023-01-01\n\n### Added\n- first release\n", string(formatters.PrependRelease([]byte(changelog), release)))
+
+	// merged into an empty unreleased section at the end of the changelog
+	require.Equal(t, "# Changelog\n\n## [Unreleased]\n\n### Added\n- new endpoint\n\n### Removed\n- old endpoint\n", string(formatters.PrependRelease([]byte("# Changelog\n\n## [Unreleased]\n"), release)))
+
+	// conventional commits entries are listed under the section heading
+	changelog = "## [Unreleased]\n\n- feat: manual entry\n\n## [1.0.0]\n"
+	require.Equal(t, "## [Unreleased]\n\n- feat: manual entry\n- feat: new endpoint\n\n## [1.0.0]\n", string(formatters.PrependRelease([]byte(changelog), []byte("## [Unreleased]\n\n- feat: new endpoint\n"))))
+
+	// no changes leaves the changelog as is
+	require.Equal(t, changelog, string(formatters.PrependRelease([]byte(changelog), []byte("## [Unreleased]\n\nNo changes\n"))))
+}
+
+func TestReleaseNotesFormatter_NotImplemented(t *testing.T) {
+	var err error
+	_, err = keepAChangelogFormatter.RenderDiff(nil, formatters.NewRenderOpts())
+	require.Error(t, err)
+
+	_, err = keepAChangelogFormatter.RenderSummary(nil, formatters.NewRenderOpts())
+	require.Error(t, err)
+
+	_, err = keepAChangelogFormatter.RenderChecks(nil, formatters.NewRenderOpts())
+	require.Error(t, err)
+}
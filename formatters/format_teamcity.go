@@ -0,0 +1,87 @@
+package formatters
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/utils"
+)
+
+var teamCitySeverity = map[checker.Level]string{
+	checker.ERR:  "ERROR",
+	checker.WARN: "WARNING",
+	checker.INFO: "INFO",
+}
+
+// teamCityEscaper escapes values of TeamCity service messages
+// See https://www.jetbrains.com/help/teamcity/service-messages.html#Escaped+Values
+var teamCityEscaper = strings.NewReplacer(
+	"|", "||",
+	"'", "|'",
+	"\n", "|n",
+	"\r", "|r",
+	"[", "|[",
+	"]", "|]",
+)
+
+type TeamCityFormatter struct {
+	notImplementedFormatter
+	Localizer checker.Localizer
+}
+
+func newTeamCityFormatter(l checker.Localizer) TeamCityFormatter {
+	return TeamCityFormatter{
+		Localizer: l,
+	}
+}
+
+// RenderChangelog reports the changes as TeamCity inspections
+// Each change id is declared once as an inspection type before the first inspection that uses it
+func (f TeamCityFormatter) RenderChangelog(changes checker.Changes, opts RenderOpts, _, _ string) ([]byte, error) {
+	var buf bytes.Buffer
+
+	inspectionTypes := utils.StringSet{}
+	for _, change := range changes {
+		id := change.GetId()
+		if !inspectionTypes.Contains(id) {
+			inspectionTypes.Add(id)
+			writeTeamCityMessage(&buf, "inspectionType", [][2]string{
+				{"id", id},
+				{"name", id},
+				{"category", "oasdiff"},
+				{"description", id},
+			})
+		}
+
+		params := [][2]string{
+			{"typeId", id},
+			{"message", getChangeMessage(change, f.Localizer)},
+		}
+		// the file is omitted when it is unknown
+		if file := getChangeFile(change, opts.SpecInfoPair); file != "" {
+			params = append(params, [2]string{"file", file})
+		}
+		if change.GetSourceLine() != 0 {
+			params = append(params, [2]string{"line", strconv.Itoa(change.GetSourceLine() + 1)})
+		}
+		params = append(params, [2]string{"SEVERITY", teamCitySeverity[change.GetLevel()]})
+		writeTeamCityMessage(&buf, "inspection", params)
+	}
+
+	return buf.Bytes(), nil
+}
+
+func writeTeamCityMessage(buf *bytes.Buffer, name string, params [][2]string) {
+	attributes := make([]string, len(params))
+	for i, param := range params {
+		attributes[i] = fmt.Sprintf("%s='%s'", param[0], teamCityEscaper.Replace(param[1]))
+	}
+	buf.WriteString(fmt.Sprintf("##teamcity[%s %s]\n", name, strings.Join(attributes, " ")))
+}
+
+func (f TeamCityFormatter) SupportedOutputs() []Output {
+	return []Output{OutputChangelog}
+}
@@ -0,0 +1,108 @@
+package formatters_test
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/formatters"
+	"github.com/oasdiff/oasdiff/load"
+	"github.com/stretchr/testify/require"
+)
+
+var teamCityFormatter = formatters.TeamCityFormatter{
+	Localizer: MockLocalizer,
+}
+
+func TestTeamCityLookup(t *testing.T) {
+	f, err := formatters.Lookup(string(formatters.FormatTeamCity), formatters.DefaultFormatterOpts())
+	require.NoError(t, err)
+	require.IsType(t, formatters.TeamCityFormatter{}, f)
+}
+
+func TestTeamCityFormatter_RenderChangelog(t *testing.T) {
+	testChanges := checker.Changes{
+		checker.ApiChange{
+			Id:         "change_id",
+			Level:      checker.ERR,
+			Operation:  http.MethodGet,
+			Path:       "/api/test",
+			Source:     load.NewSource("openapi.yaml"),
+			SourceLine: 9,
+		},
+		checker.ApiChange{
+			Id:        "change_id",
+			Level:     checker.ERR,
+			Operation: http.MethodGet,
+			Path:      "/api/{id}",
+			Source:    load.NewSource("openapi.yaml"),
+		},
+		checker.ApiChange{
+			Id:        "notice_id",
+			Level:     checker.INFO,
+			Operation: http.MethodPost,
+			Path:      "/api/test",
+			Source:    load.NewSource("openapi.yaml"),
+		},
+	}
+
+	output, err := teamCityFormatter.RenderChangelog(testChanges, formatters.NewRenderOpts(), "", "")
+	require.NoError(t, err)
+	expectedOutput := "##teamcity[inspectionType id='change_id' name='change_id' category='oasdiff' description='change_id']\n" +
+		"##teamcity[inspection typeId='change_id' message='in API GET /api/test This is a breaking change.' file='openapi.yaml' line='10' SEVERITY='ERROR']\n" +
+		"##teamcity[inspection typeId='change_id' message='in API GET /api/{id} This is a breaking change.' file='openapi.yaml' SEVERITY='ERROR']\n" +
+		"##teamcity[inspectionType id='notice_id' name='notice_id' category='oasdiff' description='notice_id']\n" +
+		"##teamcity[inspection typeId='notice_id' message='in API POST /api/test This is a notice.' file='openapi.yaml' SEVERITY='INFO']\n"
+	require.Equal(t, expectedOutput, string(output))
+}
+
+func TestTeamCityFormatter_Escaping(t *testing.T) {
+	testChanges := checker.Changes{
+		checker.ApiChange{
+			Id:        "change_id",
+			Level:     checker.WARN,
+			Operation: http.MethodGet,
+			Path:      "/api/[test]|'x'",
+			Source:    load.NewSource("openapi.yaml"),
+		},
+	}
+
+	output, err := teamCityFormatter.RenderChangelog(testChanges, formatters.NewRenderOpts(), "", "")
+	require.NoError(t, err)
+	require.Contains(t, string(output), "message='in API GET /api/|[test|]|||'x|' This is a breaking change.'")
+}
+
+func TestTeamCityFormatter_ComponentChange(t *testing.T) {
+	testChanges := checker.Changes{
+		checker.ComponentChange{
+			Id:        "change_id",
+			Level:     checker.ERR,
+			Component: "securitySchemes",
+		},
+	}
+
+	opts := formatters.NewRenderOpts()
+	opts.SpecInfoPair = load.NewSpecInfoPair(&load.SpecInfo{Url: "base.yaml"}, &load.SpecInfo{Url: "revision.yaml"})
+	output, err := teamCityFormatter.RenderChangelog(testChanges, opts, "", "")
+	require.NoError(t, err)
+	require.Contains(t, string(output), "##teamcity[inspection typeId='change_id' message='This is a breaking change.' file='revision.yaml' SEVERITY='ERROR']\n")
+
+	output, err = teamCityFormatter.RenderChangelog(testChanges, formatters.NewRenderOpts(), "", "")
+	require.NoError(t, err)
+	require.Contains(t, string(output), "##teamcity[inspection typeId='change_id' message='This is a breaking change.' SEVERITY='ERROR']\n")
+}
+
+func TestTeamCityFormatter_NotImplemented(t *testing.T) {
+	var err error
+	_, err = teamCityFormatter.RenderDiff(nil, formatters.NewRenderOpts())
+	require.Error(t, err)
+
+	_, err = teamCityFormatter.RenderSummary(nil, formatters.NewRenderOpts())
+	require.Error(t, err)
+
+	_, err = teamCityFormatter.RenderChecks(nil, formatters.NewRenderOpts())
+	require.Error(t, err)
+
+	_, err = teamCityFormatter.RenderFlatten(nil, formatters.NewRenderOpts())
+	require.Error(t, err)
+}
@@ -3,6 +3,8 @@ package formatters
 import (
 	"bytes"
 	"fmt"
+	"strconv"
+	"strings"
 	"text/tabwriter"
 
 	"github.com/oasdiff/oasdiff/checker"
@@ -39,19 +41,48 @@ func (f TEXTFormatter) RenderChangelog(changes checker.Changes, opts RenderOpts,
 	return result.Bytes(), nil
 }
 
-func (f TEXTFormatter) RenderChecks(checks Checks, opts RenderOpts) ([]byte, error) {
-	result := bytes.NewBuffer(nil)
+func (f TEXTFormatter) RenderSummary(diff *diff.Diff, opts RenderOpts) ([]byte, error) {
+	data := newSummaryData(diff)
+	if !data.Diff {
+		return []byte("No changes\n"), nil
+	}
 
-	w := tabwriter.NewWriter(result, 1, 1, 1, ' ', 0)
-	_, _ = fmt.Fprintln(w, "ID\tDESCRIPTION\tLEVEL")
-	for _, check := range checks {
-		_, _ = fmt.Fprintln(w, check.Id+"\t"+f.Localizer(check.Description)+"\t"+check.Level)
+	rows := [][]string{{"DETAIL", "ADDED", "DELETED", "MODIFIED"}}
+	for _, row := range data.Rows {
+		rows = append(rows, []string{string(row.Detail), strconv.Itoa(row.Added), strconv.Itoa(row.Deleted), strconv.Itoa(row.Modified)})
 	}
-	_ = w.Flush()
 
-	return result.Bytes(), nil
+	return writeTable(rows), nil
+}
+
+func (f TEXTFormatter) RenderChecks(checks Checks, opts RenderOpts) ([]byte, error) {
+	rows := [][]string{{"ID", "DESCRIPTION", "LEVEL", "DIRECTION", "LOCATION", "ACTION"}}
+	for _, check := range checks.localize(f.Localizer) {
+		rows = append(rows, []string{check.Id, check.Description, check.Level, check.Direction, check.Location, check.Action})
+	}
+
+	return writeTable(rows), nil
 }
 
 func (f TEXTFormatter) SupportedOutputs() []Output {
-	return []Output{OutputDiff, OutputChangelog, OutputChecks}
+	return []Output{OutputDiff, OutputSummary, OutputChangelog, OutputChecks}
+}
+
+// writeTable aligns the columns of the rows, without trailing spaces
+func writeTable(rows [][]string) []byte {
+	table := bytes.NewBuffer(nil)
+	w := tabwriter.NewWriter(table, 1, 1, 1, ' ', 0)
+	for _, row := range rows {
+		_, _ = fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+	_ = w.Flush()
+
+	result := bytes.NewBuffer(nil)
+	for _, line := range strings.SplitAfter(table.String(), "\n") {
+		if line == "" {
+			continue
+		}
+		result.WriteString(strings.TrimRight(line, " \n") + "\n")
+	}
+	return result.Bytes()
 }
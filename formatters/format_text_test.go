@@ -44,7 +44,7 @@ func TestTextFormatter_RenderChecks(t *testing.T) {
 
 	out, err := textFormatter.RenderChecks(checks, formatters.NewRenderOpts())
 	require.NoError(t, err)
-	require.Equal(t, string(out), "ID        DESCRIPTION                LEVEL\nchange_id This is a breaking change. info\n")
+	require.Equal(t, "ID        DESCRIPTION                LEVEL DIRECTION LOCATION ACTION\nchange_id This is a breaking change. info\n", string(out))
 }
 
 func TestTextFormatter_RenderDiff(t *testing.T) {
@@ -58,7 +58,34 @@ func TestTextFormatter_NotImplemented(t *testing.T) {
 
 	_, err = textFormatter.RenderFlatten(nil, formatters.NewRenderOpts())
 	assert.Error(t, err)
+}
 
-	_, err = textFormatter.RenderSummary(nil, formatters.NewRenderOpts())
-	assert.Error(t, err)
+func TestTextFormatter_RenderChecksWithTags(t *testing.T) {
+	checks := formatters.Checks{
+		{
+			Id:          "change_id",
+			Level:       "error",
+			Description: "This is a breaking change.",
+			Direction:   "request",
+			Location:    "body",
+			Action:      "remove",
+		},
+	}
+
+	out, err := textFormatter.RenderChecks(checks, formatters.NewRenderOpts())
+	require.NoError(t, err)
+	require.Equal(t, "ID        DESCRIPTION                LEVEL DIRECTION LOCATION ACTION\nchange_id This is a breaking change. error request   body     remove\n", string(out))
+}
+
+func TestTextFormatter_RenderSummary(t *testing.T) {
+	out, err := textFormatter.RenderSummary(getTestDiff(t), formatters.NewRenderOpts())
+	require.NoError(t, err)
+	require.Contains(t, string(out), "DETAIL")
+	require.Contains(t, string(out), "\npaths ")
+}
+
+func TestTextFormatter_RenderSummaryNoChanges(t *testing.T) {
+	out, err := textFormatter.RenderSummary(nil, formatters.NewRenderOpts())
+	require.NoError(t, err)
+	require.Equal(t, "No changes\n", string(out))
 }
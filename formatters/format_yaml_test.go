@@ -43,6 +43,7 @@ func TestYamlFormatter_RenderChangelogWithWrapInObject(t *testing.T) {
 	out, err := yamlFormatter.RenderChangelog(testChanges, formatters.RenderOpts{WrapInObject: true}, "", "")
 	require.NoError(t, err)
 	require.True(t, strings.HasPrefix(string(out), "changes:"))
+	require.Contains(t, string(out), "schemaVersion: \""+formatters.SchemaVersion+"\"\n")
 }
 
 func TestYamlFormatter_RenderChecks(t *testing.T) {
@@ -18,10 +18,16 @@ type Formatter interface {
 	RenderChangelog(changes checker.Changes, opts RenderOpts, baseVersion, revisionVersion string) ([]byte, error)
 	RenderChecks(checks Checks, opts RenderOpts) ([]byte, error)
 	RenderFlatten(spec *openapi3.T, opts RenderOpts) ([]byte, error)
+	RenderPatch(base, revision *openapi3.T, opts RenderOpts) ([]byte, error)
 	SupportedOutputs() []Output
 	SupportsTemplate() bool
 }
 
+// DocumentPatchFormatter is implemented by patch formats that compare the raw documents of the specs rather than the loaded specs
+type DocumentPatchFormatter interface {
+	RenderDocumentPatch(base, revision []byte, opts RenderOpts) ([]byte, error)
+}
+
 var formatters = map[Format]Formatter{
 	FormatYAML:          YAMLFormatter{},
 	FormatJSON:          JSONFormatter{},
@@ -32,6 +38,19 @@ var formatters = map[Format]Formatter{
 	FormatHTML:          HTMLFormatter{},
 	FormatGithubActions: GitHubActionsFormatter{},
 	FormatJUnit:         JUnitFormatter{},
+
+	FormatGitLabCodeQuality: GitLabCodeQualityFormatter{},
+	FormatGitLabJUnit:       GitLabJUnitFormatter{},
+	FormatCheckstyle:        CheckstyleFormatter{},
+	FormatTeamCity:          TeamCityFormatter{},
+	FormatAzureDevOps:       AzureDevOpsFormatter{},
+	FormatCSV:               CSVFormatter{},
+	FormatTSV:               CSVFormatter{},
+	FormatJSONPatch:         JSONPatchFormatter{},
+	FormatOverlay:           OverlayFormatter{},
+	FormatPRComment:         PRCommentFormatter{},
+	FormatKeepAChangelog:    ReleaseNotesFormatter{},
+	FormatConventional:      ReleaseNotesFormatter{},
 }
 
 // Lookup returns a formatter by its name
@@ -56,6 +75,30 @@ func Lookup(format string, opts FormatterOpts) (Formatter, error) {
 		return newGitHubActionsFormatter(l), nil
 	case FormatJUnit:
 		return newJUnitFormatter(l), nil
+	case FormatGitLabCodeQuality:
+		return newGitLabCodeQualityFormatter(l), nil
+	case FormatGitLabJUnit:
+		return newGitLabJUnitFormatter(l), nil
+	case FormatCheckstyle:
+		return newCheckstyleFormatter(l), nil
+	case FormatTeamCity:
+		return newTeamCityFormatter(l), nil
+	case FormatAzureDevOps:
+		return newAzureDevOpsFormatter(l), nil
+	case FormatCSV:
+		return newCSVFormatter(l, ','), nil
+	case FormatTSV:
+		return newCSVFormatter(l, '\t'), nil
+	case FormatJSONPatch:
+		return newJSONPatchFormatter(l), nil
+	case FormatOverlay:
+		return newOverlayFormatter(l), nil
+	case FormatPRComment:
+		return newPRCommentFormatter(l), nil
+	case FormatKeepAChangelog:
+		return newReleaseNotesFormatter(l, ReleaseNotesKeepAChangelog), nil
+	case FormatConventional:
+		return newReleaseNotesFormatter(l, ReleaseNotesConventionalCommits), nil
 	default:
 		return nil, fmt.Errorf("unsupported format: %s", f)
 	}
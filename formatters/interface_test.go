@@ -24,16 +24,29 @@ func TestDiffOutputFormats(t *testing.T) {
 	assert.Contains(t, supportedFormats, string(formatters.FormatHTML))
 }
 
+func TestPatchOutputFormats(t *testing.T) {
+	supportedFormats := formatters.SupportedFormatsByContentType(formatters.OutputPatch)
+	assert.Len(t, supportedFormats, 2)
+	assert.Contains(t, supportedFormats, string(formatters.FormatJSONPatch))
+	assert.Contains(t, supportedFormats, string(formatters.FormatOverlay))
+}
+
 func TestSummaryOutputFormats(t *testing.T) {
 	supportedFormats := formatters.SupportedFormatsByContentType(formatters.OutputSummary)
-	assert.Len(t, supportedFormats, 2)
+	assert.Len(t, supportedFormats, 8)
 	assert.Contains(t, supportedFormats, string(formatters.FormatYAML))
 	assert.Contains(t, supportedFormats, string(formatters.FormatJSON))
+	assert.Contains(t, supportedFormats, string(formatters.FormatText))
+	assert.Contains(t, supportedFormats, string(formatters.FormatMarkup))
+	assert.Contains(t, supportedFormats, string(formatters.FormatMarkdown))
+	assert.Contains(t, supportedFormats, string(formatters.FormatHTML))
+	assert.Contains(t, supportedFormats, string(formatters.FormatCSV))
+	assert.Contains(t, supportedFormats, string(formatters.FormatTSV))
 }
 
 func TestChangelogOutputFormats(t *testing.T) {
 	supportedFormats := formatters.SupportedFormatsByContentType(formatters.OutputChangelog)
-	assert.Len(t, supportedFormats, 9)
+	assert.Len(t, supportedFormats, 19)
 	assert.Contains(t, supportedFormats, string(formatters.FormatYAML))
 	assert.Contains(t, supportedFormats, string(formatters.FormatJSON))
 	assert.Contains(t, supportedFormats, string(formatters.FormatText))
@@ -43,4 +56,14 @@ func TestChangelogOutputFormats(t *testing.T) {
 	assert.Contains(t, supportedFormats, string(formatters.FormatHTML))
 	assert.Contains(t, supportedFormats, string(formatters.FormatGithubActions))
 	assert.Contains(t, supportedFormats, string(formatters.FormatJUnit))
+	assert.Contains(t, supportedFormats, string(formatters.FormatGitLabCodeQuality))
+	assert.Contains(t, supportedFormats, string(formatters.FormatGitLabJUnit))
+	assert.Contains(t, supportedFormats, string(formatters.FormatCheckstyle))
+	assert.Contains(t, supportedFormats, string(formatters.FormatTeamCity))
+	assert.Contains(t, supportedFormats, string(formatters.FormatAzureDevOps))
+	assert.Contains(t, supportedFormats, string(formatters.FormatCSV))
+	assert.Contains(t, supportedFormats, string(formatters.FormatTSV))
+	assert.Contains(t, supportedFormats, string(formatters.FormatPRComment))
+	assert.Contains(t, supportedFormats, string(formatters.FormatKeepAChangelog))
+	assert.Contains(t, supportedFormats, string(formatters.FormatConventional))
 }
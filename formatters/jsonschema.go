@@ -0,0 +1,262 @@
+package formatters
+
+import (
+	"encoding"
+	"encoding/json"
+	"fmt"
+	"path"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/diff"
+)
+
+// SchemaVersion is the version of the JSON Schemas of the json and yaml outputs
+// The minor version is increased when fields are added and the major version is increased when fields are renamed or removed
+const SchemaVersion = "1.0"
+
+const schemaBaseURL = "https://raw.githubusercontent.com/oasdiff/oasdiff/main/schemas/"
+
+// jsonSchema is a subset of JSON Schema 2020-12 which is sufficient to describe the outputs
+type jsonSchema struct {
+	Schema               string                 `json:"$schema,omitempty"`
+	Id                   string                 `json:"$id,omitempty"`
+	Title                string                 `json:"title,omitempty"`
+	Description          string                 `json:"description,omitempty"`
+	Ref                  string                 `json:"$ref,omitempty"`
+	Type                 string                 `json:"type,omitempty"`
+	Format               string                 `json:"format,omitempty"`
+	Const                any                    `json:"const,omitempty"`
+	Enum                 []any                  `json:"enum,omitempty"`
+	Properties           map[string]*jsonSchema `json:"properties,omitempty"`
+	Required             []string               `json:"required,omitempty"`
+	AdditionalProperties *jsonSchema            `json:"additionalProperties,omitempty"`
+	Items                *jsonSchema            `json:"items,omitempty"`
+	AnyOf                []*jsonSchema          `json:"anyOf,omitempty"`
+	Defs                 map[string]*jsonSchema `json:"$defs,omitempty"`
+}
+
+// schemaTypes are the go types that are encoded by the json and yaml formats of each output
+var schemaTypes = map[string]reflect.Type{
+	"diff":      reflect.TypeOf(diff.Diff{}),
+	"summary":   reflect.TypeOf(diff.Summary{}),
+	"changelog": reflect.TypeOf(Changes{}),
+	"checks":    reflect.TypeOf(Checks{}),
+}
+
+// GetSchemaOutputs returns the names of the outputs that have a JSON Schema
+func GetSchemaOutputs() []string {
+	return []string{"diff", "summary", "changelog", "checks"}
+}
+
+// GetOutputSchema returns the JSON Schema of the json and yaml formats of an output
+func GetOutputSchema(output string) ([]byte, error) {
+	t, ok := schemaTypes[output]
+	if !ok {
+		return nil, fmt.Errorf("no schema for output %q", output)
+	}
+
+	g := newSchemaGenerator()
+	root := g.generate(t)
+
+	if output == "changelog" {
+		// the changelog may also be wrapped in an object, see RenderOpts.WrapInObject
+		root = &jsonSchema{
+			AnyOf: []*jsonSchema{
+				root,
+				{
+					Type: "object",
+					Properties: map[string]*jsonSchema{
+						"schemaVersion": {Type: "string", Const: SchemaVersion},
+						"changes":       root,
+					},
+					Required: []string{"changes", "schemaVersion"},
+				},
+			},
+		}
+	}
+
+	root.Schema = "https://json-schema.org/draft/2020-12/schema"
+	root.Id = schemaBaseURL + SchemaVersion + "/" + output + ".json"
+	root.Title = "oasdiff " + output
+	root.Description = fmt.Sprintf("The json and yaml output of oasdiff %s, schema version %s", output, SchemaVersion)
+	root.Defs = g.defs
+
+	result, err := json.MarshalIndent(root, "", "  ")
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal schema: %w", err)
+	}
+	return result, nil
+}
+
+type schemaGenerator struct {
+	defs  map[string]*jsonSchema
+	names map[reflect.Type]string
+}
+
+func newSchemaGenerator() *schemaGenerator {
+	return &schemaGenerator{
+		defs:  map[string]*jsonSchema{},
+		names: map[reflect.Type]string{},
+	}
+}
+
+var (
+	timeType          = reflect.TypeOf(time.Time{})
+	levelType         = reflect.TypeOf(checker.Level(0))
+	jsonMarshalerType = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// generate returns the schema of a type as encoded by encoding/json, or nil if the type can't be encoded
+func (g *schemaGenerator) generate(t reflect.Type) *jsonSchema {
+	switch {
+	case t == timeType:
+		return &jsonSchema{Type: "string", Format: "date-time"}
+	case t == levelType:
+		return &jsonSchema{Type: "integer", Enum: []any{checker.INFO, checker.WARN, checker.ERR}, Description: "1: info, 2: warning, 3: error"}
+	case t.Implements(jsonMarshalerType) || reflect.PointerTo(t).Implements(jsonMarshalerType):
+		return &jsonSchema{}
+	case t.Implements(textMarshalerType) || reflect.PointerTo(t).Implements(textMarshalerType):
+		return &jsonSchema{Type: "string"}
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return &jsonSchema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &jsonSchema{Type: "integer"}
+	case reflect.Float32, reflect.Float64:
+		return &jsonSchema{Type: "number"}
+	case reflect.String:
+		return &jsonSchema{Type: "string"}
+	case reflect.Interface:
+		return &jsonSchema{}
+	case reflect.Pointer:
+		return g.generate(t.Elem())
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &jsonSchema{Type: "string", Format: "byte"}
+		}
+		items := g.generate(t.Elem())
+		if items == nil {
+			return nil
+		}
+		return &jsonSchema{Type: "array", Items: items}
+	case reflect.Map:
+		if !isJSONMapKey(t.Key()) {
+			return nil
+		}
+		values := g.generate(t.Elem())
+		if values == nil {
+			return nil
+		}
+		return &jsonSchema{Type: "object", AdditionalProperties: values}
+	case reflect.Struct:
+		return g.generateStruct(t)
+	}
+
+	// functions, channels etc. can't be encoded
+	return nil
+}
+
+// isJSONMapKey returns true if encoding/json can encode a map with this key type
+func isJSONMapKey(t reflect.Type) bool {
+	switch t.Kind() {
+	case reflect.String,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	}
+	return t.Implements(textMarshalerType)
+}
+
+// generateStruct adds the schema of a named struct to the definitions and returns a reference to it
+func (g *schemaGenerator) generateStruct(t reflect.Type) *jsonSchema {
+	if t.Name() == "" {
+		return g.generateProperties(t)
+	}
+
+	name, ok := g.names[t]
+	if !ok {
+		name = g.getDefName(t)
+		g.names[t] = name
+		g.defs[name] = &jsonSchema{} // placeholder for recursive types
+		g.defs[name] = g.generateProperties(t)
+	}
+
+	return &jsonSchema{Ref: "#/$defs/" + name}
+}
+
+// getDefName returns the name of the type, prefixed by its package if another type has the same name
+func (g *schemaGenerator) getDefName(t reflect.Type) string {
+	name := t.Name()
+	if _, exists := g.defs[name]; exists {
+		name = path.Base(t.PkgPath()) + "." + name
+	}
+	return name
+}
+
+func (g *schemaGenerator) generateProperties(t reflect.Type) *jsonSchema {
+	result := &jsonSchema{
+		Type:       "object",
+		Properties: map[string]*jsonSchema{},
+	}
+	g.addProperties(result, t)
+	return result
+}
+
+// addProperties adds the fields of a struct to the schema, following the rules of encoding/json
+func (g *schemaGenerator) addProperties(schema *jsonSchema, t reflect.Type) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+
+		name, options, _ := strings.Cut(tag, ",")
+
+		// embedded structs without a name are inlined
+		if field.Anonymous && name == "" {
+			embedded := field.Type
+			if embedded.Kind() == reflect.Pointer {
+				embedded = embedded.Elem()
+			}
+			if embedded.Kind() == reflect.Struct {
+				g.addProperties(schema, embedded)
+				continue
+			}
+		}
+
+		if !field.IsExported() {
+			continue
+		}
+
+		if name == "" {
+			name = field.Name
+		}
+
+		property := g.generate(field.Type)
+		if property == nil {
+			continue
+		}
+
+		if strings.Contains(options, "omitempty") {
+			schema.Properties[name] = property
+			continue
+		}
+
+		schema.Required = append(schema.Required, name)
+		switch field.Type.Kind() {
+		case reflect.Pointer, reflect.Slice, reflect.Map:
+			// nil values are encoded as null
+			property = &jsonSchema{AnyOf: []*jsonSchema{property, {Type: "null"}}}
+		}
+		schema.Properties[name] = property
+	}
+}
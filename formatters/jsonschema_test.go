@@ -0,0 +1,62 @@
+package formatters_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/oasdiff/oasdiff/formatters"
+	"github.com/stretchr/testify/require"
+)
+
+// TestOutputSchema_Published verifies that the published schemas are up to date
+// To update them, run: go run . schema <output> > schemas/<version>/<output>.json
+func TestOutputSchema_Published(t *testing.T) {
+	for _, output := range formatters.GetSchemaOutputs() {
+		schema, err := formatters.GetOutputSchema(output)
+		require.NoError(t, err)
+
+		published, err := os.ReadFile(filepath.Join("..", "schemas", formatters.SchemaVersion, output+".json"))
+		require.NoError(t, err)
+		require.Equal(t, string(published), string(schema)+"\n", "schemas/%s/%s.json is out of date", formatters.SchemaVersion, output)
+	}
+}
+
+func TestOutputSchema_Invalid(t *testing.T) {
+	_, err := formatters.GetOutputSchema("flatten")
+	require.EqualError(t, err, `no schema for output "flatten"`)
+}
+
+func getSchemaDefs(t *testing.T, output string) map[string]map[string]any {
+	t.Helper()
+
+	schema, err := formatters.GetOutputSchema(output)
+	require.NoError(t, err)
+
+	var result struct {
+		Defs map[string]map[string]any `json:"$defs"`
+	}
+	require.NoError(t, json.Unmarshal(schema, &result))
+	return result.Defs
+}
+
+func TestOutputSchema_Checks(t *testing.T) {
+	check := getSchemaDefs(t, "checks")["Check"]
+	require.Equal(t, []any{"id", "level", "description"}, check["required"])
+	require.Contains(t, check["properties"], "direction")
+}
+
+func TestOutputSchema_Changelog(t *testing.T) {
+	change := getSchemaDefs(t, "changelog")["Change"]
+	properties := change["properties"].(map[string]any)
+	require.Equal(t, []any{1.0, 2.0, 3.0}, properties["level"].(map[string]any)["enum"])
+	require.NotContains(t, properties, "Tags")
+	require.NotContains(t, properties, "IsBreaking")
+}
+
+func TestOutputSchema_DiffIsRecursive(t *testing.T) {
+	schemaDiff := getSchemaDefs(t, "diff")["SchemaDiff"]
+	properties := schemaDiff["properties"].(map[string]any)
+	require.Equal(t, "#/$defs/SubschemasDiff", properties["allOf"].(map[string]any)["$ref"])
+}
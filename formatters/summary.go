@@ -0,0 +1,38 @@
+package formatters
+
+import (
+	"maps"
+	"slices"
+
+	"github.com/oasdiff/oasdiff/diff"
+)
+
+// SummaryRow is the summary of a single part of the specs: paths, schemas, parameters etc.
+type SummaryRow struct {
+	Detail diff.DetailName
+	diff.SummaryDetails
+}
+
+// SummaryData is the data passed to the summary templates
+type SummaryData struct {
+	Diff bool
+	Rows []SummaryRow
+}
+
+// newSummaryData returns the summary of the diff with a row for each part of the specs, sorted by name
+func newSummaryData(d *diff.Diff) SummaryData {
+	summary := d.GetSummary()
+
+	rows := []SummaryRow{}
+	for _, name := range slices.Sorted(maps.Keys(summary.Details)) {
+		rows = append(rows, SummaryRow{
+			Detail:         name,
+			SummaryDetails: summary.GetSummaryDetails(name),
+		})
+	}
+
+	return SummaryData{
+		Diff: summary.Diff,
+		Rows: rows,
+	}
+}
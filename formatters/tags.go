@@ -0,0 +1,35 @@
+package formatters
+
+import (
+	"github.com/oasdiff/oasdiff/load"
+)
+
+// getOperationTags returns the tags of an operation in the revision spec, or in the base spec if the operation was removed
+func getOperationTags(specInfoPair *load.SpecInfoPair, path, operation string) []string {
+	if specInfoPair == nil || path == "" {
+		return nil
+	}
+
+	if tags, ok := findOperationTags(specInfoPair.Revision, path, operation); ok {
+		return tags
+	}
+	tags, _ := findOperationTags(specInfoPair.Base, path, operation)
+	return tags
+}
+
+func findOperationTags(specInfo *load.SpecInfo, path, operation string) ([]string, bool) {
+	if specInfo == nil || specInfo.Spec == nil || specInfo.Spec.Paths == nil {
+		return nil, false
+	}
+
+	pathItem := specInfo.Spec.Paths.Value(path)
+	if pathItem == nil {
+		return nil, false
+	}
+
+	op := pathItem.GetOperation(operation)
+	if op == nil {
+		return nil, false
+	}
+	return op.Tags, true
+}
@@ -0,0 +1,51 @@
+package formatters_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/formatters"
+	"github.com/oasdiff/oasdiff/load"
+	"github.com/stretchr/testify/require"
+)
+
+// newTaggedSpecInfo returns a spec with the given tags for each operation, for example "GET /pets": {"pets"}
+func newTaggedSpecInfo(operationTags map[string][]string) *load.SpecInfo {
+	paths := openapi3.NewPaths()
+	for operation, tags := range operationTags {
+		method, path, _ := strings.Cut(operation, " ")
+		pathItem := paths.Value(path)
+		if pathItem == nil {
+			pathItem = &openapi3.PathItem{}
+			paths.Set(path, pathItem)
+		}
+		pathItem.SetOperation(method, &openapi3.Operation{Tags: tags})
+	}
+	return &load.SpecInfo{Spec: &openapi3.T{Paths: paths}}
+}
+
+func TestTags_RemovedOperation(t *testing.T) {
+	opts := formatters.NewRenderOpts()
+	opts.GroupBy = formatters.GroupByTag
+	opts.SpecInfoPair = load.NewSpecInfoPair(
+		newTaggedSpecInfo(map[string][]string{"GET /pets": {"pets"}, "DELETE /pets": {"admin"}}),
+		newTaggedSpecInfo(map[string][]string{"GET /pets": {"animals"}}),
+	)
+
+	changes := checker.Changes{
+		checker.ApiChange{Id: "change_id", Level: checker.INFO, Operation: "GET", Path: "/pets"},
+		checker.ApiChange{Id: "change_id", Level: checker.ERR, Operation: "DELETE", Path: "/pets"},
+	}
+
+	out, err := prCommentFormatter.RenderChangelog(changes, opts, "", "")
+	require.NoError(t, err)
+
+	output := string(out)
+	// the tags of the revision take precedence
+	require.Contains(t, output, "<summary>animals (1)</summary>")
+	require.NotContains(t, output, "<summary>pets")
+	// removed operations are looked up in the base
+	require.Contains(t, output, "<summary>admin (1)</summary>")
+}
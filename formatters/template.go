@@ -0,0 +1,159 @@
+package formatters
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/diff"
+	"github.com/oasdiff/oasdiff/load"
+)
+
+// TemplateSpecInfo describes a spec in the template data
+type TemplateSpecInfo struct {
+	Source  string
+	Title   string
+	Version string
+}
+
+func newTemplateSpecInfo(specInfo *load.SpecInfo) TemplateSpecInfo {
+	if specInfo == nil {
+		return TemplateSpecInfo{}
+	}
+
+	result := TemplateSpecInfo{
+		Source:  specInfo.Url,
+		Version: specInfo.GetVersion(),
+	}
+	if specInfo.Spec != nil && specInfo.Spec.Info != nil {
+		result.Title = specInfo.Spec.Info.Title
+	}
+	return result
+}
+
+// newTemplateData returns the template data that is common to all outputs
+func newTemplateData(opts RenderOpts) TemplateData {
+	result := TemplateData{}
+	if opts.SpecInfoPair != nil {
+		result.Base = newTemplateSpecInfo(opts.SpecInfoPair.Base)
+		result.Revision = newTemplateSpecInfo(opts.SpecInfoPair.Revision)
+	}
+	return result
+}
+
+// newChangelogTemplateData returns the template data of the changelog output
+func newChangelogTemplateData(changes checker.Changes, opts RenderOpts, baseVersion, revisionVersion string, l checker.Localizer) TemplateData {
+	result := newTemplateData(opts)
+	result.APIChanges = GroupChanges(changes, l)
+	result.BaseVersion = baseVersion
+	result.RevisionVersion = revisionVersion
+	result.Changes = NewChanges(changes, l)
+	for i, change := range result.Changes {
+		result.Changes[i].Tags = getOperationTags(opts.SpecInfoPair, change.Path, change.Operation)
+	}
+	result.Summary = newSummaryData(opts.DiffReport)
+	return result
+}
+
+// newDiffTemplateData returns the template data of the diff and summary outputs
+func newDiffTemplateData(d *diff.Diff, opts RenderOpts) TemplateData {
+	result := newTemplateData(opts)
+	result.Diff = d
+	result.Summary = newSummaryData(d)
+	return result
+}
+
+// newChecksTemplateData returns the template data of the checks output
+func newChecksTemplateData(checks Checks, opts RenderOpts, l checker.Localizer) TemplateData {
+	result := newTemplateData(opts)
+	result.Checks = checks.localize(l)
+	return result
+}
+
+// readTemplate reads a custom template file
+func readTemplate(templatePath string) (string, error) {
+	templateContent, err := os.ReadFile(templatePath)
+	if err != nil {
+		return "", fmt.Errorf("failed to read template file %s: %w", templatePath, err)
+	}
+	return string(templateContent), nil
+}
+
+// getTemplateFuncs returns the helper functions that are available in custom templates
+func getTemplateFuncs(l checker.Localizer) map[string]any {
+	return map[string]any{
+		"localize":        func(id string, args ...any) string { return l(id, args...) },
+		"groupByTag":      groupChangesByTag,
+		"groupByEndpoint": groupChangesByEndpoint,
+		"level":           filterChangesByLevel,
+		"minLevel":        filterChangesByMinLevel,
+		"breaking":        filterBreakingChanges,
+		"join":            strings.Join,
+	}
+}
+
+// groupChangesByTag groups the changes under each of the tags of their operation
+// Changes of operations without tags are grouped under an empty tag
+func groupChangesByTag(changes Changes) map[string]Changes {
+	result := map[string]Changes{}
+	for _, change := range changes {
+		if len(change.Tags) == 0 {
+			result[""] = append(result[""], change)
+			continue
+		}
+		for _, tag := range change.Tags {
+			result[tag] = append(result[tag], change)
+		}
+	}
+	return result
+}
+
+func groupChangesByEndpoint(changes Changes) map[Endpoint]Changes {
+	result := map[Endpoint]Changes{}
+	for _, change := range changes {
+		ep := Endpoint{Path: change.Path, Operation: change.Operation}
+		result[ep] = append(result[ep], change)
+	}
+	return result
+}
+
+func filterChangesByLevel(level string, changes Changes) (Changes, error) {
+	l, err := parseTemplateLevel(level)
+	if err != nil {
+		return nil, err
+	}
+	return filterChanges(changes, func(change Change) bool { return change.Level == l }), nil
+}
+
+func filterChangesByMinLevel(level string, changes Changes) (Changes, error) {
+	l, err := parseTemplateLevel(level)
+	if err != nil {
+		return nil, err
+	}
+	return filterChanges(changes, func(change Change) bool { return change.Level >= l }), nil
+}
+
+func filterBreakingChanges(changes Changes) Changes {
+	return filterChanges(changes, func(change Change) bool { return change.IsBreaking })
+}
+
+func filterChanges(changes Changes, keep func(Change) bool) Changes {
+	result := Changes{}
+	for _, change := range changes {
+		if keep(change) {
+			result = append(result, change)
+		}
+	}
+	return result
+}
+
+// parseTemplateLevel accepts the level names of the output (error, warning, info) as well as the names used by the flags (ERR, WARN, INFO)
+func parseTemplateLevel(level string) (checker.Level, error) {
+	for _, l := range []checker.Level{checker.ERR, checker.WARN, checker.INFO} {
+		if level == l.String() {
+			return l, nil
+		}
+	}
+	return checker.NewLevel(level)
+}
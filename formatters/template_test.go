@@ -0,0 +1,152 @@
+package formatters_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/formatters"
+	"github.com/oasdiff/oasdiff/load"
+	"github.com/stretchr/testify/require"
+)
+
+func writeTemplate(t *testing.T, content string) string {
+	t.Helper()
+
+	templatePath := filepath.Join(t.TempDir(), "template.tmpl")
+	require.NoError(t, os.WriteFile(templatePath, []byte(content), 0644))
+	return templatePath
+}
+
+func getTemplateTestChanges() checker.Changes {
+	return checker.Changes{
+		checker.ApiChange{
+			Id:        "change_id",
+			Level:     checker.ERR,
+			Operation: "GET",
+			Path:      "/pets",
+			Source:    load.NewSource("openapi.yaml"),
+			CommonChange: checker.CommonChange{
+				Attributes: map[string]any{"x-team": "pets"},
+			},
+		},
+		checker.ApiChange{
+			Id:        "change_id",
+			Level:     checker.INFO,
+			Operation: "POST",
+			Path:      "/stores",
+			Source:    load.NewSource("openapi.yaml"),
+		},
+	}
+}
+
+func TestTemplate_Changes(t *testing.T) {
+	opts := formatters.NewRenderOpts()
+	opts.TemplatePath = writeTemplate(t, `{{ range .Changes }}{{ .Id }} {{ .Level }} {{ .Operation }} {{ .Path }} {{ .Source }} {{ index .Attributes "x-team" }}
+{{ end }}`)
+
+	out, err := markupFormatter.RenderChangelog(getTemplateTestChanges(), opts, "", "")
+	require.NoError(t, err)
+	require.Equal(t, "change_id error GET /pets openapi.yaml pets\nchange_id info POST /stores openapi.yaml <no value>\n", string(out))
+}
+
+func TestTemplate_GroupByTag(t *testing.T) {
+	opts := formatters.NewRenderOpts()
+	revision := newTaggedSpecInfo(map[string][]string{"GET /pets": {"pets", "public"}, "POST /stores": nil})
+	opts.SpecInfoPair = load.NewSpecInfoPair(revision, revision)
+	opts.TemplatePath = writeTemplate(t, `{{ range $tag, $changes := groupByTag .Changes }}[{{ $tag }}]{{ range $changes }} {{ .Path }}{{ end }}
+{{ end }}`)
+
+	out, err := markupFormatter.RenderChangelog(getTemplateTestChanges(), opts, "", "")
+	require.NoError(t, err)
+	require.Equal(t, "[] /stores\n[pets] /pets\n[public] /pets\n", string(out))
+}
+
+func TestTemplate_GroupByEndpoint(t *testing.T) {
+	opts := formatters.NewRenderOpts()
+	opts.TemplatePath = writeTemplate(t, `{{ range $endpoint, $changes := groupByEndpoint .Changes }}{{ $endpoint.Operation }} {{ $endpoint.Path }}: {{ len $changes }}
+{{ end }}`)
+
+	out, err := markupFormatter.RenderChangelog(getTemplateTestChanges(), opts, "", "")
+	require.NoError(t, err)
+	require.Equal(t, "GET /pets: 1\nPOST /stores: 1\n", string(out))
+}
+
+func TestTemplate_LevelFilters(t *testing.T) {
+	opts := formatters.NewRenderOpts()
+	opts.TemplatePath = writeTemplate(t, `{{ len (level "error" .Changes) }} {{ len (level "INFO" .Changes) }} {{ len (minLevel "warning" .Changes) }} {{ len (breaking .Changes) }}`)
+
+	out, err := htmlFormatter.RenderChangelog(getTemplateTestChanges(), opts, "", "")
+	require.NoError(t, err)
+	require.Equal(t, "1 1 1 1", string(out))
+}
+
+func TestTemplate_InvalidLevel(t *testing.T) {
+	opts := formatters.NewRenderOpts()
+	opts.TemplatePath = writeTemplate(t, `{{ level "xxx" .Changes }}`)
+
+	_, err := markupFormatter.RenderChangelog(getTemplateTestChanges(), opts, "", "")
+	require.ErrorContains(t, err, "invalid level xxx")
+}
+
+func TestTemplate_Localize(t *testing.T) {
+	opts := formatters.NewRenderOpts()
+	opts.TemplatePath = writeTemplate(t, `{{ localize "change_id" }}`)
+
+	out, err := markupFormatter.RenderChecks(formatters.Checks{}, opts)
+	require.NoError(t, err)
+	require.Equal(t, "This is a breaking change.", string(out))
+}
+
+func TestTemplate_Checks(t *testing.T) {
+	opts := formatters.NewRenderOpts()
+	opts.TemplatePath = writeTemplate(t, `{{ range .Checks }}{{ .Id }}: {{ .Description }} ({{ .Direction }})
+{{ end }}`)
+
+	checks := formatters.Checks{{Id: "change_id", Level: "error", Description: "change_id", Direction: "request"}}
+
+	out, err := htmlFormatter.RenderChecks(checks, opts)
+	require.NoError(t, err)
+	require.Equal(t, "change_id: This is a breaking change. (request)\n", string(out))
+}
+
+func TestTemplate_DiffAndSummary(t *testing.T) {
+	opts := formatters.NewRenderOpts()
+	opts.TemplatePath = writeTemplate(t, `{{ if .Summary.Diff }}{{ range .Summary.Rows }}{{ if eq .Detail "paths" }}paths: {{ .Added }}/{{ .Deleted }}/{{ .Modified }}{{ end }}{{ end }}{{ end }} {{ len .Diff.PathsDiff.Deleted }}`)
+
+	d := getTestDiff(t)
+	out, err := markupFormatter.RenderDiff(d, opts)
+	require.NoError(t, err)
+
+	summary := d.GetSummary().GetSummaryDetails("paths")
+	expected := fmt.Sprintf("paths: %d/%d/%d %d", summary.Added, summary.Deleted, summary.Modified, len(d.PathsDiff.Deleted))
+	require.Equal(t, expected, string(out))
+
+	out, err = htmlFormatter.RenderSummary(d, opts)
+	require.NoError(t, err)
+	require.Equal(t, expected, string(out))
+}
+
+func TestTemplate_SpecInfo(t *testing.T) {
+	opts := formatters.NewRenderOpts()
+	opts.TemplatePath = writeTemplate(t, `{{ .Base.Title }} {{ .Base.Version }} {{ .Base.Source }} -> {{ .Revision.Version }}`)
+	opts.SpecInfoPair = &load.SpecInfoPair{
+		Base: &load.SpecInfo{
+			Url:     "base.yaml",
+			Spec:    &openapi3.T{Info: &openapi3.Info{Title: "Pets", Version: "1.0.0"}},
+			Version: "1.0.0",
+		},
+		Revision: &load.SpecInfo{
+			Url:     "revision.yaml",
+			Spec:    &openapi3.T{Info: &openapi3.Info{Title: "Pets", Version: "2.0.0"}},
+			Version: "2.0.0",
+		},
+	}
+
+	out, err := markupFormatter.RenderSummary(nil, opts)
+	require.NoError(t, err)
+	require.Equal(t, "Pets 1.0.0 base.yaml -> 2.0.0", string(out))
+}
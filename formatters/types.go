@@ -2,7 +2,10 @@ package formatters
 
 import (
 	"fmt"
+
 	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/diff"
+	"github.com/oasdiff/oasdiff/load"
 )
 
 type Format string
@@ -18,6 +21,19 @@ const (
 	FormatGithubActions Format = "githubactions"
 	FormatJUnit         Format = "junit"
 	FormatSarif         Format = "sarif"
+
+	FormatGitLabCodeQuality Format = "gitlab-codequality"
+	FormatGitLabJUnit       Format = "gitlab-junit"
+	FormatCheckstyle        Format = "checkstyle"
+	FormatTeamCity          Format = "teamcity"
+	FormatAzureDevOps       Format = "azuredevops"
+	FormatCSV               Format = "csv"
+	FormatTSV               Format = "tsv"
+	FormatJSONPatch         Format = "jsonpatch"
+	FormatOverlay           Format = "overlay"
+	FormatPRComment         Format = "pr-comment"
+	FormatKeepAChangelog    Format = "keepachangelog"
+	FormatConventional      Format = "conventional-commits"
 )
 
 func GetSupportedFormats() []string {
@@ -32,6 +48,18 @@ func GetSupportedFormats() []string {
 		string(FormatGithubActions),
 		string(FormatJUnit),
 		string(FormatSarif),
+		string(FormatGitLabCodeQuality),
+		string(FormatGitLabJUnit),
+		string(FormatCheckstyle),
+		string(FormatTeamCity),
+		string(FormatAzureDevOps),
+		string(FormatCSV),
+		string(FormatTSV),
+		string(FormatJSONPatch),
+		string(FormatOverlay),
+		string(FormatPRComment),
+		string(FormatKeepAChangelog),
+		string(FormatConventional),
 	}
 }
 
@@ -43,8 +71,16 @@ type FormatterOpts struct {
 // RenderOpts can be used to pass properties to the renderer method
 type RenderOpts struct {
 	ColorMode    checker.ColorMode
-	WrapInObject bool   // wrap the output in a JSON object with the key "changes"
-	TemplatePath string // path to custom template file for changelog generation
+	WrapInObject bool     // wrap the output in a JSON object with the keys "changes" and "schemaVersion"
+	TemplatePath string   // path to custom template file
+	MaxSize      int      // maximum size of the pr-comment output in bytes, zero means DefaultPRCommentMaxSize
+	GroupBy      string   // group the pr-comment output by endpoint or by tag
+	Attributes   []string // the --attributes of the changes, the csv and tsv outputs have a column for each of them
+	ReleaseDate  string   // the date in the heading of the keepachangelog and conventional-commits outputs, omitted if empty
+
+	// the following are used by custom templates
+	SpecInfoPair *load.SpecInfoPair // the base and revision specs, also used to look up the tags of operations
+	DiffReport   *diff.Diff         // the diff report behind the changelog, used for its summary
 }
 
 func NewRenderOpts() RenderOpts {
@@ -53,10 +89,19 @@ func NewRenderOpts() RenderOpts {
 	}
 }
 
+// TemplateData is the data passed to changelog templates and to custom templates of all outputs
+// Each output fills the fields that are relevant to it, see docs/TEMPLATES.md
 type TemplateData struct {
 	APIChanges      ChangesByEndpoint
 	BaseVersion     string
 	RevisionVersion string
+
+	Changes  Changes          // changelog: the changes with their ids, levels, attributes and sources
+	Diff     *diff.Diff       // diff: the diff report
+	Summary  SummaryData      // diff, summary and changelog: the summary of the diff report
+	Checks   Checks           // checks: the localized checks
+	Base     TemplateSpecInfo // the base spec, empty in composed mode
+	Revision TemplateSpecInfo // the revision spec, empty in composed mode
 }
 
 func (t TemplateData) GetVersionTitle() string {
@@ -3,10 +3,12 @@ package internal
 import (
 	"fmt"
 	"io"
+	"os"
+	"time"
 
 	"github.com/oasdiff/oasdiff/checker"
 	"github.com/oasdiff/oasdiff/formatters"
-	"github.com/oasdiff/oasdiff/load"
+	"github.com/oasdiff/oasdiff/plugins"
 	"github.com/spf13/cobra"
 )
 
@@ -51,17 +53,41 @@ func getChangelog(flags *Flags, stdout io.Writer, level checker.Level) (bool, *R
 		return false, returnErr
 	}
 
-	severityLevels, returnErr := getCustomSeverityLevels(flags.getSeverityLevelsFile())
+	customRules, returnErr := getCustomRules(flags.getCustomRulesFile())
 	if returnErr != nil {
 		return false, returnErr
 	}
 
-	errs, returnErr := filterIgnored(
+	pluginList, returnErr := getPlugins(flags)
+	if returnErr != nil {
+		return false, returnErr
+	}
+
+	severityLevels, returnErr := getCustomSeverityLevels(flags.getSeverityLevelsFile(), customRules, pluginList)
+	if returnErr != nil {
+		return false, returnErr
+	}
+
+	severityLevels, pluginLevels := splitPluginLevels(severityLevels, pluginList)
+
+	changes := addPluginChanges(
+		pluginList,
 		checker.CheckBackwardCompatibilityUntilLevel(
-			checker.NewConfig(checker.GetAllChecks()).WithOptionalChecks(flags.getIncludeChecks()).WithSeverityLevels(severityLevels).WithDeprecation(flags.getDeprecationDaysBeta(), flags.getDeprecationDaysStable()).WithAttributes(flags.getAttributes()),
+			checker.NewConfig(checker.GetAllChecks()).WithOptionalChecks(flags.getIncludeChecks()).WithCustomRules(customRules).WithSeverityLevels(severityLevels).WithDeprecation(flags.getDeprecationDaysBeta(), flags.getDeprecationDaysStable()).WithAttributes(flags.getAttributes()),
 			diffResult.diffReport,
 			diffResult.operationsSources,
 			level),
+		diffResult,
+		pluginLevels,
+		level)
+
+	changes, returnErr = annotateUsage(flags, changes, diffResult.specInfoPair, level)
+	if returnErr != nil {
+		return false, returnErr
+	}
+
+	errs, returnErr := filterIgnored(
+		changes,
 		flags.getWarnIgnoreFile(),
 		flags.getErrIgnoreFile(),
 		checker.NewLocalizer(flags.getLang()))
@@ -70,7 +96,16 @@ func getChangelog(flags *Flags, stdout io.Writer, level checker.Level) (bool, *R
 		return false, returnErr
 	}
 
-	if returnErr := outputChangelog(flags, stdout, errs, diffResult.specInfoPair); returnErr != nil {
+	if flags.getLint() {
+		// lint errors are filtered by the ignore files in getChangedLintChanges
+		lintChanges, returnErr := getChangedLintChanges(flags, diffResult, level, flags.getLintSeverityLevelsFile())
+		if returnErr != nil {
+			return false, returnErr
+		}
+		errs = append(errs, lintChanges...)
+	}
+
+	if returnErr := outputChangelog(flags, stdout, errs, diffResult); returnErr != nil {
 		return false, returnErr
 	}
 
@@ -106,7 +141,7 @@ func filterIgnored(errs checker.Changes, warnIgnoreFile string, errIgnoreFile st
 	return errs, nil
 }
 
-func outputChangelog(flags *Flags, stdout io.Writer, errs checker.Changes, specInfoPair *load.SpecInfoPair) *ReturnError {
+func outputChangelog(flags *Flags, stdout io.Writer, errs checker.Changes, diffResult *diffResult) *ReturnError {
 
 	// formatter lookup
 	formatter, err := formatters.Lookup(flags.getFormat(), formatters.FormatterOpts{
@@ -121,32 +156,96 @@ func outputChangelog(flags *Flags, stdout io.Writer, errs checker.Changes, specI
 		return getErrTemplateNotSupported(flags.getFormat())
 	}
 
+	// validate prepend-to usage
+	if flags.getPrependTo() != "" && !isReleaseNotesFormat(flags.getFormat()) {
+		return getErrInvalidFlags(fmt.Errorf("--prepend-to is only supported by the %s and %s formats", formatters.FormatKeepAChangelog, formatters.FormatConventional))
+	}
+
+	// validate release-date usage
+	if date := flags.getReleaseDate(); date != "" {
+		if !isReleaseNotesFormat(flags.getFormat()) {
+			return getErrInvalidFlags(fmt.Errorf("--release-date is only supported by the %s and %s formats", formatters.FormatKeepAChangelog, formatters.FormatConventional))
+		}
+		if _, err := time.Parse(time.DateOnly, date); err != nil {
+			return getErrInvalidFlags(fmt.Errorf("invalid --release-date %q, expected YYYY-MM-DD", date))
+		}
+	}
+
 	// render
 	colorMode, err := checker.NewColorMode(flags.getColor())
 	if err != nil {
 		return getErrInvalidColorMode(err)
 	}
 
-	bytes, err := formatter.RenderChangelog(errs, formatters.RenderOpts{ColorMode: colorMode, TemplatePath: flags.getTemplate()}, specInfoPair.GetBaseVersion(), specInfoPair.GetRevisionVersion())
+	opts := getTemplateRenderOpts(flags, diffResult.specInfoPair)
+	opts.ColorMode = colorMode
+	opts.DiffReport = diffResult.diffReport
+	opts.MaxSize = flags.getMaxSize()
+	opts.GroupBy = flags.getGroupBy()
+	opts.Attributes = flags.getAttributes()
+	opts.ReleaseDate = flags.getReleaseDate()
+
+	bytes, err := formatter.RenderChangelog(errs, opts, diffResult.specInfoPair.GetBaseVersion(), diffResult.specInfoPair.GetRevisionVersion())
 	if err != nil {
 		return getErrFailedPrint(changelogCmd+" "+flags.getFormat(), err)
 	}
 
+	if file := flags.getPrependTo(); file != "" {
+		return prependToChangelog(file, bytes)
+	}
+
 	// print output
 	_, _ = fmt.Fprintf(stdout, "%s\n", bytes)
 
 	return nil
 }
 
-func getCustomSeverityLevels(severityLevelsFile string) (map[string]checker.Level, *ReturnError) {
+func isReleaseNotesFormat(format string) bool {
+	return format == string(formatters.FormatKeepAChangelog) || format == string(formatters.FormatConventional)
+}
+
+// prependToChangelog inserts the release into the changelog file, creating the file if it doesn't exist
+func prependToChangelog(file string, release []byte) *ReturnError {
+	changelog, err := os.ReadFile(file)
+	if err != nil && !os.IsNotExist(err) {
+		return getErrFailedToUpdateChangelog(file, err)
+	}
+
+	if err := os.WriteFile(file, formatters.PrependRelease(changelog, release), 0644); err != nil {
+		return getErrFailedToUpdateChangelog(file, err)
+	}
+
+	return nil
+}
+
+// getCustomSeverityLevels reads the severity levels file, which may also override the levels of the custom rules and of the ids declared by the plugins
+func getCustomSeverityLevels(severityLevelsFile string, customRules checker.CustomRules, pluginList plugins.Plugins) (map[string]checker.Level, *ReturnError) {
 	if severityLevelsFile == "" {
 		return nil, nil
 	}
 
-	m, err := checker.ProcessSeverityLevels(severityLevelsFile)
+	customIds := customRules.GetIds()
+	if len(pluginList) > 0 {
+		customIds = append(customIds, pluginList.GetIds()...)
+	}
+
+	m, err := checker.ProcessSeverityLevels(severityLevelsFile, customIds...)
 	if err != nil {
 		return nil, getErrFailedToLoadSeverityLevels(severityLevelsFile, err)
 	}
 
 	return m, nil
 }
+
+func getCustomRules(customRulesFile string) (checker.CustomRules, *ReturnError) {
+	if customRulesFile == "" {
+		return nil, nil
+	}
+
+	rules, err := checker.LoadCustomRules(customRulesFile)
+	if err != nil {
+		return nil, getErrFailedToLoadCustomRules(customRulesFile, err)
+	}
+
+	return rules, nil
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/oasdiff/oasdiff/checker"
 	"github.com/oasdiff/oasdiff/checker/localizations"
 	"github.com/oasdiff/oasdiff/formatters"
+	"github.com/oasdiff/oasdiff/lint"
 	"github.com/spf13/cobra"
 	"golang.org/x/exp/slices"
 )
@@ -19,7 +20,7 @@ func getChecksCmd() *cobra.Command {
 	cmd := cobra.Command{
 		Use:               "checks [flags]",
 		Short:             "Display checks",
-		Long:              `Display a list of all supported checks.`,
+		Long:              `Display a list of all supported checks, or of all lint rules with --lint.`,
 		Args:              cobra.NoArgs,
 		ValidArgsFunction: cobra.NoFileCompletions, // see https://github.com/spf13/cobra/issues/1969
 		RunE:              getRun(runChecks),
@@ -29,14 +30,36 @@ func getChecksCmd() *cobra.Command {
 	enumWithOptions(&cmd, newEnumValue(formatters.SupportedFormatsByContentType(formatters.OutputChecks), string(formatters.FormatText)), "format", "f", "output format")
 	enumWithOptions(&cmd, newEnumSliceValue([]string{"info", "warn", "error"}, nil), "severity", "s", "include only checks with any of specified severities")
 	enumWithOptions(&cmd, newEnumSliceValue(getAllTags(), nil), "tags", "t", "include only checks with all specified tags")
+	addTemplateFlag(&cmd)
+	cmd.PersistentFlags().Bool("lint", false, "display lint rules instead of breaking-change checks")
 
 	return &cmd
 }
 
 func runChecks(flags *Flags, stdout io.Writer) (bool, *ReturnError) {
+	if flags.getLint() {
+		return false, outputChecks(stdout, flags, getLintRules())
+	}
 	return false, outputChecks(stdout, flags, checker.GetAllRules())
 }
 
+// getLintRules returns the lint rules, including the style rules, as rules without direction, location and action so they can be listed like the breaking-change checks
+func getLintRules() []checker.BackwardCompatibilityRule {
+	rules := append(lint.GetAllRules(), lint.GetStyleRules()...)
+	result := make([]checker.BackwardCompatibilityRule, len(rules))
+	for i, rule := range rules {
+		result[i] = checker.BackwardCompatibilityRule{
+			Id:          rule.Id,
+			Level:       rule.Level,
+			Description: rule.Description,
+			Direction:   checker.DirectionNone,
+			Location:    checker.LocationNone,
+			Action:      checker.ActionNone,
+		}
+	}
+	return result
+}
+
 func outputChecks(stdout io.Writer, flags *Flags, rules []checker.BackwardCompatibilityRule) *ReturnError {
 
 	format := flags.getFormat()
@@ -49,6 +72,11 @@ func outputChecks(stdout io.Writer, flags *Flags, rules []checker.BackwardCompat
 		return getErrUnsupportedFormat(format, checksCmd)
 	}
 
+	// validate template usage
+	if flags.getTemplate() != "" && !formatter.SupportsTemplate() {
+		return getErrTemplateNotSupported(format)
+	}
+
 	// filter rules
 	severity := flags.getSeverity()
 	checks := make(formatters.Checks, 0, len(rules))
@@ -75,12 +103,15 @@ func outputChecks(stdout io.Writer, flags *Flags, rules []checker.BackwardCompat
 			Id:          rule.Id,
 			Level:       rule.Level.String(),
 			Description: rule.Description,
+			Direction:   rule.Direction.String(),
+			Location:    rule.Location.String(),
+			Action:      rule.Action.String(),
 		})
 	}
 
 	// render
 	sort.Sort(checks)
-	bytes, err := formatter.RenderChecks(checks, formatters.NewRenderOpts())
+	bytes, err := formatter.RenderChecks(checks, getTemplateRenderOpts(flags, nil))
 	if err != nil {
 		return getErrFailedPrint("checks "+format, err)
 	}
@@ -20,11 +20,18 @@ func addCommonDiffFlags(cmd *cobra.Command) {
 	cmd.PersistentFlags().Bool("flatten-allof", false, "merge subschemas under allOf before diff")
 	cmd.PersistentFlags().Bool("flatten-params", false, "merge common parameters at path level with operation parameters")
 	cmd.PersistentFlags().Bool("case-insensitive-headers", false, "case-insensitive header name comparison")
+	cmd.PersistentFlags().StringSlice("overlay-base", nil, "OpenAPI Overlay files to apply to base-spec before comparison")
+	cmd.PersistentFlags().StringSlice("overlay-revision", nil, "OpenAPI Overlay files to apply to revised-spec before comparison")
 
 	addHiddenFlattenFlag(cmd)
 	addHiddenCircularDepFlag(cmd)
 }
 
+// addTemplateFlag adds --template which is supported by the markdown and html formats
+func addTemplateFlag(cmd *cobra.Command) {
+	cmd.PersistentFlags().String("template", "", "path to custom template file for markdown and html output")
+}
+
 // addHiddenFlattenFlag adds --flatten as a hidden flag
 // --flatten was replaced by --flatten-allof
 // we still accept --flatten as a synonym for --flatten-allof to avoid breaking existing scripts
@@ -62,6 +69,24 @@ func addCommonBreakingFlags(cmd *cobra.Command) {
 	enumWithOptions(cmd, newEnumValue(checker.GetSupportedColorValues(), "auto"), "color", "", "when to colorize textual output")
 	enumWithOptions(cmd, newEnumValue(formatters.SupportedFormatsByContentType(formatters.OutputChangelog), string(formatters.FormatText)), "format", "f", "output format")
 	cmd.PersistentFlags().String("severity-levels", "", "configuration file for custom severity levels")
+	cmd.PersistentFlags().String("custom-rules", "", "configuration file for custom rules")
+	cmd.PersistentFlags().Bool("run-plugins", false, "run the plugins listed in the config file")
 	cmd.PersistentFlags().StringSlice("attributes", nil, "OpenAPI Extensions to include in json or yaml output")
-	cmd.PersistentFlags().String("template", "", "path to custom template file for changelog generation")
+	addTemplateFlag(cmd)
+	cmd.PersistentFlags().String("prepend-to", "", "insert keepachangelog or conventional-commits output into this changelog file instead of printing it")
+	cmd.PersistentFlags().String("release-date", "", "date of the release in keepachangelog and conventional-commits output (YYYY-MM-DD), omitted if not set")
+	cmd.PersistentFlags().Int("max-size", formatters.DefaultPRCommentMaxSize, "maximum size in bytes of pr-comment output, additional changes are truncated")
+	enumWithOptions(cmd, newEnumValue(formatters.GetSupportedGroupBy(), formatters.GroupByEndpoint), "group-by", "", "group changes in pr-comment output by")
+	cmd.PersistentFlags().StringSlice("traffic", nil, "recorded traffic files (HAR or JSON lines) used to annotate changes with their usage")
+	cmd.PersistentFlags().Bool("traffic-downgrade", false, "downgrade breaking changes without recorded usage to INFO (requires --traffic)")
+	cmd.PersistentFlags().Bool("lint", false, "also report lint errors in the parts of the revision that were added or modified")
+	cmd.PersistentFlags().String("lint-severity-levels", "", "configuration file for custom severity levels of lint rules")
+	addLintRuleFlags(cmd)
+}
+
+// addLintRuleFlags adds --lint-ruleset which adds the rules of a Spectral ruleset to lint, and --lint-style and --lint-style-options which add the style rules
+func addLintRuleFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().String("lint-ruleset", "", "Spectral ruleset file with additional lint rules")
+	cmd.PersistentFlags().Bool("lint-style", false, "add the style guide rules to lint")
+	cmd.PersistentFlags().String("lint-style-options", "", "configuration file for the style guide rules, implies --lint-style")
 }
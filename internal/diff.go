@@ -3,6 +3,7 @@ package internal
 import (
 	"fmt"
 	"io"
+	"slices"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/oasdiff/oasdiff/diff"
@@ -25,14 +26,28 @@ func getDiffCmd() *cobra.Command {
 
 	addCommonDiffFlags(&cmd)
 	enumWithOptions(&cmd, newEnumSliceValue(diff.GetExcludeDiffOptions(), nil), "exclude-elements", "e", "elements to exclude")
-	enumWithOptions(&cmd, newEnumValue(formatters.SupportedFormatsByContentType(formatters.OutputDiff), string(formatters.FormatYAML)), "format", "f", "output format")
+	enumWithOptions(&cmd, newEnumValue(getDiffFormats(), string(formatters.FormatYAML)), "format", "f", "output format")
 	cmd.PersistentFlags().BoolP("fail-on-diff", "o", false, "exit with return code 1 when any change is found")
+	addTemplateFlag(&cmd)
 
 	return &cmd
 }
 
+// getDiffFormats returns the formats of the diff report followed by the formats of patches that transform the base spec into the revision spec
+func getDiffFormats() []string {
+	return append(formatters.SupportedFormatsByContentType(formatters.OutputDiff), formatters.SupportedFormatsByContentType(formatters.OutputPatch)...)
+}
+
+func isPatchFormat(format string) bool {
+	return slices.Contains(formatters.SupportedFormatsByContentType(formatters.OutputPatch), format)
+}
+
 func runDiff(flags *Flags, stdout io.Writer) (bool, *ReturnError) {
 
+	if isPatchFormat(flags.getFormat()) {
+		return runPatch(flags, stdout)
+	}
+
 	if flags.getFormat() == string(formatters.FormatJSON) {
 		flags.addExcludeElements(diff.ExcludeEndpointsOption)
 	}
@@ -42,22 +57,29 @@ func runDiff(flags *Flags, stdout io.Writer) (bool, *ReturnError) {
 		return false, err
 	}
 
-	if err := outputDiff(stdout, diffResult.diffReport, flags.getFormat()); err != nil {
+	if err := outputDiff(stdout, flags, diffResult); err != nil {
 		return false, err
 	}
 
 	return flags.getFailOnDiff() && !diffResult.diffReport.Empty(), nil
 }
 
-func outputDiff(stdout io.Writer, diffReport *diff.Diff, format string) *ReturnError {
+func outputDiff(stdout io.Writer, flags *Flags, diffResult *diffResult) *ReturnError {
+	format := flags.getFormat()
+
 	// formatter lookup
 	formatter, err := formatters.Lookup(format, formatters.DefaultFormatterOpts())
 	if err != nil {
 		return getErrUnsupportedFormat(format, diffCmd)
 	}
 
+	// validate template usage
+	if flags.getTemplate() != "" && !formatter.SupportsTemplate() {
+		return getErrTemplateNotSupported(format)
+	}
+
 	// render
-	bytes, err := formatter.RenderDiff(diffReport, formatters.NewRenderOpts())
+	bytes, err := formatter.RenderDiff(diffResult.diffReport, getTemplateRenderOpts(flags, diffResult.specInfoPair))
 	if err != nil {
 		return getErrFailedPrint("diff "+format, err)
 	}
@@ -68,6 +90,120 @@ func outputDiff(stdout io.Writer, diffReport *diff.Diff, format string) *ReturnE
 	return nil
 }
 
+// getTemplateRenderOpts returns the render options with the custom template and the specs that are passed to it
+func getTemplateRenderOpts(flags *Flags, specInfoPair *load.SpecInfoPair) formatters.RenderOpts {
+	opts := formatters.NewRenderOpts()
+	opts.TemplatePath = flags.getTemplate()
+	opts.SpecInfoPair = specInfoPair
+	return opts
+}
+
+// runPatch prints the patch that transforms the base spec into the revision spec
+func runPatch(flags *Flags, stdout io.Writer) (bool, *ReturnError) {
+	format := flags.getFormat()
+
+	// formatter lookup
+	formatter, err := formatters.Lookup(format, formatters.DefaultFormatterOpts())
+	if err != nil {
+		return false, getErrUnsupportedFormat(format, diffCmd)
+	}
+
+	documentFormatter, isDocumentPatch := formatter.(formatters.DocumentPatchFormatter)
+
+	if returnErr := checkPatchFlags(flags, isDocumentPatch); returnErr != nil {
+		return false, returnErr
+	}
+
+	if isDocumentPatch {
+		return outputDocumentPatch(stdout, flags, documentFormatter)
+	}
+
+	diffResult, returnErr := calcDiff(flags)
+	if returnErr != nil {
+		return false, returnErr
+	}
+
+	// render
+	bytes, err := formatter.RenderPatch(diffResult.specInfoPair.Base.Spec, diffResult.specInfoPair.Revision.Spec, formatters.NewRenderOpts())
+	if err != nil {
+		return false, getErrFailedPrint("diff "+format, err)
+	}
+
+	// print output
+	_, _ = fmt.Fprintf(stdout, "%s\n", bytes)
+
+	return flags.getFailOnDiff() && !diffResult.diffReport.Empty(), nil
+}
+
+// checkPatchFlags rejects the flags that patches don't support
+// Patches transform the whole base spec into the revision spec, so they ignore the filters of the diff report
+// Document patches compare the raw documents, so they also ignore the options that modify the specs when they are loaded
+func checkPatchFlags(flags *Flags, isDocumentPatch bool) *ReturnError {
+	format := flags.getFormat()
+
+	if flags.getComposed() {
+		return getErrInvalidFlags(fmt.Errorf("%s format is not supported in composed mode", format))
+	}
+
+	for _, name := range []string{"match-path", "unmatch-path", "filter-extension", "prefix-base", "prefix-revision", "strip-prefix-base", "strip-prefix-revision"} {
+		if flags.v.GetString(name) != "" {
+			return getErrInvalidFlags(fmt.Errorf("--%s is not supported by the %s format", name, format))
+		}
+	}
+	if flags.v.GetBool("include-path-params") {
+		return getErrInvalidFlags(fmt.Errorf("--include-path-params is not supported by the %s format", format))
+	}
+	if len(flags.getExcludeElements()) > 0 {
+		return getErrInvalidFlags(fmt.Errorf("--exclude-elements is not supported by the %s format", format))
+	}
+
+	if !isDocumentPatch {
+		return nil
+	}
+
+	if flags.getFlattenAllOf() {
+		return getErrInvalidFlags(fmt.Errorf("--flatten-allof is not supported by the %s format", format))
+	}
+	if flags.getFlattenParams() {
+		return getErrInvalidFlags(fmt.Errorf("--flatten-params is not supported by the %s format", format))
+	}
+	if flags.getCaseInsensitiveHeaders() {
+		return getErrInvalidFlags(fmt.Errorf("--case-insensitive-headers is not supported by the %s format", format))
+	}
+	if len(flags.getOverlayBase()) > 0 || len(flags.getOverlayRevision()) > 0 {
+		return getErrInvalidFlags(fmt.Errorf("--overlay-base and --overlay-revision are not supported by the %s format", format))
+	}
+
+	return nil
+}
+
+// outputDocumentPatch prints the patch between the raw base and revision documents
+func outputDocumentPatch(stdout io.Writer, flags *Flags, formatter formatters.DocumentPatchFormatter) (bool, *ReturnError) {
+	base, err := load.ReadDocument(flags.getBase())
+	if err != nil {
+		return false, getErrFailedToLoadSpec("base", flags.getBase(), err)
+	}
+
+	revision := base
+	// stdin can only be read once, so in this edge case, we use base as the revision
+	if !flags.getRevision().IsStdin() || !flags.getBase().IsStdin() {
+		if revision, err = load.ReadDocument(flags.getRevision()); err != nil {
+			return false, getErrFailedToLoadSpec("revision", flags.getRevision(), err)
+		}
+	}
+
+	// render
+	bytes, err := formatter.RenderDocumentPatch(base, revision, formatters.NewRenderOpts())
+	if err != nil {
+		return false, getErrFailedPrint("diff "+flags.getFormat(), err)
+	}
+
+	// print output
+	_, _ = fmt.Fprintf(stdout, "%s\n", bytes)
+
+	return flags.getFailOnDiff() && string(bytes) != formatters.EmptyJSONPatch, nil
+}
+
 func calcDiff(flags *Flags) (*diffResult, *ReturnError) {
 
 	loader := openapi3.NewLoader()
@@ -99,13 +235,15 @@ func normalDiff(loader load.Loader, flags *Flags) (*diffResult, *ReturnError) {
 	flattenAllOf := load.GetOption(load.WithFlattenAllOf(), flags.getFlattenAllOf())
 	flattenParams := load.GetOption(load.WithFlattenParams(), flags.getFlattenParams())
 	lowerHeaderNames := load.GetOption(load.WithLowercaseHeaders(), flags.getCaseInsensitiveHeaders())
+	overlayBase := load.GetOption(load.WithOverlays(flags.getOverlayBase()...), len(flags.getOverlayBase()) > 0)
+	overlayRevision := load.GetOption(load.WithOverlays(flags.getOverlayRevision()...), len(flags.getOverlayRevision()) > 0)
 
-	s1, err := load.NewSpecInfo(loader, flags.getBase(), flattenAllOf, flattenParams, lowerHeaderNames)
+	s1, err := load.NewSpecInfo(loader, flags.getBase(), overlayBase, flattenAllOf, flattenParams, lowerHeaderNames)
 	if err != nil {
 		return nil, getErrFailedToLoadSpec("base", flags.getBase(), err)
 	}
 
-	s2, err := load.NewSpecInfo(loader, flags.getRevision(), flattenAllOf, flattenParams, lowerHeaderNames)
+	s2, err := load.NewSpecInfo(loader, flags.getRevision(), overlayRevision, flattenAllOf, flattenParams, lowerHeaderNames)
 	if err != nil {
 		return nil, getErrFailedToLoadSpec("revision", flags.getRevision(), err)
 	}
@@ -128,13 +266,15 @@ func composedDiff(loader load.Loader, flags *Flags) (*diffResult, *ReturnError)
 	flattenAllOf := load.GetOption(load.WithFlattenAllOf(), flags.getFlattenAllOf())
 	flattenParams := load.GetOption(load.WithFlattenParams(), flags.getFlattenParams())
 	lowerHeaderNames := load.GetOption(load.WithLowercaseHeaders(), flags.getCaseInsensitiveHeaders())
+	overlayBase := load.GetOption(load.WithOverlays(flags.getOverlayBase()...), len(flags.getOverlayBase()) > 0)
+	overlayRevision := load.GetOption(load.WithOverlays(flags.getOverlayRevision()...), len(flags.getOverlayRevision()) > 0)
 
-	s1, err := load.NewSpecInfoFromGlob(loader, flags.getBase().Path, flattenAllOf, flattenParams, lowerHeaderNames)
+	s1, err := load.NewSpecInfoFromGlob(loader, flags.getBase().Path, overlayBase, flattenAllOf, flattenParams, lowerHeaderNames)
 	if err != nil {
 		return nil, getErrFailedToLoadSpecs("base", flags.getBase().Path, err)
 	}
 
-	s2, err := load.NewSpecInfoFromGlob(loader, flags.getRevision().Path, flattenAllOf, flattenParams, lowerHeaderNames)
+	s2, err := load.NewSpecInfoFromGlob(loader, flags.getRevision().Path, overlayRevision, flattenAllOf, flattenParams, lowerHeaderNames)
 	if err != nil {
 		return nil, getErrFailedToLoadSpecs("revision", flags.getRevision().Path, err)
 	}
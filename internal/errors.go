@@ -93,6 +93,48 @@ func getErrCantProcessIgnoreFile(what string, err error) *ReturnError {
 	)
 }
 
+func getErrFailedToLoadTraffic(err error) *ReturnError {
+	return getError(
+		fmt.Errorf("failed to load traffic: %w", err),
+		122,
+	)
+}
+
+func getErrFailedToLoadCustomRules(source string, err error) *ReturnError {
+	return getError(
+		fmt.Errorf("failed to load custom rules from %s: %w", source, err),
+		123,
+	)
+}
+
+func getErrFailedToUpdateChangelog(file string, err error) *ReturnError {
+	return getError(
+		fmt.Errorf("failed to update changelog file %s: %w", file, err),
+		124,
+	)
+}
+
+func getErrFailedToLoadLintRuleset(source string, err error) *ReturnError {
+	return getError(
+		fmt.Errorf("failed to load lint ruleset from %s: %w", source, err),
+		125,
+	)
+}
+
+func getErrFailedToFixSpec(source string, err error) *ReturnError {
+	return getError(
+		fmt.Errorf("failed to fix spec %s: %w", source, err),
+		126,
+	)
+}
+
+func getErrFailedToLoadLintStyle(source string, err error) *ReturnError {
+	return getError(
+		fmt.Errorf("failed to load lint style options from %s: %w", source, err),
+		127,
+	)
+}
+
 func getError(err error, code int) *ReturnError {
 	return &ReturnError{err, code}
 }
@@ -3,6 +3,7 @@ package internal
 import (
 	"github.com/oasdiff/oasdiff/diff"
 	"github.com/oasdiff/oasdiff/load"
+	"github.com/oasdiff/oasdiff/plugins"
 	"github.com/spf13/viper"
 )
 
@@ -56,6 +57,14 @@ func (flags *Flags) getFlattenAllOf() bool {
 	return flags.v.GetBool("flatten-allof") || flags.v.GetBool("flatten")
 }
 
+func (flags *Flags) getOverlayBase() []string {
+	return flags.v.GetStringSlice("overlay-base")
+}
+
+func (flags *Flags) getOverlayRevision() []string {
+	return flags.v.GetStringSlice("overlay-revision")
+}
+
 func (flags *Flags) getFlattenParams() bool {
 	return flags.v.GetBool("flatten-params")
 }
@@ -112,6 +121,22 @@ func (flags *Flags) getSeverityLevelsFile() string {
 	return flags.v.GetString("severity-levels")
 }
 
+func (flags *Flags) getCustomRulesFile() string {
+	return flags.v.GetString("custom-rules")
+}
+
+func (flags *Flags) getRunPlugins() bool {
+	return flags.v.GetBool("run-plugins")
+}
+
+func (flags *Flags) getPlugins() (plugins.Plugins, error) {
+	var result plugins.Plugins
+	if err := flags.v.UnmarshalKey("plugins", &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (flags *Flags) getExcludeElements() []string {
 	return fixViperStringSlice(flags.v.GetStringSlice("exclude-elements"))
 }
@@ -139,3 +164,55 @@ func (flags *Flags) getTags() []string {
 func (flags *Flags) getTemplate() string {
 	return flags.v.GetString("template")
 }
+
+func (flags *Flags) getPrependTo() string {
+	return flags.v.GetString("prepend-to")
+}
+
+func (flags *Flags) getReleaseDate() string {
+	return flags.v.GetString("release-date")
+}
+
+func (flags *Flags) getMaxSize() int {
+	return flags.v.GetInt("max-size")
+}
+
+func (flags *Flags) getGroupBy() string {
+	return flags.v.GetString("group-by")
+}
+
+func (flags *Flags) getTraffic() []string {
+	return flags.v.GetStringSlice("traffic")
+}
+
+func (flags *Flags) getLint() bool {
+	return flags.v.GetBool("lint")
+}
+
+func (flags *Flags) getLintSeverityLevelsFile() string {
+	return flags.v.GetString("lint-severity-levels")
+}
+
+func (flags *Flags) getLintRuleset() string {
+	return flags.v.GetString("lint-ruleset")
+}
+
+func (flags *Flags) getLintStyle() bool {
+	return flags.v.GetBool("lint-style")
+}
+
+func (flags *Flags) getLintStyleOptions() string {
+	return flags.v.GetString("lint-style-options")
+}
+
+func (flags *Flags) getFlattenModes() []string {
+	return fixViperStringSlice(flags.v.GetStringSlice("mode"))
+}
+
+func (flags *Flags) getFix() string {
+	return flags.v.GetString("fix")
+}
+
+func (flags *Flags) getTrafficDowngrade() bool {
+	return flags.v.GetBool("traffic-downgrade")
+}
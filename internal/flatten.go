@@ -3,6 +3,7 @@ package internal
 import (
 	"fmt"
 	"io"
+	"slices"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/oasdiff/oasdiff/formatters"
@@ -16,8 +17,15 @@ func getFlattenCmd() *cobra.Command {
 
 	cmd := cobra.Command{
 		Use:   "flatten spec",
-		Short: "Merge allOf",
-		Long: `Display a flattened version of the given OpenAPI spec by merging all instances of allOf.
+		Short: "Merge allOf, flatten discriminated schemas, dereference, bundle or hoist schemas",
+		Long: `Display a flattened version of the given OpenAPI spec.
+By default, all instances of allOf are merged.
+Use --mode to select other ways to flatten the spec, they are applied in this order:
+- bundle: move objects referenced in external files into the components
+- discriminator: merge the subschemas of oneOf and anyOf with a discriminator with their parent
+- allof: merge all instances of allOf
+- hoist: move inline object schemas into named component schemas
+- deref: replace all $refs by the objects that they reference, circular references are replaced by placeholders
 Spec can be a path to a file, a URL or '-' to read standard input.
 `,
 		Args: cobra.ExactArgs(1),
@@ -25,16 +33,46 @@ Spec can be a path to a file, a URL or '-' to read standard input.
 	}
 
 	enumWithOptions(&cmd, newEnumValue(formatters.SupportedFormatsByContentType(formatters.OutputFlatten), string(formatters.FormatJSON)), "format", "f", "output format")
+	enumWithOptions(&cmd, newEnumSliceValue(getFlattenModes(), []string{flattenModeAllOf}), "mode", "", "ways to flatten the spec")
 	addHiddenCircularDepFlag(&cmd)
 
 	return &cmd
 }
 
+const (
+	flattenModeBundle        = "bundle"
+	flattenModeDiscriminator = "discriminator"
+	flattenModeAllOf         = "allof"
+	flattenModeHoist         = "hoist"
+	flattenModeDeref         = "deref"
+)
+
+// getFlattenModes returns the flatten modes in the order in which they are applied
+func getFlattenModes() []string {
+	return []string{flattenModeBundle, flattenModeDiscriminator, flattenModeAllOf, flattenModeHoist, flattenModeDeref}
+}
+
+func getFlattenOptions(modes []string) []load.Option {
+	options := map[string]load.Option{
+		flattenModeBundle:        load.WithBundle(),
+		flattenModeDiscriminator: load.WithFlattenDiscriminator(),
+		flattenModeAllOf:         load.WithFlattenAllOf(),
+		flattenModeHoist:         load.WithHoist(),
+		flattenModeDeref:         load.WithDereference(),
+	}
+
+	result := []load.Option{}
+	for _, mode := range getFlattenModes() {
+		result = append(result, load.GetOption(options[mode], slices.Contains(modes, mode)))
+	}
+	return result
+}
+
 func runFlatten(flags *Flags, stdout io.Writer) (bool, *ReturnError) {
 
 	loader := openapi3.NewLoader()
 	loader.IsExternalRefsAllowed = true
-	spec, err := load.NewSpecInfo(loader, flags.getBase(), load.WithFlattenAllOf())
+	spec, err := load.NewSpecInfo(loader, flags.getBase(), getFlattenOptions(flags.getFlattenModes())...)
 	if err != nil {
 		return false, getErrFailedToLoadSpec("original", flags.getBase(), err)
 	}
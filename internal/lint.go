@@ -0,0 +1,295 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/checker/localizations"
+	"github.com/oasdiff/oasdiff/formatters"
+	"github.com/oasdiff/oasdiff/lint"
+	"github.com/oasdiff/oasdiff/load"
+	"github.com/spf13/cobra"
+)
+
+func getLintCmd() *cobra.Command {
+
+	cmd := cobra.Command{
+		Use:   "lint [base] revision [flags]",
+		Short: "Lint a spec",
+		Long: `Display lint errors of a spec.
+With base and revision, display only the lint errors in the parts of the revision that were added or modified.
+` + specHelp,
+		Args: getLintArgs(),
+		RunE: getRun(runLint),
+	}
+
+	addCommonDiffFlags(&cmd)
+	enumWithOptions(&cmd, newEnumValue(localizations.GetSupportedLanguages(), localizations.LangDefault), "lang", "l", "language for localized output")
+	enumWithOptions(&cmd, newEnumValue(checker.GetSupportedColorValues(), "auto"), "color", "", "when to colorize textual output")
+	enumWithOptions(&cmd, newEnumValue(formatters.SupportedFormatsByContentType(formatters.OutputChangelog), string(formatters.FormatText)), "format", "f", "output format")
+	enumWithOptions(&cmd, newEnumValue(GetSupportedLevels(), ""), "fail-on", "o", "exit with return code 1 when output includes errors with this level or higher")
+	enumWithOptions(&cmd, newEnumValue(GetSupportedLevels(), LevelInfo), "level", "", "output errors with this level or higher")
+	cmd.PersistentFlags().String("err-ignore", "", "configuration file for ignoring errors")
+	cmd.PersistentFlags().String("warn-ignore", "", "configuration file for ignoring warnings")
+	cmd.PersistentFlags().String("severity-levels", "", "configuration file for custom severity levels of lint rules")
+	addTemplateFlag(&cmd)
+	cmd.PersistentFlags().Int("max-size", formatters.DefaultPRCommentMaxSize, "maximum size in bytes of pr-comment output, additional changes are truncated")
+	enumWithOptions(&cmd, newEnumValue(formatters.GetSupportedGroupBy(), formatters.GroupByEndpoint), "group-by", "", "group changes in pr-comment output by")
+	addLintRuleFlags(&cmd)
+	enumWithOptions(&cmd, newEnumValue(getSupportedFixValues(), ""), "fix", "", "apply safe fixes to the spec file, or print them as a diff with dry-run")
+	cmd.PersistentFlags().Lookup("fix").NoOptDefVal = fixApply
+
+	return &cmd
+}
+
+const (
+	fixApply  = "true"
+	fixDryRun = "dry-run"
+)
+
+func getSupportedFixValues() []string {
+	return []string{fixApply, fixDryRun}
+}
+
+func getLintArgs() cobra.PositionalArgs {
+	return func(cmd *cobra.Command, args []string) error {
+		if len(args) < 1 {
+			return errors.New("please specify a spec, or base and revision specs, as a path to a file, a URL, or '-' to read standard input")
+		}
+		if len(args) > 2 {
+			return errors.New("invalid arguments after base and revision")
+		}
+		return checkColor(cmd)
+	}
+}
+
+func runLint(flags *Flags, stdout io.Writer) (bool, *ReturnError) {
+
+	level, err := checker.NewLevel(flags.getLevel())
+	if err != nil {
+		return false, getErrInvalidFlags(fmt.Errorf("invalid level value: %q", flags.getLevel()))
+	}
+
+	if fix := flags.getFix(); fix != "" {
+		if done, returnErr := runLintFix(flags, stdout, fix); done || returnErr != nil {
+			return false, returnErr
+		}
+	}
+
+	var changes checker.Changes
+	var diffResult *diffResult
+	var returnErr *ReturnError
+	if flags.getRevision() == nil {
+		changes, diffResult, returnErr = getLintChanges(flags, level)
+	} else {
+		diffResult, returnErr = calcDiff(flags)
+		if returnErr == nil {
+			changes, returnErr = getChangedLintChanges(flags, diffResult, level, flags.getSeverityLevelsFile())
+		}
+	}
+	if returnErr != nil {
+		return false, returnErr
+	}
+
+	if returnErr := outputChangelog(flags, stdout, changes, diffResult); returnErr != nil {
+		return false, returnErr
+	}
+
+	if flags.getFailOn() != "" {
+		level, err := checker.NewLevel(flags.getFailOn())
+		if err != nil {
+			return false, getErrInvalidFlags(fmt.Errorf("invalid fail-on value %s", flags.getFailOn()))
+		}
+		return changes.HasLevelOrHigher(level), nil
+	}
+
+	return false, nil
+}
+
+// runLintFix applies safe fixes to the spec file, or prints them as a diff in dry-run mode
+// It returns true if there is nothing more to do
+func runLintFix(flags *Flags, stdout io.Writer, fix string) (bool, *ReturnError) {
+	if flags.getRevision() != nil {
+		return false, getErrInvalidFlags(errors.New("fix is not supported with base and revision"))
+	}
+
+	source := flags.getBase()
+	if source.Type != load.SourceTypeFile {
+		return false, getErrInvalidFlags(errors.New("fix requires a spec file"))
+	}
+
+	result, err := lint.FixFile(source.Path)
+	if err != nil {
+		return false, getErrFailedToFixSpec(source.Path, err)
+	}
+
+	if fix == fixDryRun {
+		_, _ = fmt.Fprint(stdout, result.Diff(source.Path))
+		return true, nil
+	}
+
+	if len(result.Fixes) == 0 {
+		return false, nil
+	}
+
+	info, err := os.Stat(source.Path)
+	if err != nil {
+		return false, getErrFailedToFixSpec(source.Path, err)
+	}
+	if err := os.WriteFile(source.Path, result.Fixed, info.Mode().Perm()); err != nil {
+		return false, getErrFailedToFixSpec(source.Path, err)
+	}
+	return false, nil
+}
+
+// getLintChanges lints a whole spec
+func getLintChanges(flags *Flags, level checker.Level) (checker.Changes, *diffResult, *ReturnError) {
+	if flags.getComposed() {
+		return nil, nil, getErrInvalidFlags(errors.New("lint is not supported in composed mode"))
+	}
+
+	config, returnErr := getLintConfig(flags, flags.getSeverityLevelsFile())
+	if returnErr != nil {
+		return nil, nil, returnErr
+	}
+
+	if returnErr := checkLintSpecFlags(flags); returnErr != nil {
+		return nil, nil, returnErr
+	}
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	// a single spec is the revision, see the usage of the lint command
+	spec, err := load.NewSpecInfo(loader, flags.getBase(),
+		load.GetOption(load.WithOverlays(flags.getOverlayRevision()...), len(flags.getOverlayRevision()) > 0),
+		load.GetOption(load.WithFlattenAllOf(), flags.getFlattenAllOf()),
+		load.GetOption(load.WithFlattenParams(), flags.getFlattenParams()),
+		load.GetOption(load.WithLowercaseHeaders(), flags.getCaseInsensitiveHeaders()))
+	if err != nil {
+		return nil, nil, getErrFailedToLoadSpec("spec", flags.getBase(), err)
+	}
+
+	errs, returnErr := filterLintErrors(flags, lint.Run(config, spec.Url, spec), level)
+	if returnErr != nil {
+		return nil, nil, returnErr
+	}
+	return errs.ToChanges(), newDiffResult(nil, nil, load.NewSpecInfoPair(spec, spec)), nil
+}
+
+// checkLintSpecFlags rejects the flags that only apply to a diff when linting a single spec
+func checkLintSpecFlags(flags *Flags) *ReturnError {
+	for _, name := range []string{"match-path", "unmatch-path", "filter-extension", "prefix-base", "prefix-revision", "strip-prefix-base", "strip-prefix-revision"} {
+		if flags.v.GetString(name) != "" {
+			return getErrInvalidFlags(fmt.Errorf("--%s is only supported with base and revision", name))
+		}
+	}
+	if flags.v.GetBool("include-path-params") {
+		return getErrInvalidFlags(errors.New("--include-path-params is only supported with base and revision"))
+	}
+	if len(flags.getOverlayBase()) > 0 {
+		return getErrInvalidFlags(errors.New("--overlay-base is only supported with base and revision, use --overlay-revision with a single spec"))
+	}
+	return nil
+}
+
+// getChangedLintChanges lints the parts of the revision that were added or modified
+// severityLevelsFile is the --severity-levels of the lint command or the --lint-severity-levels of the breaking-changes commands
+func getChangedLintChanges(flags *Flags, diffResult *diffResult, level checker.Level, severityLevelsFile string) (checker.Changes, *ReturnError) {
+	if diffResult.specInfoPair == nil {
+		return nil, getErrInvalidFlags(errors.New("lint is not supported in composed mode"))
+	}
+
+	config, returnErr := getLintConfig(flags, severityLevelsFile)
+	if returnErr != nil {
+		return nil, returnErr
+	}
+
+	errs, returnErr := filterLintErrors(flags, lint.RunChanged(config, diffResult.diffReport, diffResult.specInfoPair.Base, diffResult.specInfoPair.Revision), level)
+	if returnErr != nil {
+		return nil, returnErr
+	}
+	return errs.ToChanges(), nil
+}
+
+func getLintConfig(flags *Flags, severityLevelsFile string) (*lint.Config, *ReturnError) {
+	config := lint.DefaultConfig().WithLocalizer(checker.NewLocalizer(flags.getLang()))
+
+	if severityLevelsFile != "" {
+		levels, err := lint.ProcessSeverityLevels(severityLevelsFile)
+		if err != nil {
+			return nil, getErrFailedToLoadSeverityLevels(severityLevelsFile, err)
+		}
+		config = config.WithSeverityLevels(levels)
+	}
+
+	if flags.getLintStyle() || flags.getLintStyleOptions() != "" {
+		style, returnErr := getLintStyle(flags.getLintStyleOptions())
+		if returnErr != nil {
+			return nil, returnErr
+		}
+		config = config.WithStyle(style)
+	}
+
+	if file := flags.getLintRuleset(); file != "" {
+		ruleset, err := lint.ReadSpectralRuleset(file)
+		if err != nil {
+			return nil, getErrFailedToLoadLintRuleset(file, err)
+		}
+		config = config.WithSpectralRuleset(ruleset)
+	}
+
+	return config, nil
+}
+
+func getLintStyle(optionsFile string) (*lint.Style, *ReturnError) {
+	if optionsFile == "" {
+		return lint.DefaultStyle(), nil
+	}
+
+	options, err := lint.ReadStyleOptions(optionsFile)
+	if err != nil {
+		return nil, getErrFailedToLoadLintStyle(optionsFile, err)
+	}
+
+	style, err := lint.NewStyle(options)
+	if err != nil {
+		return nil, getErrFailedToLoadLintStyle(optionsFile, err)
+	}
+
+	return style, nil
+}
+
+// filterLintErrors removes the errors below the level and the errors that match a line in --warn-ignore or --err-ignore
+func filterLintErrors(flags *Flags, errs lint.Errors, level checker.Level) (lint.Errors, *ReturnError) {
+	errs = filterLintLevel(errs, level)
+
+	if file := flags.getWarnIgnoreFile(); file != "" {
+		var err error
+		if errs, err = lint.ProcessIgnoredErrors(checker.WARN, errs, file); err != nil {
+			return nil, getErrCantProcessIgnoreFile("warn", err)
+		}
+	}
+
+	if file := flags.getErrIgnoreFile(); file != "" {
+		var err error
+		if errs, err = lint.ProcessIgnoredErrors(checker.ERR, errs, file); err != nil {
+			return nil, getErrCantProcessIgnoreFile("err", err)
+		}
+	}
+
+	return errs, nil
+}
+
+func filterLintLevel(errs lint.Errors, level checker.Level) lint.Errors {
+	result := make(lint.Errors, 0, len(errs))
+	for _, err := range errs {
+		if err.Level >= level {
+			result = append(result, err)
+		}
+	}
+	return result
+}
@@ -0,0 +1,65 @@
+package internal
+
+import (
+	"sort"
+
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/plugins"
+	"github.com/oasdiff/oasdiff/utils"
+)
+
+// getPlugins returns the plugins listed in the config file, or none if they weren't enabled with --run-plugins
+// The opt-in is only accepted on the command line, so that a config file in the working directory can't run commands on its own
+func getPlugins(flags *Flags) (plugins.Plugins, *ReturnError) {
+	if !flags.getRunPlugins() {
+		return nil, nil
+	}
+
+	pluginList, err := flags.getPlugins()
+	if err != nil {
+		return nil, getErrConfigFileProblem(err)
+	}
+	return pluginList, nil
+}
+
+// splitPluginLevels separates the severity levels of the plugin ids from the severity levels of the checker rules
+func splitPluginLevels(severityLevels map[string]checker.Level, pluginList plugins.Plugins) (map[string]checker.Level, map[string]checker.Level) {
+	if len(pluginList) == 0 || len(severityLevels) == 0 {
+		return severityLevels, nil
+	}
+
+	pluginIds := utils.StringList(pluginList.GetIds()).ToStringSet()
+	ruleLevels := map[string]checker.Level{}
+	pluginLevels := map[string]checker.Level{}
+	for id, level := range severityLevels {
+		if pluginIds.Contains(id) {
+			pluginLevels[id] = level
+		} else {
+			ruleLevels[id] = level
+		}
+	}
+	return ruleLevels, pluginLevels
+}
+
+// addPluginChanges runs the plugins and adds their changes with the levels from the severity levels file, if the level is the requested level or higher
+func addPluginChanges(pluginList plugins.Plugins, changes checker.Changes, diffResult *diffResult, pluginLevels map[string]checker.Level, level checker.Level) checker.Changes {
+	if len(pluginList) == 0 {
+		return changes
+	}
+
+	result := changes
+	for _, change := range plugins.RunAll(pluginList, diffResult.diffReport, diffResult.specInfoPair) {
+		if customChange, ok := change.(checker.CustomChange); ok {
+			if customLevel, ok := pluginLevels[customChange.Id]; ok {
+				customChange.Level = customLevel
+				change = customChange
+			}
+		}
+		if change.GetLevel() >= level {
+			result = append(result, change)
+		}
+	}
+
+	sort.Sort(result)
+	return result
+}
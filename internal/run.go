@@ -27,7 +27,9 @@ func Run(args []string, stdout io.Writer, stderr io.Writer) int {
 		getChangelogCmd(),
 		getFlattenCmd(),
 		getChecksCmd(),
+		getLintCmd(),
 		getQRCodeCmd(),
+		getSchemaCmd(),
 	)
 
 	return run(rootCmd)
@@ -12,6 +12,7 @@ import (
 	"github.com/oasdiff/oasdiff/checker"
 	"github.com/oasdiff/oasdiff/formatters"
 	"github.com/oasdiff/oasdiff/internal"
+	"github.com/oasdiff/oasdiff/overlay"
 	"github.com/stretchr/testify/require"
 	"gopkg.in/yaml.v3"
 )
@@ -69,6 +70,67 @@ func Test_DiffText(t *testing.T) {
 	require.Contains(t, stdout.String(), `### New Endpoints: None`)
 }
 
+func Test_DiffJsonPatch(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff diff ../data/openapi-test1.yaml ../data/openapi-test3.yaml -f jsonpatch"), &stdout, io.Discard))
+	var patch []map[string]any
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &patch))
+	require.NotEmpty(t, patch)
+	require.Contains(t, patch[0], "op")
+	require.Contains(t, patch[0], "path")
+}
+
+func Test_DiffJsonPatchFailOnDiff(t *testing.T) {
+	require.Equal(t, 1, internal.Run(cmdToArgs("oasdiff diff ../data/openapi-test1.yaml ../data/openapi-test3.yaml -f jsonpatch --fail-on-diff"), io.Discard, io.Discard))
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff diff ../data/openapi-test1.yaml ../data/openapi-test1.yaml -f jsonpatch --fail-on-diff"), io.Discard, io.Discard))
+}
+
+func Test_DiffJsonPatchUnsupportedFlags(t *testing.T) {
+	for flag, message := range map[string]string{
+		"--match-path /api":                          "--match-path is not supported by the jsonpatch format",
+		"--exclude-elements description":             "--exclude-elements is not supported by the jsonpatch format",
+		"--flatten-allof":                            "--flatten-allof is not supported by the jsonpatch format",
+		"--overlay-base ../data/overlay/public.yaml": "--overlay-base and --overlay-revision are not supported by the jsonpatch format",
+	} {
+		var stderr bytes.Buffer
+		require.Equal(t, 101, internal.Run(cmdToArgs("oasdiff diff ../data/openapi-test1.yaml ../data/openapi-test3.yaml -f jsonpatch "+flag), io.Discard, &stderr), flag)
+		require.Contains(t, stderr.String(), message)
+	}
+}
+
+func Test_DiffOverlayUnsupportedFlags(t *testing.T) {
+	var stderr bytes.Buffer
+	require.Equal(t, 101, internal.Run(cmdToArgs("oasdiff diff ../data/overlay/base.yaml ../data/overlay/revision.yaml -f overlay --match-path /api"), io.Discard, &stderr))
+	require.Contains(t, stderr.String(), "--match-path is not supported by the overlay format")
+}
+
+func Test_DiffJsonPatchComposed(t *testing.T) {
+	var stderr bytes.Buffer
+	require.Equal(t, 101, internal.Run(cmdToArgs("oasdiff diff ../data/composed/base/*.yaml ../data/composed/revision/*.yaml --composed -f jsonpatch"), io.Discard, &stderr))
+	require.Contains(t, stderr.String(), "jsonpatch format is not supported in composed mode")
+}
+
+func Test_DiffOverlay(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff diff ../data/overlay/base.yaml ../data/overlay/revision.yaml -f overlay"), &stdout, io.Discard))
+	o, err := overlay.Parse(stdout.Bytes())
+	require.NoError(t, err)
+	require.NotEmpty(t, o.Actions)
+}
+
+func Test_DiffWithOverlays(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff diff ../data/overlay/base.yaml ../data/overlay/revision.yaml --overlay-base ../data/overlay/public.yaml --exclude-elements endpoints"), &stdout, io.Discard))
+	require.NotContains(t, stdout.String(), "/admin/pets")
+	require.NotContains(t, stdout.String(), "x-internal-notes")
+}
+
+func Test_DiffWithInvalidOverlay(t *testing.T) {
+	var stderr bytes.Buffer
+	require.Equal(t, 102, internal.Run(cmdToArgs("oasdiff diff ../data/overlay/base.yaml ../data/overlay/revision.yaml --overlay-revision ../data/overlay/invalid.yaml"), io.Discard, &stderr))
+	require.Contains(t, stderr.String(), `failed to load overlay "../data/overlay/invalid.yaml"`)
+}
+
 func Test_Summary(t *testing.T) {
 	var stdout bytes.Buffer
 	require.Zero(t, internal.Run(cmdToArgs("oasdiff summary ../data/openapi-test1.yaml ../data/openapi-test3.yaml"), &stdout, io.Discard))
@@ -167,7 +229,7 @@ func Test_BreakingChangesIgnoreErrs(t *testing.T) {
 	require.Zero(t, internal.Run(cmdToArgs("oasdiff breaking ../data/openapi-test1.yaml ../data/openapi-test3.yaml --err-ignore ../data/ignore-err-example.txt --format json"), &stdout, io.Discard))
 	bc := formatters.Changes{}
 	require.NoError(t, json.Unmarshal(stdout.Bytes(), &bc))
-	require.Len(t, bc, 5)
+	require.Len(t, bc, 6)
 }
 
 func Test_BreakingChangesIgnoreErrsAndWarns(t *testing.T) {
@@ -175,7 +237,7 @@ func Test_BreakingChangesIgnoreErrsAndWarns(t *testing.T) {
 	require.Zero(t, internal.Run(cmdToArgs("oasdiff breaking ../data/openapi-test1.yaml ../data/openapi-test3.yaml --err-ignore ../data/ignore-err-example.txt --warn-ignore ../data/ignore-warn-example.txt --format json"), &stdout, io.Discard))
 	bc := formatters.Changes{}
 	require.NoError(t, json.Unmarshal(stdout.Bytes(), &bc))
-	require.Len(t, bc, 4)
+	require.Len(t, bc, 5)
 }
 
 func Test_BreakingChangesIgnoreErrsApiSchemaOptional(t *testing.T) {
@@ -183,7 +245,7 @@ func Test_BreakingChangesIgnoreErrsApiSchemaOptional(t *testing.T) {
 	require.Zero(t, internal.Run(cmdToArgs("oasdiff breaking ../data/openapi-test1.yaml ../data/openapi-test3.yaml --err-ignore ../data/ignore-err-example.txt --warn-ignore ../data/ignore-warn-example.txt --include-checks api-schema-removed --format json"), &stdout, io.Discard))
 	bc := formatters.Changes{}
 	require.NoError(t, json.Unmarshal(stdout.Bytes(), &bc))
-	require.Len(t, bc, 4)
+	require.Len(t, bc, 5)
 }
 
 func Test_BreakingChangesInvalidIgnoreFile(t *testing.T) {
@@ -242,12 +304,18 @@ func Test_Changelog(t *testing.T) {
 	require.Len(t, cl, 1)
 }
 
+func Test_ChangelogCSVWithAttributes(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff changelog ../data/openapi-test1.yaml ../data/openapi-test3.yaml --attributes x-no-such-extension,x-beta -f csv"), &stdout, io.Discard))
+	require.True(t, strings.HasPrefix(stdout.String(), "id,level,section,method,path,operationId,source,text,comment,x-no-such-extension,x-beta\n"))
+}
+
 func Test_ChangelogWithAttributes(t *testing.T) {
 	var stdout bytes.Buffer
 	require.Zero(t, internal.Run(cmdToArgs("oasdiff changelog ../data/openapi-test1.yaml ../data/openapi-test3.yaml --attributes x-beta,x-extension-test -f yaml"), &stdout, io.Discard))
 	cl := formatters.Changes{}
 	require.NoError(t, yaml.Unmarshal(stdout.Bytes(), &cl))
-	require.Len(t, cl, 21)
+	require.Len(t, cl, 22)
 	require.Equal(t, map[string]interface{}{"x-beta": true, "x-extension-test": interface{}(nil)}, cl[12].Attributes)
 }
 
@@ -306,10 +374,201 @@ func Test_FlattenCmdInvalid(t *testing.T) {
 `, stderr.String())
 }
 
+func Test_FlattenCmdModes(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff flatten ../data/flatten/bundle/openapi.yaml --mode bundle,deref"), &stdout, io.Discard))
+	require.NotContains(t, stdout.String(), `"$ref"`)
+	require.Contains(t, stdout.String(), `"pets_Pet"`)
+}
+
+func Test_FlattenCmdDiscriminator(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff flatten ../data/flatten/discriminator.yaml --mode discriminator,allof -f yaml"), &stdout, io.Discard))
+	require.NotContains(t, stdout.String(), "discriminator:")
+	require.NotContains(t, stdout.String(), "allOf:")
+	require.Contains(t, stdout.String(), "- kitten")
+}
+
+func Test_FlattenCmdInvalidMode(t *testing.T) {
+	var stderr bytes.Buffer
+	require.Equal(t, 100, internal.Run(cmdToArgs("oasdiff flatten ../data/flatten/hoist.yaml --mode inline"), io.Discard, &stderr))
+	require.Contains(t, stderr.String(), `invalid argument "inline" for "--mode" flag`)
+}
+
 func Test_Checks(t *testing.T) {
 	require.Zero(t, internal.Run(cmdToArgs("oasdiff checks -l ru --tags decrease,parameters --severity info,warn,error"), io.Discard, io.Discard))
 }
 
+func Test_ChecksLint(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff checks --lint --severity warn"), &stdout, io.Discard))
+	require.Equal(t, `ID                             DESCRIPTION                                                     LEVEL   DIRECTION LOCATION ACTION
+ambiguous-endpoints            endpoints may match the same request                            warning
+path-param-duplicate           path parameter is defined both in the path and in the operation warning
+path-param-missing             path parameter appears in the URL path but isn't defined        warning
+required-param-with-default    required parameter has a default value                          warning
+required-property-with-default required property has a default value                           warning
+style-collection-not-plural    collection name isn't plural                                    warning
+style-error-schema             error response doesn't use the shared error schema              warning
+style-operation-id-duplicate   operationId is used by more than one operation                  warning
+style-operation-id-missing     operation doesn't have an operationId                           warning
+style-operation-tag-missing    operation doesn't have a tag                                    warning
+style-path-casing              path segment doesn't follow the casing convention               warning
+style-path-trailing-slash      path ends with a slash                                          warning
+style-property-casing          property name doesn't follow the casing convention              warning
+style-tag-undeclared           operation tag isn't declared in the tags of the spec            warning
+
+`, stdout.String())
+}
+
+func Test_Lint(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Equal(t, 1, internal.Run(cmdToArgs("oasdiff lint ../data/lint/changed/base.yaml --format json --fail-on WARN"), &stdout, io.Discard))
+	var errs []map[string]any
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &errs))
+	require.Len(t, errs, 2)
+	require.Equal(t, "schema-default-invalid", errs[0]["id"])
+	require.Equal(t, "required-param-with-default", errs[1]["id"])
+}
+
+func Test_LintChanged(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff lint ../data/lint/changed/base.yaml ../data/lint/changed/revision.yaml --format json --level ERR"), &stdout, io.Discard))
+	var errs []map[string]any
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &errs))
+	require.Len(t, errs, 2)
+	require.Equal(t, "/pets", errs[0]["path"])
+	require.Equal(t, "/owners", errs[1]["path"])
+}
+
+func Test_BreakingLint(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff breaking ../data/lint/changed/base.yaml ../data/lint/changed/revision.yaml --lint --format json"), &stdout, io.Discard))
+	var errs []map[string]any
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &errs))
+	require.Len(t, errs, 3)
+	require.Equal(t, "parameter-default-invalid", errs[0]["id"])
+}
+
+func Test_LintSeverityLevels(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Equal(t, 1, internal.Run(cmdToArgs("oasdiff lint ../data/lint/changed/base.yaml --severity-levels ../data/lint/changed/severity-levels.txt --format json --fail-on ERR"), &stdout, io.Discard))
+	var errs []map[string]any
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &errs))
+	require.Len(t, errs, 1)
+	require.Equal(t, "required-param-with-default", errs[0]["id"])
+	require.Equal(t, float64(checker.ERR), errs[0]["level"])
+}
+
+func Test_LintInvalidSeverityLevels(t *testing.T) {
+	require.Equal(t, 106, internal.Run(cmdToArgs("oasdiff lint ../data/lint/changed/base.yaml --severity-levels ../data/severity-levels.txt"), io.Discard, io.Discard))
+}
+
+func Test_LintIgnore(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff lint ../data/lint/changed/base.yaml --warn-ignore ../data/lint/changed/ignore-warn.txt --format json"), &stdout, io.Discard))
+	var errs []map[string]any
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &errs))
+	require.Len(t, errs, 1)
+	require.Equal(t, "schema-default-invalid", errs[0]["id"])
+}
+
+func Test_BreakingLintSeverityLevels(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff breaking ../data/lint/changed/base.yaml ../data/lint/changed/revision.yaml --lint --lint-severity-levels ../data/lint/changed/severity-levels.txt --format json"), &stdout, io.Discard))
+	var errs []map[string]any
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &errs))
+	require.Len(t, errs, 2)
+	require.Equal(t, "parameter-default-invalid", errs[0]["id"])
+	require.Equal(t, "required-param-with-default", errs[1]["id"])
+	require.Equal(t, float64(checker.ERR), errs[1]["level"])
+}
+
+func Test_LintStyle(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff lint ../data/lint/style/invalid.yaml --lint-style --format json"), &stdout, io.Discard))
+	var errs []map[string]any
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &errs))
+	require.NotEmpty(t, errs)
+	require.Equal(t, "style-collection-not-plural", errs[0]["id"])
+}
+
+func Test_LintStyleOptions(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff lint ../data/lint/style/invalid.yaml --lint-style-options ../data/lint/style/options.yaml --format json"), &stdout, io.Discard))
+	require.Contains(t, stdout.String(), "#/components/schemas/Problem")
+}
+
+func Test_LintInvalidStyleOptions(t *testing.T) {
+	require.Equal(t, 127, internal.Run(cmdToArgs("oasdiff lint ../data/lint/changed/base.yaml --lint-style-options ../data/lint/style/no-such-options.yaml"), io.Discard, io.Discard))
+}
+
+func Test_LintOverlay(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff lint ../data/lint/changed/base.yaml --overlay-revision ../data/lint/changed/overlay.yaml --format json"), &stdout, io.Discard))
+	var errs []map[string]any
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &errs))
+	require.Len(t, errs, 1)
+	require.Equal(t, "schema-default-invalid", errs[0]["id"])
+}
+
+func Test_LintOverlayStructure(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff lint ../data/lint/structure/duplicate-required.yaml --format json"), &stdout, io.Discard))
+	require.Contains(t, stdout.String(), "duplicate-required-property")
+
+	// the overlay removes the duplicate and the raw document checks lint the overlaid spec
+	stdout.Reset()
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff lint ../data/lint/structure/duplicate-required.yaml --overlay-revision ../data/lint/structure/overlay.yaml --format json"), &stdout, io.Discard))
+	require.NotContains(t, stdout.String(), "duplicate-required-property")
+}
+
+func Test_LintDiffFlags(t *testing.T) {
+	var stderr bytes.Buffer
+	require.Equal(t, 101, internal.Run(cmdToArgs("oasdiff lint ../data/lint/changed/base.yaml --match-path /pets"), io.Discard, &stderr))
+	require.Equal(t, "Error: --match-path is only supported with base and revision\n", stderr.String())
+}
+
+func Test_LintComposed(t *testing.T) {
+	var stderr bytes.Buffer
+	require.Equal(t, 101, internal.Run(cmdToArgs("oasdiff breaking ../data/allof/simple.yaml ../data/allof/revision.yaml --composed --lint"), io.Discard, &stderr))
+	require.Equal(t, "Error: lint is not supported in composed mode\n", stderr.String())
+}
+
+func Test_LintInvalidRuleset(t *testing.T) {
+	require.Equal(t, 125, internal.Run(cmdToArgs("oasdiff lint ../data/lint/changed/base.yaml --lint-ruleset ../data/lint/no-such-ruleset.yaml"), io.Discard, io.Discard))
+}
+
+func Test_LintFixDryRun(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff lint ../data/lint/fix/openapi.yaml --fix=dry-run"), &stdout, io.Discard))
+	require.Contains(t, stdout.String(), "--- ../data/lint/fix/openapi.yaml\n+++ ../data/lint/fix/openapi.yaml (fixed)\n")
+	require.Contains(t, stdout.String(), "+      operationId: getPets\n")
+}
+
+func Test_LintFix(t *testing.T) {
+	data, err := os.ReadFile("../data/lint/fix/openapi.yaml")
+	require.NoError(t, err)
+	file := filepath.Join(t.TempDir(), "openapi.yaml")
+	require.NoError(t, os.WriteFile(file, data, 0644))
+
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff lint "+file+" --fix --format json"), &stdout, io.Discard))
+	var errs []map[string]any
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &errs))
+	require.Empty(t, errs)
+
+	fixed, err := os.ReadFile(file)
+	require.NoError(t, err)
+	require.Contains(t, string(fixed), "operationId: getPets\n")
+}
+
+func Test_LintFixBaseAndRevision(t *testing.T) {
+	var stderr bytes.Buffer
+	require.Equal(t, 101, internal.Run(cmdToArgs("oasdiff lint ../data/lint/changed/base.yaml ../data/lint/changed/revision.yaml --fix"), io.Discard, &stderr))
+	require.Equal(t, "Error: fix is not supported with base and revision\n", stderr.String())
+}
+
 func Test_Color(t *testing.T) {
 	require.Zero(t, internal.Run(cmdToArgs("oasdiff breaking ../data/allof/simple.yaml ../data/allof/revision.yaml --color always"), io.Discard, io.Discard))
 }
@@ -324,6 +583,42 @@ func Test_QR(t *testing.T) {
 	require.Zero(t, internal.Run(cmdToArgs("oasdiff qr"), io.Discard, io.Discard))
 }
 
+func Test_Schema(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff schema changelog"), &stdout, io.Discard))
+	var schema map[string]any
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &schema))
+	require.Equal(t, "https://raw.githubusercontent.com/oasdiff/oasdiff/main/schemas/"+formatters.SchemaVersion+"/changelog.json", schema["$id"])
+}
+
+func Test_SchemaInvalidOutput(t *testing.T) {
+	var stderr bytes.Buffer
+	require.Equal(t, 100, internal.Run(cmdToArgs("oasdiff schema flatten"), io.Discard, &stderr))
+	require.Contains(t, stderr.String(), `Error: invalid argument "flatten" for "oasdiff schema"`)
+}
+
+func Test_ChangelogJsonMatchesSchema(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff changelog ../data/openapi-test1.yaml ../data/openapi-test3.yaml -f json"), &stdout, io.Discard))
+	var changes []map[string]any
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &changes))
+	require.NotEmpty(t, changes)
+
+	var schema struct {
+		Defs map[string]struct {
+			Properties map[string]any `json:"properties"`
+		} `json:"$defs"`
+	}
+	var schemaOut bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff schema changelog"), &schemaOut, io.Discard))
+	require.NoError(t, json.Unmarshal(schemaOut.Bytes(), &schema))
+	for _, change := range changes {
+		for key := range change {
+			require.Contains(t, schema.Defs["Change"].Properties, key)
+		}
+	}
+}
+
 func Test_InvalidEnumValue(t *testing.T) {
 	var stderr bytes.Buffer
 	require.Equal(t, 100, internal.Run(cmdToArgs("oasdiff diff ../data/openapi-test1.yaml ../data/openapi-test3.yaml --exclude-elements xxx"), io.Discard, &stderr))
@@ -402,3 +697,112 @@ func Test_Changelog_WithInvalidCustomTemplate(t *testing.T) {
 	require.Equal(t, 105, internal.Run(cmdToArgs("oasdiff changelog ../data/run_test/changelog_base.yaml ../data/run_test/changelog_revision.yaml --format markdown --template /nonexistent/template.md"), io.Discard, &stderr))
 	require.Contains(t, stderr.String(), "failed to load custom template")
 }
+
+func Test_ChangelogPRComment(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff changelog ../data/run_test/changelog_base.yaml ../data/run_test/changelog_revision.yaml -f pr-comment --group-by tag --max-size 1000"), &stdout, io.Discard))
+
+	result := stdout.String()
+	require.True(t, strings.HasPrefix(result, formatters.PRCommentMarker))
+	require.Contains(t, result, "<summary>untagged (")
+	require.LessOrEqual(t, len(strings.TrimSuffix(result, "\n")), 1000)
+}
+
+func Test_ChangelogPRCommentInvalidGroupBy(t *testing.T) {
+	var stderr bytes.Buffer
+	require.Equal(t, 100, internal.Run(cmdToArgs("oasdiff changelog ../data/run_test/changelog_base.yaml ../data/run_test/changelog_revision.yaml -f pr-comment --group-by xxx"), io.Discard, &stderr))
+	require.Contains(t, stderr.String(), `invalid argument "xxx" for "--group-by" flag`)
+}
+
+func Test_ChangelogPrependTo(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "CHANGELOG.md")
+	require.NoError(t, os.WriteFile(file, []byte("# Changelog\n\n## [0.0.1] - 2020-01-01\n\n### Added\n- first release\n"), 0644))
+
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff changelog ../data/run_test/changelog_base.yaml ../data/run_test/changelog_revision.yaml -f keepachangelog --release-date 2024-05-01 --prepend-to "+file), &stdout, io.Discard))
+	require.Empty(t, stdout.String())
+
+	changelog, err := os.ReadFile(file)
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(string(changelog), "# Changelog\n\n## [1.0] - 2024-05-01\n\n### "))
+	require.True(t, strings.HasSuffix(string(changelog), "\n## [0.0.1] - 2020-01-01\n\n### Added\n- first release\n"))
+}
+
+func Test_ChangelogPrependToInvalidFormat(t *testing.T) {
+	var stderr bytes.Buffer
+	require.Equal(t, 101, internal.Run(cmdToArgs("oasdiff changelog ../data/run_test/changelog_base.yaml ../data/run_test/changelog_revision.yaml -f markdown --prepend-to CHANGELOG.md"), io.Discard, &stderr))
+	require.Contains(t, stderr.String(), "--prepend-to is only supported by the keepachangelog and conventional-commits formats")
+}
+
+func Test_ChangelogReleaseDateInvalid(t *testing.T) {
+	var stderr bytes.Buffer
+	require.Equal(t, 101, internal.Run(cmdToArgs("oasdiff changelog ../data/run_test/changelog_base.yaml ../data/run_test/changelog_revision.yaml -f keepachangelog --release-date 01/05/2024"), io.Discard, &stderr))
+	require.Contains(t, stderr.String(), `invalid --release-date "01/05/2024", expected YYYY-MM-DD`)
+}
+
+func Test_ChangelogReleaseDateInvalidFormat(t *testing.T) {
+	var stderr bytes.Buffer
+	require.Equal(t, 101, internal.Run(cmdToArgs("oasdiff changelog ../data/run_test/changelog_base.yaml ../data/run_test/changelog_revision.yaml -f markdown --release-date 2024-05-01"), io.Discard, &stderr))
+	require.Contains(t, stderr.String(), "--release-date is only supported by the keepachangelog and conventional-commits formats")
+}
+
+func Test_BreakingChangesTraffic(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff breaking ../data/traffic/base.yaml ../data/traffic/revision.yaml --traffic ../data/traffic/traffic.jsonl --format json"), &stdout, io.Discard))
+	bc := formatters.Changes{}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &bc))
+	require.Len(t, bc, 4)
+	for _, c := range bc {
+		require.Contains(t, c.Attributes, "usage")
+	}
+}
+
+func Test_BreakingChangesTrafficDowngrade(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff breaking ../data/traffic/base.yaml ../data/traffic/revision.yaml --traffic ../data/traffic/traffic.jsonl --traffic-downgrade --format json"), &stdout, io.Discard))
+	bc := formatters.Changes{}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &bc))
+	require.Len(t, bc, 2)
+	for _, c := range bc {
+		require.NotZero(t, c.Attributes["usage"].(map[string]any)["requests"])
+	}
+}
+
+func Test_BreakingChangesTrafficMissingFile(t *testing.T) {
+	require.Equal(t, 122, internal.Run(cmdToArgs("oasdiff breaking ../data/traffic/base.yaml ../data/traffic/revision.yaml --traffic ../data/traffic/missing.jsonl"), io.Discard, io.Discard))
+}
+
+func Test_BreakingChangesTrafficComposed(t *testing.T) {
+	require.Equal(t, 101, internal.Run(cmdToArgs("oasdiff breaking -c ../data/traffic/base.yaml ../data/traffic/revision.yaml --traffic ../data/traffic/traffic.jsonl"), io.Discard, io.Discard))
+}
+
+func Test_BreakingChangesCustomRules(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff breaking ../data/custom-rules/base.yaml ../data/custom-rules/revision.yaml --custom-rules ../data/custom-rules/rules.yaml --severity-levels ../data/custom-rules/severity-levels.txt --format json"), &stdout, io.Discard))
+	bc := formatters.Changes{}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &bc))
+	require.Len(t, bc, 2)
+	require.Equal(t, "v1-response-property-added", bc[1].Id)
+	require.Equal(t, checker.ERR, bc[1].Level)
+	require.Equal(t, "added the response property age to a frozen v1 endpoint", bc[1].Text)
+}
+
+func Test_BreakingChangesCustomRulesIgnore(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff breaking ../data/custom-rules/base.yaml ../data/custom-rules/revision.yaml --custom-rules ../data/custom-rules/rules.yaml --severity-levels ../data/custom-rules/severity-levels.txt --err-ignore ../data/custom-rules/ignore-err.txt --format json"), &stdout, io.Discard))
+	bc := formatters.Changes{}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &bc))
+	require.Len(t, bc, 1)
+	require.Equal(t, "api-path-removed-without-deprecation", bc[0].Id)
+}
+
+func Test_ChangelogCustomRules(t *testing.T) {
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff changelog ../data/custom-rules/base.yaml ../data/custom-rules/revision.yaml --custom-rules ../data/custom-rules/rules.yaml --format markdown"), &stdout, io.Discard))
+	require.Contains(t, stdout.String(), "added the response property age to a frozen v1 endpoint")
+	require.Contains(t, stdout.String(), "internal endpoint GET /health changed")
+}
+
+func Test_BreakingChangesInvalidCustomRules(t *testing.T) {
+	require.Equal(t, 123, internal.Run(cmdToArgs("oasdiff breaking ../data/custom-rules/base.yaml ../data/custom-rules/revision.yaml --custom-rules ../data/custom-rules/invalid-rules.yaml"), io.Discard, io.Discard))
+}
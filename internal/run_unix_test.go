@@ -4,10 +4,13 @@ package internal_test
 
 import (
 	"bytes"
+	"encoding/json"
 	"io"
 	"testing"
 
+	"github.com/oasdiff/oasdiff/formatters"
 	"github.com/oasdiff/oasdiff/internal"
+	"github.com/oasdiff/oasdiff/plugins"
 	"github.com/stretchr/testify/require"
 )
 
@@ -24,3 +27,44 @@ func Test_ComposedModeInvalidFileUnix(t *testing.T) {
 	require.Equal(t, `Error: failed to load base specs from glob "../data/allof/*": failed to flatten allOf in "../data/allof/invalid.yaml": unable to resolve Type conflict: all Type values must be identical
 `, stderr.String())
 }
+
+func Test_BreakingChangesPluginsUnix(t *testing.T) {
+	// the plugins are listed in data/plugins/oasdiff.yaml
+	t.Chdir("../data/plugins")
+
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff breaking ../custom-rules/base.yaml ../custom-rules/revision.yaml --format json --run-plugins"), &stdout, io.Discard))
+	bc := formatters.Changes{}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &bc))
+	require.Len(t, bc, 3)
+	require.Equal(t, "api-path-removed-without-deprecation", bc[0].Id)
+	require.Equal(t, plugins.PluginFailedId, bc[1].Id)
+	require.Equal(t, `plugin "fail" failed: exit status 2: something went wrong`, bc[1].Text)
+	require.Equal(t, "v1-endpoint-changed", bc[2].Id)
+	require.Equal(t, "api-guidelines", bc[2].Attributes[plugins.PluginAttribute])
+}
+
+func Test_BreakingChangesPluginsNotEnabledUnix(t *testing.T) {
+	// the plugins listed in data/plugins/oasdiff.yaml don't run without --run-plugins
+	t.Chdir("../data/plugins")
+
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgs("oasdiff breaking ../custom-rules/base.yaml ../custom-rules/revision.yaml --format json"), &stdout, io.Discard))
+	bc := formatters.Changes{}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &bc))
+	require.Len(t, bc, 1)
+	require.Equal(t, "api-path-removed-without-deprecation", bc[0].Id)
+}
+
+func Test_BreakingChangesPluginsSeverityLevelsUnix(t *testing.T) {
+	t.Chdir("../data/plugins")
+
+	var stdout bytes.Buffer
+	require.Equal(t, 1, internal.Run(cmdToArgs("oasdiff breaking ../custom-rules/base.yaml ../custom-rules/revision.yaml --format json --run-plugins --severity-levels severity-levels.txt --fail-on ERR"), &stdout, io.Discard))
+	bc := formatters.Changes{}
+	require.NoError(t, json.Unmarshal(stdout.Bytes(), &bc))
+	require.Len(t, bc, 2)
+	require.Equal(t, "api-path-removed-without-deprecation", bc[0].Id)
+	require.Equal(t, "v1-endpoint-changed", bc[1].Id)
+	require.Equal(t, "error", bc[1].Level.String())
+}
@@ -0,0 +1,39 @@
+package internal
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/oasdiff/oasdiff/formatters"
+	"github.com/spf13/cobra"
+)
+
+func getSchemaCmd() *cobra.Command {
+
+	cmd := cobra.Command{
+		Use:   "schema output",
+		Short: "Display the JSON Schema of an output",
+		Long: fmt.Sprintf(`Display the JSON Schema of the json and yaml formats of an output.
+Outputs: %s.
+Schema version: %s`, strings.Join(formatters.GetSchemaOutputs(), ", "), formatters.SchemaVersion),
+		Args:      cobra.MatchAll(cobra.ExactArgs(1), cobra.OnlyValidArgs),
+		ValidArgs: formatters.GetSchemaOutputs(),
+		RunE: func(cmd *cobra.Command, args []string) error {
+
+			// by now args have been parsed successfully so we don't need to show usage on any errors
+			cmd.Root().SilenceUsage = true
+
+			schema, err := formatters.GetOutputSchema(args[0])
+			if err != nil {
+				returnErr := getErrFailedPrint("schema "+args[0], err)
+				setReturnValue(cmd, returnErr.Code)
+				return returnErr
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "%s\n", schema)
+			return nil
+		},
+	}
+
+	return &cmd
+}
@@ -25,6 +25,7 @@ func getSummaryCmd() *cobra.Command {
 	enumWithOptions(&cmd, newEnumSliceValue(diff.GetExcludeDiffOptions(), nil), "exclude-elements", "e", "elements to exclude")
 	enumWithOptions(&cmd, newEnumValue(formatters.SupportedFormatsByContentType(formatters.OutputSummary), string(formatters.FormatYAML)), "format", "f", "output format")
 	cmd.PersistentFlags().BoolP("fail-on-diff", "", false, "exit with return code 1 when any change is found")
+	addTemplateFlag(&cmd)
 
 	return &cmd
 }
@@ -36,22 +37,29 @@ func runSummary(flags *Flags, stdout io.Writer) (bool, *ReturnError) {
 		return false, err
 	}
 
-	if err := outputSummary(stdout, diffResult.diffReport, flags.getFormat()); err != nil {
+	if err := outputSummary(stdout, flags, diffResult); err != nil {
 		return false, err
 	}
 
 	return flags.getFailOnDiff() && !diffResult.diffReport.Empty(), nil
 }
 
-func outputSummary(stdout io.Writer, diffReport *diff.Diff, format string) *ReturnError {
+func outputSummary(stdout io.Writer, flags *Flags, diffResult *diffResult) *ReturnError {
+	format := flags.getFormat()
+
 	// formatter lookup
 	formatter, err := formatters.Lookup(format, formatters.DefaultFormatterOpts())
 	if err != nil {
 		return getErrUnsupportedFormat(format, summaryCmd)
 	}
 
+	// validate template usage
+	if flags.getTemplate() != "" && !formatter.SupportsTemplate() {
+		return getErrTemplateNotSupported(format)
+	}
+
 	// render
-	bytes, err := formatter.RenderSummary(diffReport, formatters.NewRenderOpts())
+	bytes, err := formatter.RenderSummary(diffResult.diffReport, getTemplateRenderOpts(flags, diffResult.specInfoPair))
 	if err != nil {
 		return getErrFailedPrint(summaryCmd+" "+format, err)
 	}
@@ -85,3 +85,29 @@ func TestTemplateValidationInChangelog(t *testing.T) {
 		})
 	}
 }
+
+func TestTemplateInDiffSummaryAndChecks(t *testing.T) {
+	tempDir := t.TempDir()
+	templatePath := filepath.Join(tempDir, "test-template.md")
+	templateContent := "{{ .Base.Version }} {{ .Revision.Version }} {{ .Summary.Diff }} {{ len .Checks }}"
+	require.NoError(t, os.WriteFile(templatePath, []byte(templateContent), 0644))
+
+	var stdout bytes.Buffer
+	require.Zero(t, internal.Run(cmdToArgsLocal("oasdiff diff ../data/version/base.yaml ../data/version/revision.yaml --format markdown --template "+templatePath), &stdout, io.Discard))
+	require.Equal(t, "0.0.0 0.0.1 true 0\n", stdout.String())
+
+	stdout.Reset()
+	require.Zero(t, internal.Run(cmdToArgsLocal("oasdiff summary ../data/version/base.yaml ../data/version/base.yaml --format html --template "+templatePath), &stdout, io.Discard))
+	require.Equal(t, "0.0.0 0.0.0 false 0\n", stdout.String())
+
+	stdout.Reset()
+	require.Zero(t, internal.Run(cmdToArgsLocal("oasdiff checks --severity error --format markdown --template "+templatePath), &stdout, io.Discard))
+	require.NotEqual(t, "  false 0\n", stdout.String())
+	require.True(t, strings.HasPrefix(stdout.String(), "  false "))
+}
+
+func TestTemplateNotSupportedInDiff(t *testing.T) {
+	var stderr bytes.Buffer
+	require.Equal(t, 111, internal.Run(cmdToArgsLocal("oasdiff diff ../data/version/base.yaml ../data/version/revision.yaml --format yaml --template template.md"), io.Discard, &stderr))
+	require.Contains(t, stderr.String(), "template flag is not supported for format \"yaml\"")
+}
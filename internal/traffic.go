@@ -0,0 +1,36 @@
+package internal
+
+import (
+	"errors"
+
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/load"
+	"github.com/oasdiff/oasdiff/traffic"
+)
+
+// annotateUsage adds the usage of each change according to the recorded traffic
+// changes that were downgraded below the requested level are removed
+func annotateUsage(flags *Flags, changes checker.Changes, specInfoPair *load.SpecInfoPair, level checker.Level) (checker.Changes, *ReturnError) {
+	files := flags.getTraffic()
+	if len(files) == 0 {
+		return changes, nil
+	}
+
+	if specInfoPair == nil {
+		return nil, getErrInvalidFlags(errors.New("traffic analysis is not supported in composed mode"))
+	}
+
+	samples, err := traffic.LoadAll(files)
+	if err != nil {
+		return nil, getErrFailedToLoadTraffic(err)
+	}
+
+	result := make(checker.Changes, 0, len(changes))
+	for _, change := range traffic.Annotate(changes, specInfoPair.Base.Spec, samples, flags.getTrafficDowngrade()) {
+		if change.GetLevel() >= level {
+			result = append(result, change)
+		}
+	}
+
+	return result, nil
+}
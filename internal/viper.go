@@ -8,6 +8,7 @@ import (
 	"github.com/oasdiff/oasdiff/checker/localizations"
 	"github.com/oasdiff/oasdiff/diff"
 	"github.com/oasdiff/oasdiff/formatters"
+	"github.com/oasdiff/oasdiff/plugins"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
@@ -80,33 +81,51 @@ func fixViperStringSlice(viperString []string) []string {
 }
 
 type Config struct {
-	Attributes             []string `mapstructure:"attributes"`
-	Composed               bool     `mapstructure:"composed"`
-	FlattenAllof           bool     `mapstructure:"flatten-allof"`
-	FlattenParams          bool     `mapstructure:"flatten-params"`
-	CaseInsensitiveHeaders bool     `mapstructure:"case-insensitive-headers"`
-	DeprecationDaysBeta    uint     `mapstructure:"deprecation-days-beta"`
-	DeprecationDaysStable  uint     `mapstructure:"deprecation-days-stable"`
-	Lang                   string   `mapstructure:"lang"`
-	Color                  string   `mapstructure:"color"`
-	WarnIgnore             string   `mapstructure:"warn-ignore"`
-	ErrIgnore              string   `mapstructure:"err-ignore"`
-	Format                 string   `mapstructure:"format"`
-	FailOn                 string   `mapstructure:"fail-on"`
-	Level                  string   `mapstructure:"level"`
-	FailOnDiff             bool     `mapstructure:"fail-on-diff"`
-	SeverityLevels         string   `mapstructure:"severity-levels"`
-	ExcludeElements        []string `mapstructure:"exclude-elements"`
-	Severity               []string `mapstructure:"severity"`
-	Tags                   []string `mapstructure:"tags"`
-	MatchPath              string   `mapstructure:"match-path"`
-	UnmatchPath            string   `mapstructure:"unmatch-path"`
-	FilterExtension        string   `mapstructure:"filter-extension"`
-	PrefixBase             string   `mapstructure:"prefix-base"`
-	PrefixRevision         string   `mapstructure:"prefix-revision"`
-	StripPrefixBase        string   `mapstructure:"strip-prefix-base"`
-	StripPrefixRevision    string   `mapstructure:"strip-prefix-revision"`
-	IncludePathParams      bool     `mapstructure:"include-path-params"`
+	Attributes             []string        `mapstructure:"attributes"`
+	Composed               bool            `mapstructure:"composed"`
+	FlattenAllof           bool            `mapstructure:"flatten-allof"`
+	FlattenParams          bool            `mapstructure:"flatten-params"`
+	CaseInsensitiveHeaders bool            `mapstructure:"case-insensitive-headers"`
+	DeprecationDaysBeta    uint            `mapstructure:"deprecation-days-beta"`
+	DeprecationDaysStable  uint            `mapstructure:"deprecation-days-stable"`
+	Lang                   string          `mapstructure:"lang"`
+	Color                  string          `mapstructure:"color"`
+	WarnIgnore             string          `mapstructure:"warn-ignore"`
+	ErrIgnore              string          `mapstructure:"err-ignore"`
+	Format                 string          `mapstructure:"format"`
+	FailOn                 string          `mapstructure:"fail-on"`
+	Level                  string          `mapstructure:"level"`
+	FailOnDiff             bool            `mapstructure:"fail-on-diff"`
+	SeverityLevels         string          `mapstructure:"severity-levels"`
+	CustomRules            string          `mapstructure:"custom-rules"`
+	ExcludeElements        []string        `mapstructure:"exclude-elements"`
+	Severity               []string        `mapstructure:"severity"`
+	Tags                   []string        `mapstructure:"tags"`
+	MatchPath              string          `mapstructure:"match-path"`
+	UnmatchPath            string          `mapstructure:"unmatch-path"`
+	FilterExtension        string          `mapstructure:"filter-extension"`
+	PrefixBase             string          `mapstructure:"prefix-base"`
+	PrefixRevision         string          `mapstructure:"prefix-revision"`
+	StripPrefixBase        string          `mapstructure:"strip-prefix-base"`
+	StripPrefixRevision    string          `mapstructure:"strip-prefix-revision"`
+	IncludePathParams      bool            `mapstructure:"include-path-params"`
+	OverlayBase            []string        `mapstructure:"overlay-base"`
+	OverlayRevision        []string        `mapstructure:"overlay-revision"`
+	Traffic                []string        `mapstructure:"traffic"`
+	TrafficDowngrade       bool            `mapstructure:"traffic-downgrade"`
+	MaxSize                int             `mapstructure:"max-size"`
+	PrependTo              string          `mapstructure:"prepend-to"`
+	ReleaseDate            string          `mapstructure:"release-date"`
+	GroupBy                string          `mapstructure:"group-by"`
+	Lint                   bool            `mapstructure:"lint"`
+	LintSeverityLevels     string          `mapstructure:"lint-severity-levels"`
+	LintRuleset            string          `mapstructure:"lint-ruleset"`
+	LintStyle              bool            `mapstructure:"lint-style"`
+	LintStyleOptions       string          `mapstructure:"lint-style-options"`
+	Fix                    string          `mapstructure:"fix"`
+	Mode                   []string        `mapstructure:"mode"`
+	Plugins                plugins.Plugins `mapstructure:"plugins"`
+	// run-plugins is deliberately missing, plugins can only be enabled on the command line
 }
 
 // validate checks that each of the provided configuration values is one of the generally accepted values
@@ -150,6 +169,18 @@ func validate(v IViper) error {
 		return err
 	}
 
+	if err := validateString(formatters.GetSupportedGroupBy(), config.GroupBy, "group-by"); err != nil {
+		return err
+	}
+
+	if err := validateString(getSupportedFixValues(), config.Fix, "fix"); err != nil {
+		return err
+	}
+
+	if err := validateStrings(getFlattenModes(), config.Mode, "mode"); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -85,7 +85,7 @@ func TestViper_InvalidFormat(t *testing.T) {
 
 	cmd := cobra.Command{}
 
-	require.EqualError(t, internal.RunViper(&cmd, v), "failed to load config file: invalid format \"invalid\", allowed values: yaml, json, text, markup, markdown, singleline, html, githubactions, junit, sarif")
+	require.EqualError(t, internal.RunViper(&cmd, v), "failed to load config file: invalid format \"invalid\", allowed values: yaml, json, text, markup, markdown, singleline, html, githubactions, junit, sarif, gitlab-codequality, gitlab-junit, checkstyle, teamcity, azuredevops, csv, tsv, jsonpatch, overlay, pr-comment, keepachangelog, conventional-commits")
 }
 
 func TestViper_InvalidFailOn(t *testing.T) {
@@ -164,3 +164,36 @@ func TestViper_InvalidFlag(t *testing.T) {
 
 	require.EqualError(t, internal.RunViper(&cmd, v), "failed to load config file: validation error: decoding failed due to the following error(s):\n\n'' has invalid keys: invalid \n")
 }
+
+func TestViper_RunPluginsNotAllowed(t *testing.T) {
+	v := NewViperMock()
+	v.SetConfigFile("config.yaml")
+	v.SetConfigType("yaml")
+	require.NoError(t, v.ReadConfig(strings.NewReader("run-plugins: true")))
+
+	cmd := cobra.Command{}
+
+	require.EqualError(t, internal.RunViper(&cmd, v), "failed to load config file: validation error: decoding failed due to the following error(s):\n\n'' has invalid keys: run-plugins \n")
+}
+
+func TestViper_LintSeverityLevels(t *testing.T) {
+	v := NewViperMock()
+	v.SetConfigFile("config.yaml")
+	v.SetConfigType("yaml")
+	require.NoError(t, v.ReadConfig(strings.NewReader("lint-severity-levels: severity-levels.txt")))
+
+	cmd := cobra.Command{}
+
+	require.Nil(t, internal.RunViper(&cmd, v))
+}
+
+func TestViper_LintStyle(t *testing.T) {
+	v := NewViperMock()
+	v.SetConfigFile("config.yaml")
+	v.SetConfigType("yaml")
+	require.NoError(t, v.ReadConfig(strings.NewReader("lint-style: true\nlint-style-options: style.yaml")))
+
+	cmd := cobra.Command{}
+
+	require.Nil(t, internal.RunViper(&cmd, v))
+}
@@ -0,0 +1,63 @@
+package lint
+
+import (
+	"strings"
+
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/load"
+)
+
+// PointerAttribute is the attribute of a lint change with the JSON Pointer of the error
+const PointerAttribute = "pointer"
+
+// ToChanges converts lint errors to changes, so that they can be rendered by the changelog formatters together with the breaking changes
+// The section of a change is the first token of the JSON Pointer of the error, like info or components
+// The path and the operation of a change are taken from the JSON Pointer of the error, if it points into an operation
+func (e Errors) ToChanges() checker.Changes {
+	result := make(checker.Changes, len(e))
+	for i, err := range e {
+		result[i] = err.toChange()
+	}
+	return result
+}
+
+func (e *Error) toChange() checker.CustomChange {
+	source := load.NewSource(e.Source)
+
+	result := checker.CustomChange{
+		Id:      e.Id,
+		Text:    e.Text,
+		Comment: e.Comment,
+		Args:    e.Args,
+		Level:   e.Level,
+		Source:  source,
+	}
+
+	if source.IsFile() {
+		result.SourceFile = e.Source
+		// the lines of changes are zero-based and zero means that the line is unknown
+		if e.Line > 0 {
+			result.SourceLine = e.Line - 1
+		}
+	}
+
+	if e.Pointer == "" {
+		return result
+	}
+
+	result.Attributes = map[string]any{PointerAttribute: e.Pointer}
+
+	// a pointer into an operation looks like /paths/~1pets/get/...
+	tokens := strings.Split(e.Pointer, "/")
+	if len(tokens) > 1 {
+		result.Section = pointerUnescaper.Replace(tokens[1])
+	}
+	if len(tokens) > 2 && tokens[1] == "paths" {
+		result.Path = pointerUnescaper.Replace(tokens[2])
+		if len(tokens) > 3 && isHTTPMethod(tokens[3]) {
+			result.Operation = strings.ToUpper(tokens[3])
+		}
+	}
+
+	return result
+}
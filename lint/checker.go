@@ -2,23 +2,63 @@ package lint
 
 import (
 	"sort"
+	"strings"
 
+	"github.com/oasdiff/oasdiff/checker"
 	"github.com/oasdiff/oasdiff/load"
 )
 
+// Deprecated: use checker.ERR and checker.WARN, the levels of lint errors are checker levels
 const (
-	LEVEL_ERROR = 0
-	LEVEL_WARN  = 1
+	LEVEL_ERROR = checker.ERR
+	LEVEL_WARN  = checker.WARN
 )
 
 type Check func(string, *load.SpecInfo) []*Error
 
+// Error is a lint finding
+// The built-in checks set the Id and the Args, and Run sets the Level from the config and the localized Text and Comment
+// Custom checks may set the Level and the Text themselves
 type Error struct {
-	Id      string `json:"id,omitempty" yaml:"id,omitempty"`
-	Text    string `json:"text,omitempty" yaml:"text,omitempty"`
-	Comment string `json:"comment,omitempty" yaml:"comment,omitempty"`
-	Level   int    `json:"level" yaml:"level"`
-	Source  string `json:"source,omitempty" yaml:"source,omitempty"`
+	Id      string        `json:"id,omitempty" yaml:"id,omitempty"`
+	Text    string        `json:"text,omitempty" yaml:"text,omitempty"`
+	Comment string        `json:"comment,omitempty" yaml:"comment,omitempty"`
+	Level   checker.Level `json:"level" yaml:"level"`
+	Source  string        `json:"source,omitempty" yaml:"source,omitempty"`
+	Pointer string        `json:"pointer,omitempty" yaml:"pointer,omitempty"`
+	Line    int           `json:"line,omitempty" yaml:"line,omitempty"`
+	Args    []any         `json:"-" yaml:"-"`
+}
+
+func newError(id, source string, args ...any) *Error {
+	return &Error{
+		Id:     id,
+		Source: source,
+		Args:   args,
+	}
+}
+
+// newErrorAt creates an error at a location in the spec, the JSON Pointer of the location is also added as the last arg of the text
+func newErrorAt(id, source string, pointer jsonPointer, args ...any) *Error {
+	result := newError(id, source, append(args, pointer)...)
+	result.Pointer = pointer.String()
+	return result
+}
+
+func (e *Error) localize(l checker.Localizer) {
+	if e.Text == "" {
+		e.Text = l(e.Id, e.Args...)
+	}
+	if e.Comment == "" {
+		if comment := l(commentId(e.Id)); comment != commentId(e.Id) {
+			e.Comment = comment
+		}
+	}
+}
+
+// MatchIgnore returns true if the line of an ignore file contains the text of the error, case-insensitive
+func (e *Error) MatchIgnore(ignoreLine string) bool {
+	return strings.Contains(strings.ToLower(ignoreLine), strings.ToLower(e.Text))
 }
 
 type Errors []*Error
@@ -32,11 +72,13 @@ func (e Errors) Less(i, j int) bool {
 
 	switch {
 	case iv.Level != jv.Level:
-		return iv.Level < jv.Level
+		return iv.Level > jv.Level
 	case iv.Source != jv.Source:
 		return iv.Source < jv.Source
 	case iv.Id != jv.Id:
 		return iv.Id < jv.Id
+	case iv.Line != jv.Line:
+		return iv.Line < jv.Line
 	case iv.Text != jv.Text:
 		return iv.Text < jv.Text
 	default:
@@ -55,9 +97,22 @@ func Run(config *Config, source string, spec *load.SpecInfo) Errors {
 		return result
 	}
 
+	l := config.Localizer
+	if l == nil {
+		l = checker.NewDefaultLocalizer()
+	}
+
 	for _, check := range config.Checks {
-		errs := check(source, spec)
-		result = append(result, errs...)
+		for _, err := range check(source, spec) {
+			if level, ok := config.LogLevels[err.Id]; ok {
+				err.Level = level
+			}
+			if err.Level == checker.NONE {
+				continue
+			}
+			err.localize(l)
+			result = append(result, err)
+		}
 	}
 
 	sort.Sort(result)
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oasdiff/oasdiff/checker"
 	"github.com/oasdiff/oasdiff/lint"
 	"github.com/oasdiff/oasdiff/load"
 	"github.com/stretchr/testify/require"
@@ -23,3 +24,18 @@ func TestRun(t *testing.T) {
 	const source = "../data/lint/openapi.yaml"
 	require.Empty(t, lint.Run(lint.DefaultConfig(), source, loadFrom(t, source)))
 }
+
+func TestRun_DeprecatedLevels(t *testing.T) {
+	customCheck := func(source string, spec *load.SpecInfo) []*lint.Error {
+		return []*lint.Error{
+			{Id: "custom-error", Text: "error", Level: lint.LEVEL_ERROR, Source: source},
+			{Id: "custom-warning", Text: "warning", Level: lint.LEVEL_WARN, Source: source},
+		}
+	}
+
+	const source = "../data/lint/openapi.yaml"
+	errs := lint.Run(lint.NewConfig([]lint.Check{customCheck}), source, loadFrom(t, source))
+	require.Len(t, errs, 2)
+	require.Equal(t, checker.ERR, errs[0].Level)
+	require.Equal(t, checker.WARN, errs[1].Level)
+}
@@ -1,24 +1,48 @@
 package lint
 
+import "github.com/oasdiff/oasdiff/checker"
+
 type Config struct {
-	Checks []Check
+	Checks    []Check
+	LogLevels map[string]checker.Level
+	Localizer checker.Localizer
 }
 
+// NewConfig creates a new configuration with the given checks and the default levels and language
 func NewConfig(checks []Check) *Config {
-	return &Config{Checks: checks}
+	return &Config{
+		Checks:    checks,
+		LogLevels: GetRuleLevels(),
+		Localizer: checker.NewDefaultLocalizer(),
+	}
 }
 
 func DefaultConfig() *Config {
-	return &Config{
-		Checks: defaultChecks(),
-	}
+	return NewConfig(GetAllChecks())
 }
 
-func defaultChecks() []Check {
-	return []Check{
-		SchemaCheck,
-		PathParamsCheck,
-		RequiredParamsCheck,
-		InfoCheck,
+// WithSeverityLevels overrides the levels of the given rules, level NONE disables a rule
+func (config *Config) WithSeverityLevels(severityLevels map[string]checker.Level) *Config {
+	for id, level := range severityLevels {
+		config.LogLevels[id] = level
 	}
+	return config
+}
+
+// WithStyle adds the rules of a style guide to the checks, see GetStyleRules
+func (config *Config) WithStyle(style *Style) *Config {
+	config.Checks = append(config.Checks, style.Check)
+	return config
+}
+
+// WithSpectralRuleset adds the rules of a Spectral ruleset to the checks
+func (config *Config) WithSpectralRuleset(ruleset *SpectralRuleset) *Config {
+	config.Checks = append(config.Checks, ruleset.Check)
+	return config
+}
+
+// WithLocalizer sets the localizer of the error texts
+func (config *Config) WithLocalizer(l checker.Localizer) *Config {
+	config.Localizer = l
+	return config
 }
@@ -0,0 +1,288 @@
+package lint
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/mail"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oasdiff/oasdiff/load"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	ParameterDefaultInvalidId = "parameter-default-invalid"
+	HeaderDefaultInvalidId    = "header-default-invalid"
+	PropertyDefaultInvalidId  = "property-default-invalid"
+	SchemaDefaultInvalidId    = "schema-default-invalid"
+	RequiredPropertyDefaultId = "required-property-with-default"
+)
+
+// DefaultsCheck validates default values against their schemas, including type, enum, min/max, pattern and format
+// It also reports required properties with a default value, since the default is never used
+// Each error includes the JSON Pointer of the default value
+func DefaultsCheck(source string, s *load.SpecInfo) []*Error {
+	result := make([]*Error, 0)
+
+	if s == nil || s.Spec == nil {
+		return result
+	}
+
+	v := newDefaultsValidator(source)
+
+	if s.Spec.Paths != nil {
+		for _, path := range s.Spec.Paths.InMatchingOrder() {
+			pathItem := s.Spec.Paths.Value(path)
+			pointer := newJSONPointer("paths", path)
+			v.checkParameters(pathItem.Parameters, pointer.add("parameters"))
+			operations := pathItem.Operations()
+			for _, method := range sortedKeys(operations) {
+				v.checkOperation(operations[method], pointer.add(strings.ToLower(method)))
+			}
+		}
+	}
+
+	if components := s.Spec.Components; components != nil {
+		pointer := newJSONPointer("components")
+		for _, name := range sortedKeys(components.Parameters) {
+			v.checkParameter(components.Parameters[name].Value, pointer.add("parameters", name))
+		}
+		for _, name := range sortedKeys(components.Headers) {
+			v.checkHeader(name, components.Headers[name].Value, pointer.add("headers", name))
+		}
+		for _, name := range sortedKeys(components.RequestBodies) {
+			if requestBody := components.RequestBodies[name].Value; requestBody != nil {
+				v.checkContent(requestBody.Content, pointer.add("requestBodies", name, "content"))
+			}
+		}
+		for _, name := range sortedKeys(components.Responses) {
+			v.checkResponse(components.Responses[name].Value, pointer.add("responses", name))
+		}
+		for _, name := range sortedKeys(components.Schemas) {
+			v.checkSchemaRef(components.Schemas[name], pointer.add("schemas", name))
+		}
+	}
+
+	return v.result
+}
+
+type defaultsValidator struct {
+	source string
+	result []*Error
+}
+
+func newDefaultsValidator(source string) *defaultsValidator {
+	return &defaultsValidator{
+		source: source,
+		result: make([]*Error, 0),
+	}
+}
+
+func (v *defaultsValidator) checkOperation(op *openapi3.Operation, pointer jsonPointer) {
+	if op == nil {
+		return
+	}
+
+	v.checkParameters(op.Parameters, pointer.add("parameters"))
+
+	// referenced request bodies and responses are checked under components
+	if op.RequestBody != nil && op.RequestBody.Ref == "" && op.RequestBody.Value != nil {
+		v.checkContent(op.RequestBody.Value.Content, pointer.add("requestBody", "content"))
+	}
+
+	if op.Responses != nil {
+		for _, status := range sortedKeys(op.Responses.Map()) {
+			if response := op.Responses.Value(status); response.Ref == "" {
+				v.checkResponse(response.Value, pointer.add("responses", status))
+			}
+		}
+	}
+}
+
+func (v *defaultsValidator) checkParameters(parameters openapi3.Parameters, pointer jsonPointer) {
+	for i, parameter := range parameters {
+		// referenced parameters are checked under components
+		if parameter == nil || parameter.Ref != "" {
+			continue
+		}
+		v.checkParameter(parameter.Value, pointer.add(fmt.Sprint(i)))
+	}
+}
+
+func (v *defaultsValidator) checkParameter(parameter *openapi3.Parameter, pointer jsonPointer) {
+	if parameter == nil {
+		return
+	}
+
+	if schema := getInlineSchema(parameter.Schema); schema != nil && schema.Default != nil {
+		if err := validateDefault(schema); err != nil {
+			v.add(ParameterDefaultInvalidId, pointer.add("schema", "default"), formatValue(schema.Default), parameter.In, parameter.Name, err)
+		}
+	}
+
+	v.checkSchemaChildren(parameter.Schema, pointer.add("schema"))
+	v.checkContent(parameter.Content, pointer.add("content"))
+}
+
+func (v *defaultsValidator) checkHeader(name string, header *openapi3.Header, pointer jsonPointer) {
+	if header == nil {
+		return
+	}
+
+	if schema := getInlineSchema(header.Schema); schema != nil && schema.Default != nil {
+		if err := validateDefault(schema); err != nil {
+			v.add(HeaderDefaultInvalidId, pointer.add("schema", "default"), formatValue(schema.Default), name, err)
+		}
+	}
+
+	v.checkSchemaChildren(header.Schema, pointer.add("schema"))
+	v.checkContent(header.Content, pointer.add("content"))
+}
+
+func (v *defaultsValidator) checkResponse(response *openapi3.Response, pointer jsonPointer) {
+	if response == nil {
+		return
+	}
+
+	for _, name := range sortedKeys(response.Headers) {
+		if header := response.Headers[name]; header.Ref == "" {
+			v.checkHeader(name, header.Value, pointer.add("headers", name))
+		}
+	}
+
+	v.checkContent(response.Content, pointer.add("content"))
+}
+
+func (v *defaultsValidator) checkContent(content openapi3.Content, pointer jsonPointer) {
+	for _, mediaTypeName := range sortedKeys(content) {
+		if mediaType := content[mediaTypeName]; mediaType != nil {
+			v.checkSchemaRef(mediaType.Schema, pointer.add(mediaTypeName, "schema"))
+		}
+	}
+}
+
+// checkSchemaRef checks the default of an inline schema and of its subschemas
+func (v *defaultsValidator) checkSchemaRef(schemaRef *openapi3.SchemaRef, pointer jsonPointer) {
+	schema := getInlineSchema(schemaRef)
+	if schema == nil {
+		return
+	}
+
+	if schema.Default != nil {
+		if err := validateDefault(schema); err != nil {
+			v.add(SchemaDefaultInvalidId, pointer.add("default"), formatValue(schema.Default), err)
+		}
+	}
+
+	v.checkSchemaChildren(schemaRef, pointer)
+}
+
+// checkSchemaChildren checks the defaults of the properties and the other subschemas of an inline schema
+func (v *defaultsValidator) checkSchemaChildren(schemaRef *openapi3.SchemaRef, pointer jsonPointer) {
+	schema := getInlineSchema(schemaRef)
+	if schema == nil {
+		return
+	}
+
+	for _, name := range sortedKeys(schema.Properties) {
+		v.checkProperty(name, slices.Contains(schema.Required, name), schema.Properties[name], pointer.add("properties", name))
+	}
+
+	v.checkSchemaRef(schema.Items, pointer.add("items"))
+	v.checkSchemaRef(schema.AdditionalProperties.Schema, pointer.add("additionalProperties"))
+	v.checkSchemaRef(schema.Not, pointer.add("not"))
+	for i, subSchema := range schema.OneOf {
+		v.checkSchemaRef(subSchema, pointer.add("oneOf", fmt.Sprint(i)))
+	}
+	for i, subSchema := range schema.AnyOf {
+		v.checkSchemaRef(subSchema, pointer.add("anyOf", fmt.Sprint(i)))
+	}
+	for i, subSchema := range schema.AllOf {
+		v.checkSchemaRef(subSchema, pointer.add("allOf", fmt.Sprint(i)))
+	}
+}
+
+func (v *defaultsValidator) checkProperty(name string, required bool, schemaRef *openapi3.SchemaRef, pointer jsonPointer) {
+	schema := getInlineSchema(schemaRef)
+	if schema == nil {
+		return
+	}
+
+	if schema.Default != nil {
+		if required {
+			v.add(RequiredPropertyDefaultId, pointer.add("default"), name)
+		}
+		if err := validateDefault(schema); err != nil {
+			v.add(PropertyDefaultInvalidId, pointer.add("default"), formatValue(schema.Default), name, err)
+		}
+	}
+
+	v.checkSchemaChildren(schemaRef, pointer)
+}
+
+// add reports an error at the JSON Pointer of the default value
+func (v *defaultsValidator) add(id string, pointer jsonPointer, args ...any) {
+	v.result = append(v.result, newErrorAt(id, v.source, pointer, args...))
+}
+
+// getInlineSchema returns the value of a schema that isn't a reference, referenced schemas are checked under components
+func getInlineSchema(schemaRef *openapi3.SchemaRef) *openapi3.Schema {
+	if schemaRef == nil || schemaRef.Ref != "" {
+		return nil
+	}
+	return schemaRef.Value
+}
+
+func formatValue(value any) string {
+	result, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(result)
+}
+
+// validateDefault validates the default value of a schema against the schema
+// In addition to the formats that are validated by kin-openapi, it validates some common string formats
+func validateDefault(schema *openapi3.Schema) error {
+	if err := validateValue(schema, schema.Default); err != nil {
+		return err
+	}
+
+	if value, ok := schema.Default.(string); ok {
+		return validateStringFormat(schema.Format, value)
+	}
+
+	return nil
+}
+
+var uuidRegexp = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+
+func validateStringFormat(format, value string) error {
+	valid := true
+	switch format {
+	case "email":
+		_, err := mail.ParseAddress(value)
+		valid = err == nil
+	case "uuid":
+		valid = uuidRegexp.MatchString(value)
+	case "uri", "url":
+		u, err := url.Parse(value)
+		valid = err == nil && u.IsAbs()
+	case "ipv4":
+		ip := net.ParseIP(value)
+		valid = ip != nil && ip.To4() != nil
+	case "ipv6":
+		ip := net.ParseIP(value)
+		valid = ip != nil && ip.To4() == nil
+	}
+
+	if !valid {
+		return errors.New("value doesn't match the format " + format)
+	}
+	return nil
+}
@@ -0,0 +1,53 @@
+package lint_test
+
+import (
+	"testing"
+
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/lint"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaults_Valid(t *testing.T) {
+
+	const source = "../data/lint/defaults/valid.yaml"
+	require.Empty(t, lint.Run(lint.NewConfig([]lint.Check{lint.DefaultsCheck}), source, loadFrom(t, source)))
+}
+
+func TestDefaults_Invalid(t *testing.T) {
+
+	const source = "../data/lint/defaults/invalid.yaml"
+	errs := lint.Run(lint.NewConfig([]lint.Check{lint.DefaultsCheck}), source, loadFrom(t, source))
+
+	texts := make([]string, len(errs))
+	for i, err := range errs {
+		require.Equal(t, source, err.Source)
+		texts[i] = err.Level.String() + " " + err.Id + ": " + err.Text
+	}
+
+	require.ElementsMatch(t, []string{
+		`error parameter-default-invalid: default value 1000 of the query parameter "limit" doesn't match its schema: number must be at most 100: /paths/~1pets/get/parameters/0/schema/default`,
+		`error parameter-default-invalid: default value "pending" of the query parameter "status" doesn't match its schema: value is not one of the allowed values ["available","sold"]: /components/parameters/status/schema/default`,
+		`error header-default-invalid: default value "unlimited" of the header "X-Rate-Limit" doesn't match its schema: value must be an integer: /paths/~1pets/get/responses/200/headers/X-Rate-Limit/schema/default`,
+		`error schema-default-invalid: default value "rex" doesn't match its schema: value must be an object: /paths/~1pets/post/requestBody/content/application~1json/schema/default`,
+		`error property-default-invalid: default value "Dog" of the property "kind" doesn't match its schema: string doesn't match the regular expression "^[a-z]+$": /components/schemas/Pet/properties/kind/default`,
+		`error property-default-invalid: default value "nobody" of the property "owner" doesn't match its schema: value doesn't match the format email: /components/schemas/Pet/properties/owner/default`,
+		`error property-default-invalid: default value "yesterday" of the property "born" doesn't match its schema: string doesn't match the format "date" (string doesn't match pattern "^[0-9]{4}-(0[1-9]|10|11|12)-(0[1-9]|[12][0-9]|3[01])$"): /components/schemas/Pet/properties/born/default`,
+		`warning required-property-with-default: required property "name" shouldn't have a default value: /components/schemas/Pet/properties/name/default`,
+	}, texts)
+}
+
+func TestDefaults_RequiredPropertyComment(t *testing.T) {
+
+	const source = "../data/lint/defaults/invalid.yaml"
+	errs := lint.Run(lint.NewConfig([]lint.Check{lint.DefaultsCheck}).WithSeverityLevels(map[string]checker.Level{
+		lint.ParameterDefaultInvalidId: checker.NONE,
+		lint.HeaderDefaultInvalidId:    checker.NONE,
+		lint.PropertyDefaultInvalidId:  checker.NONE,
+		lint.SchemaDefaultInvalidId:    checker.NONE,
+	}), source, loadFrom(t, source))
+
+	require.Len(t, errs, 1)
+	require.Equal(t, "The default value is never used because the client must always send a value for a required property.", errs[0].Comment)
+	require.Equal(t, "/components/schemas/Pet/properties/name/default", errs[0].Pointer)
+}
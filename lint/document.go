@@ -0,0 +1,164 @@
+package lint
+
+import (
+	"encoding/json"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/oasdiff/oasdiff/load"
+	"gopkg.in/yaml.v3"
+)
+
+// readDocument reads the raw yaml or json document of a local spec file
+// The raw document keeps details that are lost when the spec is loaded, like duplicate keys and line numbers
+// It returns nil if the source isn't a local file or can't be parsed
+func readDocument(source string) *yaml.Node {
+	if load.NewSource(source).Type != load.SourceTypeFile {
+		return nil
+	}
+
+	data, err := os.ReadFile(source)
+	if err != nil {
+		return nil
+	}
+
+	var document yaml.Node
+	if err := yaml.Unmarshal(data, &document); err != nil || len(document.Content) == 0 {
+		return nil
+	}
+
+	return document.Content[0]
+}
+
+// getDocument returns the raw document of a spec
+// If the source isn't a local file, or if the spec was modified after loading it, like by an overlay or by flattening, it falls back to the json encoding of the loaded spec, which has no line numbers
+func getDocument(source string, s *load.SpecInfo) *yaml.Node {
+	if s == nil || !s.Modified {
+		if document := readDocument(source); document != nil {
+			return document
+		}
+	}
+
+	if s == nil || s.Spec == nil {
+		return nil
+	}
+
+	data, err := json.Marshal(s.Spec)
+	if err != nil {
+		return nil
+	}
+
+	var document yaml.Node
+	if err := yaml.Unmarshal(data, &document); err != nil || len(document.Content) == 0 {
+		return nil
+	}
+
+	clearLines(document.Content[0])
+	return document.Content[0]
+}
+
+func clearLines(node *yaml.Node) {
+	node.Line = 0
+	for _, child := range node.Content {
+		clearLines(child)
+	}
+}
+
+// walkNode calls visit for the node and for all its descendants, with their JSON Pointers
+// visit returns false to skip the descendants of a node
+func walkNode(node *yaml.Node, pointer jsonPointer, visit func(node *yaml.Node, pointer jsonPointer) bool) {
+	if node == nil || !visit(node, pointer) {
+		return
+	}
+
+	switch node.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			walkNode(node.Content[i+1], pointer.add(node.Content[i].Value), visit)
+		}
+	case yaml.SequenceNode:
+		for i, child := range node.Content {
+			walkNode(child, pointer.add(strconv.Itoa(i)), visit)
+		}
+	}
+}
+
+// resolvePointer returns the node at a JSON Pointer, like the fragment of a $ref, or nil if it doesn't exist
+func resolvePointer(node *yaml.Node, pointer string) *yaml.Node {
+	pointer, err := url.PathUnescape(pointer)
+	if err != nil {
+		return nil
+	}
+
+	if pointer == "" {
+		return node
+	}
+
+	if !strings.HasPrefix(pointer, "/") {
+		return nil
+	}
+
+	for _, token := range strings.Split(pointer[1:], "/") {
+		token = pointerUnescaper.Replace(token)
+		switch {
+		case node == nil:
+			return nil
+		case node.Kind == yaml.MappingNode:
+			node = lookupNode(node, token)
+		case node.Kind == yaml.SequenceNode:
+			i, err := strconv.Atoi(token)
+			if err != nil || i < 0 || i >= len(node.Content) {
+				return nil
+			}
+			node = node.Content[i]
+		default:
+			return nil
+		}
+	}
+	return node
+}
+
+// lookupNode returns the node at the given path of mapping keys, or nil if it doesn't exist
+func lookupNode(node *yaml.Node, keys ...string) *yaml.Node {
+	for _, key := range keys {
+		if node == nil || node.Kind != yaml.MappingNode {
+			return nil
+		}
+		var next *yaml.Node
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			if node.Content[i].Value == key {
+				next = node.Content[i+1]
+			}
+		}
+		node = next
+	}
+	return node
+}
+
+// duplicateKey is a mapping key that appears more than once
+type duplicateKey struct {
+	key        string
+	line       int
+	secondLine int
+}
+
+// getDuplicateKeys returns the keys that appear more than once in a mapping node, with the lines of the first and the repeated occurrence
+func getDuplicateKeys(node *yaml.Node) []duplicateKey {
+	result := []duplicateKey{}
+	if node == nil || node.Kind != yaml.MappingNode {
+		return result
+	}
+
+	lines := map[string]int{}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key := node.Content[i]
+		if line, ok := lines[key.Value]; ok {
+			result = append(result, duplicateKey{key: key.Value, line: line, secondLine: key.Line})
+			continue
+		}
+		lines[key.Value] = key.Line
+	}
+	return result
+}
@@ -0,0 +1,121 @@
+package lint
+
+import (
+	"strings"
+
+	"github.com/oasdiff/oasdiff/load"
+	"github.com/oasdiff/oasdiff/utils"
+)
+
+const (
+	DuplicatePathId               = "duplicate-path"
+	DuplicateEndpointId           = "duplicate-endpoint"
+	DuplicateEndpointParamNamesId = "duplicate-endpoint-param-names"
+	AmbiguousEndpointsId          = "ambiguous-endpoints"
+)
+
+// EndpointsCheck finds endpoints that oasdiff and routers can't tell apart:
+// - paths and operations that are defined more than once in the raw document (possible in json, where the last definition wins)
+// - endpoints that differ only by path parameter names, which oasdiff matches to each other, for example /pets/{id} and /pets/{petId}
+// - endpoints that may match the same request, for example /pets/{id} and /pets/mine
+func EndpointsCheck(source string, s *load.SpecInfo) []*Error {
+	result := make([]*Error, 0)
+
+	if s == nil || s.Spec == nil {
+		return result
+	}
+
+	result = append(result, checkDuplicateKeys(source)...)
+
+	if s.Spec.Paths == nil {
+		return result
+	}
+
+	paths := sortedKeys(s.Spec.Paths.Map())
+	for i, path1 := range paths {
+		normalized1, _, _ := utils.NormalizeTemplatedPath(path1)
+		for _, path2 := range paths[i+1:] {
+			normalized2, _, _ := utils.NormalizeTemplatedPath(path2)
+
+			id := ""
+			switch {
+			case normalized1 == normalized2:
+				id = DuplicateEndpointParamNamesId
+			case pathsOverlap(path1, path2):
+				id = AmbiguousEndpointsId
+			default:
+				continue
+			}
+
+			operations2 := s.Spec.Paths.Value(path2).Operations()
+			for _, method := range sortedKeys(s.Spec.Paths.Value(path1).Operations()) {
+				if _, ok := operations2[method]; !ok {
+					continue
+				}
+				pointer1 := newJSONPointer("paths", path1, strings.ToLower(method))
+				pointer2 := newJSONPointer("paths", path2, strings.ToLower(method))
+				err := newError(id, source, method, path1, method, path2, pointer1, pointer2)
+				err.Pointer = pointer1.String()
+				result = append(result, err)
+			}
+		}
+	}
+
+	return result
+}
+
+// checkDuplicateKeys finds paths and operations that are defined more than once in the raw document
+func checkDuplicateKeys(source string) []*Error {
+	result := make([]*Error, 0)
+
+	paths := lookupNode(readDocument(source), "paths")
+	if paths == nil {
+		return result
+	}
+
+	for _, duplicate := range getDuplicateKeys(paths) {
+		result = append(result, newError(DuplicatePathId, source, duplicate.key, duplicate.line, duplicate.secondLine))
+	}
+
+	for i := 0; i+1 < len(paths.Content); i += 2 {
+		path := paths.Content[i].Value
+		for _, duplicate := range getDuplicateKeys(paths.Content[i+1]) {
+			if !isHTTPMethod(duplicate.key) {
+				continue
+			}
+			result = append(result, newError(DuplicateEndpointId, source, strings.ToUpper(duplicate.key), path, duplicate.line, duplicate.secondLine))
+		}
+	}
+
+	return result
+}
+
+func isHTTPMethod(key string) bool {
+	switch key {
+	case "get", "put", "post", "delete", "options", "head", "patch", "trace":
+		return true
+	}
+	return false
+}
+
+// pathsOverlap returns true if a request path may match both paths, for example /pets/{id} and /pets/mine
+func pathsOverlap(path1, path2 string) bool {
+	segments1 := strings.Split(path1, "/")
+	segments2 := strings.Split(path2, "/")
+
+	if len(segments1) != len(segments2) {
+		return false
+	}
+
+	for i := range segments1 {
+		if segments1[i] != segments2[i] && !isTemplateSegment(segments1[i]) && !isTemplateSegment(segments2[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// isTemplateSegment returns true if the path segment is a single path parameter, like {id}
+func isTemplateSegment(segment string) bool {
+	return strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") && strings.Count(segment, "{") == 1
+}
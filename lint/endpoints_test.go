@@ -0,0 +1,57 @@
+package lint_test
+
+import (
+	"testing"
+
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/lint"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEndpoints_Valid(t *testing.T) {
+
+	const source = "../data/lint/endpoints/valid.yaml"
+	require.Empty(t, lint.Run(lint.NewConfig([]lint.Check{lint.EndpointsCheck}), source, loadFrom(t, source)))
+}
+
+func TestEndpoints_DuplicateParamNames(t *testing.T) {
+
+	const source = "../data/lint/endpoints/invalid.yaml"
+	errs := lint.Run(lint.NewConfig([]lint.Check{lint.EndpointsCheck}), source, loadFrom(t, source))
+
+	require.Len(t, errs, 3)
+
+	require.Equal(t, lint.DuplicateEndpointParamNamesId, errs[0].Id)
+	require.Equal(t, checker.ERR, errs[0].Level)
+	require.Equal(t, "endpoints GET /pets/{id} and GET /pets/{petId} differ only by path parameter names: /paths/~1pets~1{id}/get and /paths/~1pets~1{petId}/get", errs[0].Text)
+	require.Contains(t, errs[0].Comment, "x-since-date")
+	require.Contains(t, errs[0].Comment, "--include-path-params")
+}
+
+func TestEndpoints_Ambiguous(t *testing.T) {
+
+	const source = "../data/lint/endpoints/invalid.yaml"
+	errs := lint.Run(lint.NewConfig([]lint.Check{lint.EndpointsCheck}), source, loadFrom(t, source))
+
+	require.Len(t, errs, 3)
+
+	require.Equal(t, lint.AmbiguousEndpointsId, errs[1].Id)
+	require.Equal(t, checker.WARN, errs[1].Level)
+	require.Equal(t, "endpoints GET /pets/mine and GET /pets/{id} are ambiguous because a request may match both: /paths/~1pets~1mine/get and /paths/~1pets~1{id}/get", errs[1].Text)
+	require.Equal(t, "endpoints GET /pets/mine and GET /pets/{petId} are ambiguous because a request may match both: /paths/~1pets~1mine/get and /paths/~1pets~1{petId}/get", errs[2].Text)
+}
+
+func TestEndpoints_DuplicateKeys(t *testing.T) {
+
+	const source = "../data/lint/endpoints/duplicate-keys.json"
+	errs := lint.Run(lint.NewConfig([]lint.Check{lint.EndpointsCheck}), source, loadFrom(t, source))
+
+	require.Len(t, errs, 2)
+
+	require.Equal(t, lint.DuplicateEndpointId, errs[0].Id)
+	require.Equal(t, "endpoint GET /pets is defined more than once, in lines 9 and 17", errs[0].Text)
+	require.Equal(t, "Only the last definition is loaded and the others are silently ignored.", errs[0].Comment)
+
+	require.Equal(t, lint.DuplicatePathId, errs[1].Id)
+	require.Equal(t, `path "/pets" is defined more than once, in lines 8 and 25`, errs[1].Text)
+}
@@ -0,0 +1,238 @@
+package lint
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oasdiff/oasdiff/load"
+	"github.com/oasdiff/oasdiff/utils"
+)
+
+const (
+	ParameterExampleInvalidId = "parameter-example-invalid"
+	HeaderExampleInvalidId    = "header-example-invalid"
+	MediaTypeExampleInvalidId = "media-type-example-invalid"
+	SchemaExampleInvalidId    = "schema-example-invalid"
+)
+
+// ExamplesCheck validates examples against their schemas
+// This includes examples of parameters, headers, media types and schemas
+// Each error includes the JSON Pointer of the example
+func ExamplesCheck(source string, s *load.SpecInfo) []*Error {
+	result := make([]*Error, 0)
+
+	if s == nil || s.Spec == nil {
+		return result
+	}
+
+	v := newExamplesValidator(source)
+
+	if s.Spec.Paths != nil {
+		for _, path := range s.Spec.Paths.InMatchingOrder() {
+			pathItem := s.Spec.Paths.Value(path)
+			pointer := newJSONPointer("paths", path)
+			v.checkParameters(pathItem.Parameters, pointer.add("parameters"))
+			operations := pathItem.Operations()
+			for _, method := range sortedKeys(operations) {
+				v.checkOperation(operations[method], pointer.add(strings.ToLower(method)))
+			}
+		}
+	}
+
+	if components := s.Spec.Components; components != nil {
+		pointer := newJSONPointer("components")
+		for _, name := range sortedKeys(components.Parameters) {
+			v.checkParameter(components.Parameters[name].Value, pointer.add("parameters", name))
+		}
+		for _, name := range sortedKeys(components.Headers) {
+			v.checkHeader(name, components.Headers[name].Value, pointer.add("headers", name))
+		}
+		for _, name := range sortedKeys(components.RequestBodies) {
+			if requestBody := components.RequestBodies[name].Value; requestBody != nil {
+				v.checkContent(requestBody.Content, pointer.add("requestBodies", name, "content"), openapi3.VisitAsRequest())
+			}
+		}
+		for _, name := range sortedKeys(components.Responses) {
+			v.checkResponse(components.Responses[name].Value, pointer.add("responses", name))
+		}
+		for _, name := range sortedKeys(components.Schemas) {
+			v.checkSchema(components.Schemas[name].Value, pointer.add("schemas", name))
+		}
+	}
+
+	return v.result
+}
+
+type examplesValidator struct {
+	source string
+	result []*Error
+}
+
+func newExamplesValidator(source string) *examplesValidator {
+	return &examplesValidator{
+		source: source,
+		result: make([]*Error, 0),
+	}
+}
+
+func (v *examplesValidator) checkOperation(op *openapi3.Operation, pointer jsonPointer) {
+	if op == nil {
+		return
+	}
+
+	v.checkParameters(op.Parameters, pointer.add("parameters"))
+
+	// referenced request bodies and responses are checked under components
+	if op.RequestBody != nil && op.RequestBody.Ref == "" && op.RequestBody.Value != nil {
+		v.checkContent(op.RequestBody.Value.Content, pointer.add("requestBody", "content"), openapi3.VisitAsRequest())
+	}
+
+	if op.Responses != nil {
+		for _, status := range sortedKeys(op.Responses.Map()) {
+			if response := op.Responses.Value(status); response.Ref == "" {
+				v.checkResponse(response.Value, pointer.add("responses", status))
+			}
+		}
+	}
+}
+
+func (v *examplesValidator) checkParameters(parameters openapi3.Parameters, pointer jsonPointer) {
+	for i, parameter := range parameters {
+		// referenced parameters are checked under components
+		if parameter == nil || parameter.Ref != "" {
+			continue
+		}
+		v.checkParameter(parameter.Value, pointer.add(fmt.Sprint(i)))
+	}
+}
+
+func (v *examplesValidator) checkParameter(parameter *openapi3.Parameter, pointer jsonPointer) {
+	if parameter == nil {
+		return
+	}
+
+	schema := utils.GetParameterSchema(parameter)
+	for _, example := range utils.GetExamples(parameter.Example, parameter.Examples) {
+		if err := validateValue(schema, example.Value, openapi3.VisitAsRequest()); err != nil {
+			v.add(ParameterExampleInvalidId, pointer.add(example.Path...), example.Name, parameter.In, parameter.Name, err)
+		}
+	}
+
+	v.checkSchemaRef(parameter.Schema, pointer.add("schema"))
+	v.checkContent(parameter.Content, pointer.add("content"), openapi3.VisitAsRequest())
+}
+
+func (v *examplesValidator) checkHeader(name string, header *openapi3.Header, pointer jsonPointer) {
+	if header == nil {
+		return
+	}
+
+	schema := utils.GetParameterSchema(&header.Parameter)
+	for _, example := range utils.GetExamples(header.Example, header.Examples) {
+		if err := validateValue(schema, example.Value, openapi3.VisitAsResponse()); err != nil {
+			v.add(HeaderExampleInvalidId, pointer.add(example.Path...), example.Name, name, err)
+		}
+	}
+
+	v.checkSchemaRef(header.Schema, pointer.add("schema"))
+	v.checkContent(header.Content, pointer.add("content"), openapi3.VisitAsResponse())
+}
+
+func (v *examplesValidator) checkResponse(response *openapi3.Response, pointer jsonPointer) {
+	if response == nil {
+		return
+	}
+
+	for _, name := range sortedKeys(response.Headers) {
+		if header := response.Headers[name]; header.Ref == "" {
+			v.checkHeader(name, header.Value, pointer.add("headers", name))
+		}
+	}
+
+	v.checkContent(response.Content, pointer.add("content"), openapi3.VisitAsResponse())
+}
+
+func (v *examplesValidator) checkContent(content openapi3.Content, pointer jsonPointer, opts ...openapi3.SchemaValidationOption) {
+	for _, mediaTypeName := range sortedKeys(content) {
+		mediaType := content[mediaTypeName]
+		if mediaType == nil || mediaType.Schema == nil {
+			continue
+		}
+
+		mediaTypePointer := pointer.add(mediaTypeName)
+		for _, example := range utils.GetExamples(mediaType.Example, mediaType.Examples) {
+			if err := validateValue(mediaType.Schema.Value, example.Value, opts...); err != nil {
+				v.add(MediaTypeExampleInvalidId, mediaTypePointer.add(example.Path...), example.Name, mediaTypeName, err)
+			}
+		}
+
+		v.checkSchemaRef(mediaType.Schema, mediaTypePointer.add("schema"))
+	}
+}
+
+func (v *examplesValidator) checkSchemaRef(schema *openapi3.SchemaRef, pointer jsonPointer) {
+	// referenced schemas are checked under components
+	if schema == nil || schema.Ref != "" {
+		return
+	}
+	v.checkSchema(schema.Value, pointer)
+}
+
+func (v *examplesValidator) checkSchema(schema *openapi3.Schema, pointer jsonPointer) {
+	if schema == nil {
+		return
+	}
+
+	if schema.Example != nil {
+		if err := validateValue(schema, schema.Example); err != nil {
+			v.add(SchemaExampleInvalidId, pointer.add("example"), err)
+		}
+	}
+
+	for _, name := range sortedKeys(schema.Properties) {
+		v.checkSchemaRef(schema.Properties[name], pointer.add("properties", name))
+	}
+	v.checkSchemaRef(schema.Items, pointer.add("items"))
+	v.checkSchemaRef(schema.AdditionalProperties.Schema, pointer.add("additionalProperties"))
+	v.checkSchemaRef(schema.Not, pointer.add("not"))
+	for i, subSchema := range schema.OneOf {
+		v.checkSchemaRef(subSchema, pointer.add("oneOf", fmt.Sprint(i)))
+	}
+	for i, subSchema := range schema.AnyOf {
+		v.checkSchemaRef(subSchema, pointer.add("anyOf", fmt.Sprint(i)))
+	}
+	for i, subSchema := range schema.AllOf {
+		v.checkSchemaRef(subSchema, pointer.add("allOf", fmt.Sprint(i)))
+	}
+}
+
+// add reports an error at the JSON Pointer of the example
+func (v *examplesValidator) add(id string, pointer jsonPointer, args ...any) {
+	v.result = append(v.result, newErrorAt(id, v.source, pointer, args...))
+}
+
+// validateValue validates an example or a default value against its schema
+func validateValue(schema *openapi3.Schema, value any, opts ...openapi3.SchemaValidationOption) error {
+	if schema == nil {
+		return nil
+	}
+
+	err := schema.VisitJSON(value, opts...)
+	if err == nil {
+		return nil
+	}
+
+	return errors.New(utils.GetValidationReason(err))
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	result := make([]string, 0, len(m))
+	for key := range m {
+		result = append(result, key)
+	}
+	sort.Strings(result)
+	return result
+}
@@ -0,0 +1,37 @@
+package lint_test
+
+import (
+	"testing"
+
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/lint"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExamples_Valid(t *testing.T) {
+
+	const source = "../data/lint/examples/valid.yaml"
+	require.Empty(t, lint.Run(lint.NewConfig([]lint.Check{lint.ExamplesCheck}), source, loadFrom(t, source)))
+}
+
+func TestExamples_Invalid(t *testing.T) {
+
+	const source = "../data/lint/examples/invalid.yaml"
+	errs := lint.Run(lint.NewConfig([]lint.Check{lint.ExamplesCheck}), source, loadFrom(t, source))
+
+	texts := make([]string, len(errs))
+	for i, err := range errs {
+		require.Equal(t, checker.ERR, err.Level)
+		require.Equal(t, source, err.Source)
+		texts[i] = err.Id + ": " + err.Text
+	}
+
+	require.Equal(t, []string{
+		`header-example-invalid: example "invalid" of the header "X-Rate-Limit" doesn't match its schema: value must be an integer: /paths/~1pets/get/responses/200/headers/X-Rate-Limit/examples/invalid/value`,
+		`media-type-example-invalid: example "example" of the media type "application/json" doesn't match its schema: /1/name: value must be a string: /paths/~1pets/get/responses/200/content/application~1json/example`,
+		`media-type-example-invalid: example "missing-name" of the media type "application/json" doesn't match its schema: /name: property "name" is missing: /paths/~1pets/post/requestBody/content/application~1json/examples/missing-name/value`,
+		`parameter-example-invalid: example "example" of the query parameter "limit" doesn't match its schema: value must be an integer: /paths/~1pets/get/parameters/0/example`,
+		`parameter-example-invalid: example "example" of the query parameter "status" doesn't match its schema: value is not one of the allowed values ["available","sold"]: /components/parameters/status/example`,
+		`schema-example-invalid: schema example doesn't match its schema: number must be at least 0: /components/schemas/Pet/properties/age/example`,
+	}, texts)
+}
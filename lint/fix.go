@@ -0,0 +1,534 @@
+package lint
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+	"github.com/oasdiff/oasdiff/utils"
+	"github.com/pmezard/go-difflib/difflib"
+	"gopkg.in/yaml.v3"
+)
+
+// Fix is a mechanical change that resolves a lint error
+type Fix struct {
+	Id          string // the id of the lint error that the fix resolves
+	Pointer     string // the JSON Pointer of the fixed location
+	Description string
+}
+
+// FixResult is the outcome of fixing a spec
+type FixResult struct {
+	Fixes    []Fix
+	Original []byte
+	Fixed    []byte
+}
+
+// FixFile applies safe fixes to a local spec file and returns the result without writing it
+func FixFile(file string) (*FixResult, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	return FixData(data)
+}
+
+// FixData applies safe fixes to a raw yaml or json spec:
+// - missing operationIds are generated from the method and the path
+// - path parameters that appear in the path template but aren't declared are added
+// - path parameters that aren't required are made required
+// - required properties that don't exist are removed from the required list, unless the schema is combined with other schemas
+// - duplicate required properties are removed
+// - defaults of required parameters are removed
+// The fixed spec keeps the format, the key order and the comments of the original
+func FixData(data []byte) (*FixResult, error) {
+	var document yaml.Node
+	if err := yaml.Unmarshal(data, &document); err != nil {
+		return nil, err
+	}
+	if len(document.Content) == 0 || document.Content[0].Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("spec must be a yaml or json object")
+	}
+
+	f := fixer{root: document.Content[0]}
+	f.fixPaths()
+	f.fixRequiredProperties()
+
+	result := &FixResult{
+		Fixes:    f.fixes,
+		Original: data,
+		Fixed:    data,
+	}
+	if len(f.fixes) == 0 {
+		return result, nil
+	}
+
+	fixed, err := encodeDocument(&document, data)
+	if err != nil {
+		return nil, err
+	}
+	result.Fixed = fixed
+	return result, nil
+}
+
+// Diff returns a unified diff between the original and the fixed spec
+func (result *FixResult) Diff(name string) string {
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(string(result.Original)),
+		B:        difflib.SplitLines(string(result.Fixed)),
+		FromFile: name,
+		ToFile:   name + " (fixed)",
+		Context:  3,
+	})
+	if err != nil {
+		return ""
+	}
+	return diff
+}
+
+type fixer struct {
+	root  *yaml.Node
+	fixes []Fix
+}
+
+func (f *fixer) add(id string, pointer jsonPointer, description string, args ...any) {
+	f.fixes = append(f.fixes, Fix{
+		Id:          id,
+		Pointer:     pointer.String(),
+		Description: fmt.Sprintf(description, args...),
+	})
+}
+
+func (f *fixer) fixPaths() {
+	paths := lookupNode(f.root, "paths")
+	if paths == nil || paths.Kind != yaml.MappingNode {
+		return
+	}
+
+	operationIds := f.getOperationIds(paths)
+
+	for i := 0; i+1 < len(paths.Content); i += 2 {
+		path, pathItem := paths.Content[i].Value, paths.Content[i+1]
+		if pathItem.Kind != yaml.MappingNode || lookupNode(pathItem, "$ref") != nil {
+			continue
+		}
+		pointer := newJSONPointer("paths", path)
+
+		pathParams, ok := f.fixParameters(pointer, pathItem)
+
+		for j := 0; j+1 < len(pathItem.Content); j += 2 {
+			method, op := pathItem.Content[j].Value, pathItem.Content[j+1]
+			if !isHTTPMethod(method) || op.Kind != yaml.MappingNode {
+				continue
+			}
+			opPointer := pointer.add(method)
+
+			opParams, opOk := f.fixParameters(opPointer, op)
+			if ok && opOk {
+				f.addMissingPathParams(opPointer, op, path, pathParams, opParams)
+			}
+
+			if lookupNode(op, "operationId") == nil {
+				operationId := getUniqueOperationId(operationIds, method, path)
+				operationIds[operationId] = struct{}{}
+				insertKey(op, "operationId", newScalarNode(operationId), "tags", "summary", "description")
+				f.add(StyleOperationIdMissingId, opPointer, "added operationId %q to %s %s", operationId, method, path)
+			}
+		}
+	}
+}
+
+func (f *fixer) getOperationIds(paths *yaml.Node) map[string]struct{} {
+	result := map[string]struct{}{}
+	for i := 1; i < len(paths.Content); i += 2 {
+		pathItem := paths.Content[i]
+		for j := 0; j+1 < len(pathItem.Content); j += 2 {
+			if !isHTTPMethod(pathItem.Content[j].Value) {
+				continue
+			}
+			if operationId := lookupNode(pathItem.Content[j+1], "operationId"); operationId != nil {
+				result[operationId.Value] = struct{}{}
+			}
+		}
+	}
+	return result
+}
+
+// fixParameters fixes the inline parameters of a path item or an operation and returns the names of its path parameters
+// It returns false if some of the parameters are references that can't be resolved
+func (f *fixer) fixParameters(pointer jsonPointer, node *yaml.Node) (utils.StringSet, bool) {
+	result := utils.StringSet{}
+
+	parameters := lookupNode(node, "parameters")
+	if parameters == nil {
+		return result, true
+	}
+	if parameters.Kind != yaml.SequenceNode {
+		return result, false
+	}
+
+	resolved := true
+	for i, parameter := range parameters.Content {
+		paramPointer := pointer.add("parameters", strconv.Itoa(i))
+
+		inline := true
+		if ref := lookupNode(parameter, "$ref"); ref != nil {
+			inline = false
+			if parameter = f.resolveRef(ref.Value); parameter == nil {
+				resolved = false
+				continue
+			}
+		}
+
+		name, in := lookupNode(parameter, "name"), lookupNode(parameter, "in")
+		if name == nil || in == nil {
+			continue
+		}
+
+		if in.Value == "path" {
+			result.Add(name.Value)
+		}
+
+		// referenced parameters may be shared, so only inline parameters are modified
+		if !inline {
+			continue
+		}
+
+		required := lookupNode(parameter, "required")
+		if in.Value == "path" && (required == nil || required.Value != "true") {
+			if required == nil {
+				insertKey(parameter, "required", newBoolNode(true), "name", "in", "description")
+			} else {
+				setBool(required, true)
+			}
+			f.add(PathParamNotRequiredId, paramPointer, "made path parameter %q required", name.Value)
+			required = lookupNode(parameter, "required")
+		}
+
+		if required != nil && required.Value == "true" {
+			if schema := lookupNode(parameter, "schema"); schema != nil && removeKey(schema, "default") {
+				f.add(RequiredParamWithDefaultId, paramPointer.add("schema"), "removed the default of required parameter %q", name.Value)
+			}
+		}
+	}
+
+	return result, resolved
+}
+
+// addMissingPathParams declares the parameters of a path template that aren't declared by the path item or the operation
+func (f *fixer) addMissingPathParams(pointer jsonPointer, op *yaml.Node, path string, pathParams, opParams utils.StringSet) {
+	_, _, params := utils.NormalizeTemplatedPath(path)
+	for _, param := range params {
+		if pathParams.Contains(param) || opParams.Contains(param) {
+			continue
+		}
+
+		parameters := lookupNode(op, "parameters")
+		if parameters == nil {
+			parameters = &yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq"}
+			insertKey(op, "parameters", parameters, "tags", "summary", "description", "operationId")
+		}
+		parameters.Content = append(parameters.Content, newMappingNode(
+			"name", newScalarNode(param),
+			"in", newScalarNode("path"),
+			"required", newBoolNode(true),
+			"schema", newMappingNode("type", newScalarNode("string")),
+		))
+		opParams.Add(param)
+		f.add(PathParamMissingId, pointer.add("parameters", strconv.Itoa(len(parameters.Content)-1)), "added missing path parameter %q to %s", param, path)
+	}
+}
+
+// resolveRef returns the node of a local reference, or nil if the reference isn't local or doesn't exist
+func (f *fixer) resolveRef(ref string) *yaml.Node {
+	if !strings.HasPrefix(ref, "#") {
+		return nil
+	}
+	return resolvePointer(f.root, ref[1:])
+}
+
+// fixRequiredProperties removes required properties that aren't defined by the schema, and duplicates
+// The properties of a schema that combines other schemas, or that is combined by another schema, may be defined elsewhere, so only duplicates are removed from these schemas
+func (f *fixer) fixRequiredProperties() {
+	composed := f.getComposedSchemas()
+
+	walkNode(f.root, newJSONPointer(), func(node *yaml.Node, pointer jsonPointer) bool {
+		if isValuePointer(pointer) {
+			return false
+		}
+		if node.Kind != yaml.MappingNode {
+			return true
+		}
+
+		required := lookupNode(node, "required")
+		if required == nil || required.Kind != yaml.SequenceNode {
+			return true
+		}
+
+		properties := lookupNode(node, "properties")
+		standalone := properties != nil && properties.Kind == yaml.MappingNode && !composed[node]
+		for _, keyword := range []string{"allOf", "anyOf", "oneOf", "additionalProperties", "patternProperties"} {
+			if lookupNode(node, keyword) != nil {
+				standalone = false
+			}
+		}
+
+		kept := make([]*yaml.Node, 0, len(required.Content))
+		seen := utils.StringSet{}
+		for _, name := range required.Content {
+			switch {
+			case seen.Contains(name.Value):
+				f.add(ExtraRequiredPropsId, pointer.add("required"), "removed duplicate required property %q", name.Value)
+			case standalone && lookupNode(properties, name.Value) == nil:
+				f.add(ExtraRequiredPropsId, pointer.add("required"), "removed required property %q that isn't defined", name.Value)
+			default:
+				seen.Add(name.Value)
+				kept = append(kept, name)
+			}
+		}
+		required.Content = kept
+		if len(kept) == 0 {
+			removeKey(node, "required")
+		}
+		return true
+	})
+}
+
+// getComposedSchemas returns the schemas that are members of allOf, anyOf or oneOf, inline or referenced
+func (f *fixer) getComposedSchemas() map[*yaml.Node]bool {
+	result := map[*yaml.Node]bool{}
+	walkNode(f.root, newJSONPointer(), func(node *yaml.Node, pointer jsonPointer) bool {
+		if isValuePointer(pointer) {
+			return false
+		}
+		if node.Kind != yaml.MappingNode {
+			return true
+		}
+		for _, keyword := range []string{"allOf", "anyOf", "oneOf"} {
+			members := lookupNode(node, keyword)
+			if members == nil || members.Kind != yaml.SequenceNode {
+				continue
+			}
+			for _, member := range members.Content {
+				// follow chains of references, with a limit in case of circular references
+				for i := 0; member != nil && i < 10; i++ {
+					result[member] = true
+					ref := lookupNode(member, "$ref")
+					if ref == nil {
+						break
+					}
+					member = f.resolveRef(ref.Value)
+				}
+			}
+		}
+		return true
+	})
+	return result
+}
+
+// isValuePointer returns true if the pointer is a literal value, like an example or an enum, which must not be modified
+func isValuePointer(pointer jsonPointer) bool {
+	if len(pointer) == 0 || (len(pointer) > 1 && pointer[len(pointer)-2] == "properties") {
+		// a property may be named like a keyword
+		return false
+	}
+	switch pointer[len(pointer)-1] {
+	case "example", "examples", "enum", "default", "const":
+		return true
+	}
+	return false
+}
+
+// getUniqueOperationId returns an operationId made of the method and the path, like getPetsByPetId for GET /pets/{petId}
+func getUniqueOperationId(existing map[string]struct{}, method, path string) string {
+	var result strings.Builder
+	result.WriteString(strings.ToLower(method))
+	for _, segment := range strings.Split(path, "/") {
+		if segment == "" {
+			continue
+		}
+		if isTemplateSegment(segment) {
+			result.WriteString("By")
+		}
+		result.WriteString(strcase.ToCamel(strings.NewReplacer("{", "", "}", "").Replace(segment)))
+	}
+
+	operationId := result.String()
+	for i := 2; ; i++ {
+		if _, ok := existing[operationId]; !ok {
+			return operationId
+		}
+		operationId = result.String() + strconv.Itoa(i)
+	}
+}
+
+func newScalarNode(value string) *yaml.Node {
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value}
+}
+
+func newBoolNode(value bool) *yaml.Node {
+	return &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!bool", Value: strconv.FormatBool(value)}
+}
+
+func setBool(node *yaml.Node, value bool) {
+	node.Kind, node.Tag, node.Value, node.Style = yaml.ScalarNode, "!!bool", strconv.FormatBool(value), 0
+}
+
+// newMappingNode returns a mapping node of key, value pairs
+func newMappingNode(pairs ...any) *yaml.Node {
+	result := &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+	for i := 0; i+1 < len(pairs); i += 2 {
+		result.Content = append(result.Content, newScalarNode(pairs[i].(string)), pairs[i+1].(*yaml.Node))
+	}
+	return result
+}
+
+// insertKey adds a key to a mapping node after the last of the given keys that exists, or first if none exists
+func insertKey(node *yaml.Node, key string, value *yaml.Node, after ...string) {
+	position := 0
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		for _, name := range after {
+			if node.Content[i].Value == name {
+				position = i + 2
+			}
+		}
+	}
+
+	content := make([]*yaml.Node, 0, len(node.Content)+2)
+	content = append(content, node.Content[:position]...)
+	content = append(content, newScalarNode(key), value)
+	node.Content = append(content, node.Content[position:]...)
+}
+
+// removeKey removes a key from a mapping node and returns true if it existed
+func removeKey(node *yaml.Node, key string) bool {
+	if node.Kind != yaml.MappingNode {
+		return false
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			node.Content = append(node.Content[:i], node.Content[i+2:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// encodeDocument encodes the document in the format and the indentation of the original data
+func encodeDocument(document *yaml.Node, original []byte) ([]byte, error) {
+	indent := getIndent(original)
+
+	if bytes.HasPrefix(bytes.TrimSpace(original), []byte("{")) {
+		var buf bytes.Buffer
+		if err := encodeJSONNode(&buf, document.Content[0], strings.Repeat(" ", indent), ""); err != nil {
+			return nil, err
+		}
+		buf.WriteString("\n")
+		return buf.Bytes(), nil
+	}
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(indent)
+	if err := encoder.Encode(document); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// getIndent returns the indentation of the first indented line, or 2 if there is none
+func getIndent(data []byte) int {
+	for _, line := range strings.Split(string(data), "\n") {
+		trimmed := strings.TrimLeft(line, " ")
+		if indent := len(line) - len(trimmed); indent > 0 && trimmed != "" && !strings.HasPrefix(trimmed, "#") {
+			return indent
+		}
+	}
+	return 2
+}
+
+// encodeJSONNode encodes a node as indented json, keeping the order of the keys
+func encodeJSONNode(buf *bytes.Buffer, node *yaml.Node, indent, prefix string) error {
+	switch node.Kind {
+	case yaml.AliasNode:
+		return encodeJSONNode(buf, node.Alias, indent, prefix)
+	case yaml.MappingNode, yaml.SequenceNode:
+		open, close, step := "{", "}", 2
+		if node.Kind == yaml.SequenceNode {
+			open, close, step = "[", "]", 1
+		}
+		if len(node.Content) == 0 {
+			buf.WriteString(open + close)
+			return nil
+		}
+		// collections that were written on a single line stay on a single line
+		inline := node.Line > 0 && getLastLine(node) == node.Line
+		buf.WriteString(open)
+		for i := 0; i < len(node.Content); i += step {
+			if i > 0 {
+				buf.WriteString(",")
+			}
+			if inline {
+				if i > 0 {
+					buf.WriteString(" ")
+				}
+			} else {
+				buf.WriteString("\n" + prefix + indent)
+			}
+			if node.Kind == yaml.MappingNode {
+				if err := encodeJSONString(buf, node.Content[i].Value); err != nil {
+					return err
+				}
+				buf.WriteString(": ")
+			}
+			if err := encodeJSONNode(buf, node.Content[i+step-1], indent, prefix+indent); err != nil {
+				return err
+			}
+		}
+		if !inline {
+			buf.WriteString("\n" + prefix)
+		}
+		buf.WriteString(close)
+		return nil
+	}
+
+	switch node.ShortTag() {
+	case "!!null":
+		buf.WriteString("null")
+	case "!!bool", "!!int", "!!float":
+		if json.Valid([]byte(node.Value)) {
+			buf.WriteString(node.Value)
+			return nil
+		}
+		return encodeJSONString(buf, node.Value)
+	default:
+		return encodeJSONString(buf, node.Value)
+	}
+	return nil
+}
+
+// getLastLine returns the line of the last descendant of a node
+func getLastLine(node *yaml.Node) int {
+	if len(node.Content) == 0 {
+		return node.Line
+	}
+	return getLastLine(node.Content[len(node.Content)-1])
+}
+
+func encodeJSONString(buf *bytes.Buffer, value string) error {
+	var encoded bytes.Buffer
+	encoder := json.NewEncoder(&encoded)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(value); err != nil {
+		return err
+	}
+	buf.Write(bytes.TrimSuffix(encoded.Bytes(), []byte("\n")))
+	return nil
+}
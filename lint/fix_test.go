@@ -0,0 +1,109 @@
+package lint_test
+
+import (
+	"testing"
+
+	"github.com/oasdiff/oasdiff/lint"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFix_YAML(t *testing.T) {
+	result, err := lint.FixFile("../data/lint/fix/openapi.yaml")
+	require.NoError(t, err)
+	require.Equal(t, []lint.Fix{
+		{Id: lint.RequiredParamWithDefaultId, Pointer: "/paths/~1pets/get/parameters/0/schema", Description: "removed the default of required parameter \"limit\""},
+		{Id: lint.StyleOperationIdMissingId, Pointer: "/paths/~1pets/get", Description: "added operationId \"getPets\" to get /pets"},
+		{Id: lint.PathParamNotRequiredId, Pointer: "/paths/~1pets~1{petId}~1owners~1{ownerId}/parameters/0", Description: "made path parameter \"petId\" required"},
+		{Id: lint.PathParamMissingId, Pointer: "/paths/~1pets~1{petId}~1owners~1{ownerId}/get/parameters/0", Description: "added missing path parameter \"ownerId\" to /pets/{petId}/owners/{ownerId}"},
+		{Id: lint.ExtraRequiredPropsId, Pointer: "/components/schemas/Pet/required", Description: "removed required property \"tag\" that isn't defined"},
+		{Id: lint.ExtraRequiredPropsId, Pointer: "/components/schemas/Pet/required", Description: "removed duplicate required property \"id\""},
+	}, result.Fixes)
+
+	fixed := string(result.Fixed)
+	require.Contains(t, fixed, "# Pet store with mechanical lint errors\n")
+	require.Contains(t, fixed, "      summary: List pets # the operationId is missing\n      operationId: getPets\n")
+	require.Contains(t, fixed, "      required:\n        - id\n        - name\n      properties:\n")
+	require.Contains(t, fixed, "        required:\n          - not-a-property\n")
+}
+
+func TestFix_YAMLIsValid(t *testing.T) {
+	result, err := lint.FixFile("../data/lint/fix/openapi.yaml")
+	require.NoError(t, err)
+
+	again, err := lint.FixData(result.Fixed)
+	require.NoError(t, err)
+	require.Empty(t, again.Fixes)
+	require.Equal(t, result.Fixed, again.Fixed)
+}
+
+func TestFix_JSON(t *testing.T) {
+	result, err := lint.FixFile("../data/lint/fix/openapi.json")
+	require.NoError(t, err)
+	require.Len(t, result.Fixes, 2)
+	require.Equal(t, `{
+    "openapi": "3.0.0",
+    "info": {
+        "title": "Pet Store",
+        "version": "1.0"
+    },
+    "paths": {
+        "/pets/{petId}": {
+            "get": {
+                "tags": ["pets"],
+                "summary": "Get a pet",
+                "operationId": "getPetsByPetId",
+                "parameters": [
+                    {
+                        "name": "petId",
+                        "in": "path",
+                        "required": true,
+                        "schema": {
+                            "type": "string"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "ok"
+                    }
+                }
+            }
+        }
+    }
+}
+`, string(result.Fixed))
+}
+
+func TestFix_Nothing(t *testing.T) {
+	result, err := lint.FixFile("../data/lint/required-properties/ok.yaml")
+	require.NoError(t, err)
+	require.Empty(t, result.Fixes)
+	require.Equal(t, result.Original, result.Fixed)
+	require.Empty(t, result.Diff("ok.yaml"))
+}
+
+func TestFix_Diff(t *testing.T) {
+	result, err := lint.FixFile("../data/lint/fix/openapi.yaml")
+	require.NoError(t, err)
+	diff := result.Diff("openapi.yaml")
+	require.Contains(t, diff, "--- openapi.yaml\n+++ openapi.yaml (fixed)\n")
+	require.Contains(t, diff, "+      operationId: getPets\n")
+	require.Contains(t, diff, "-            default: 20\n")
+}
+
+func TestFix_Invalid(t *testing.T) {
+	_, err := lint.FixData([]byte("- not a spec"))
+	require.EqualError(t, err, "spec must be a yaml or json object")
+}
+
+func TestFix_ComposedSchemas(t *testing.T) {
+	result, err := lint.FixFile("../data/lint/fix/allof.yaml")
+	require.NoError(t, err)
+	require.Equal(t, []lint.Fix{
+		{Id: lint.ExtraRequiredPropsId, Pointer: "/components/schemas/Pet/allOf/1/required", Description: "removed duplicate required property \"name\""},
+	}, result.Fixes)
+
+	fixed := string(result.Fixed)
+	require.Contains(t, fixed, "          required:\n            - id\n            - name\n          properties:\n")
+	require.Contains(t, fixed, "      required:\n        - id\n        - name\n      properties:\n        name:\n")
+}
@@ -0,0 +1,42 @@
+package lint
+
+import (
+	"bufio"
+	"os"
+
+	"github.com/oasdiff/oasdiff/checker"
+)
+
+// ProcessIgnoredErrors removes the errors of the given level that match a line in the ignore file
+func ProcessIgnoredErrors(level checker.Level, errs Errors, ignoreFile string) (Errors, error) {
+	result := make(Errors, 0)
+
+	ignore, err := os.Open(ignoreFile)
+	if err != nil {
+		return nil, err
+	}
+	defer ignore.Close()
+	ignoreScanner := bufio.NewScanner(ignore)
+
+	ignoredErrs := make([]bool, len(errs))
+	for ignoreScanner.Scan() {
+		ignoreLine := ignoreScanner.Text()
+
+		for errIndex, err := range errs {
+			if err.Level != level {
+				continue
+			}
+
+			if err.MatchIgnore(ignoreLine) {
+				ignoredErrs[errIndex] = true
+			}
+		}
+	}
+
+	for errIndex, err := range errs {
+		if !ignoredErrs[errIndex] {
+			result = append(result, err)
+		}
+	}
+	return result, nil
+}
@@ -1,14 +1,25 @@
 package lint
 
 import (
-	"fmt"
+	"net/mail"
 	"net/url"
 
 	"github.com/oasdiff/oasdiff/load"
 )
 
+const (
+	InfoMissingId               = "info-missing"
+	InfoTitleMissingId          = "info-title-missing"
+	InfoVersionMissingId        = "info-version-missing"
+	InfoInvalidTermsOfServiceId = "info-invalid-terms-of-service"
+	InfoInvalidContactURLId     = "info-invalid-contact-url"
+	InfoInvalidContactEmailId   = "info-invalid-contact-email"
+	InfoInvalidLicenseURLId     = "info-invalid-license-url"
+)
+
 // InfoCheck based on REQUIRED fields (Version and Info) from swagger docs,
 // see: https://swagger.io/docs/specification/api-general-info/
+// It also validates the formats of the terms of service, contact and license URLs and the contact email
 func InfoCheck(source string, spec *load.SpecInfo) []*Error {
 
 	result := make([]*Error, 0)
@@ -18,43 +29,44 @@ func InfoCheck(source string, spec *load.SpecInfo) []*Error {
 	}
 
 	if spec.Spec.Info == nil {
-		result = append(result, &Error{
-			Id:      "info-missing",
-			Level:   LEVEL_ERROR,
-			Text:    "info is missing",
-			Comment: "It is a good practice to include general information about your API into the specification. Title and Version fields are required.",
-			Source:  source,
-		})
+		result = append(result, newError(InfoMissingId, source))
 		return result
 	}
 
 	if spec.Spec.Info.Title == "" {
-		result = append(result, &Error{
-			Id:     "info-title-missing",
-			Level:  LEVEL_ERROR,
-			Text:   "the title of the API is missing",
-			Source: source,
-		})
+		result = append(result, newError(InfoTitleMissingId, source))
 	}
 	if spec.Spec.Info.Version == "" {
-		result = append(result, &Error{
-			Id:     "info-version-missing",
-			Level:  LEVEL_ERROR,
-			Text:   "the version of the API is missing",
-			Source: source,
-		})
+		result = append(result, newError(InfoVersionMissingId, source))
+	}
+
+	if tos := spec.Spec.Info.TermsOfService; tos != "" && !isURL(tos) {
+		result = append(result, newError(InfoInvalidTermsOfServiceId, source, tos))
 	}
 
-	if tos := spec.Spec.Info.TermsOfService; tos != "" {
-		if _, err := url.ParseRequestURI(tos); err != nil {
-			result = append(result, &Error{
-				Id:     "info-invalid-terms-of-service",
-				Level:  LEVEL_ERROR,
-				Text:   fmt.Sprintf("terms of service must be in the format of a URL: %s", tos),
-				Source: source,
-			})
+	if contact := spec.Spec.Info.Contact; contact != nil {
+		if contact.URL != "" && !isURL(contact.URL) {
+			result = append(result, newError(InfoInvalidContactURLId, source, contact.URL))
+		}
+		if contact.Email != "" && !isEmail(contact.Email) {
+			result = append(result, newError(InfoInvalidContactEmailId, source, contact.Email))
 		}
 	}
 
+	if license := spec.Spec.Info.License; license != nil && license.URL != "" && !isURL(license.URL) {
+		result = append(result, newError(InfoInvalidLicenseURLId, source, license.URL))
+	}
+
 	return result
 }
+
+func isURL(value string) bool {
+	_, err := url.ParseRequestURI(value)
+	return err == nil
+}
+
+// isEmail returns true if the value is a plain email address, without a display name
+func isEmail(value string) bool {
+	address, err := mail.ParseAddress(value)
+	return err == nil && address.Address == value
+}
@@ -3,6 +3,7 @@ package lint_test
 import (
 	"testing"
 
+	"github.com/oasdiff/oasdiff/checker"
 	"github.com/oasdiff/oasdiff/lint"
 	"github.com/stretchr/testify/require"
 )
@@ -13,7 +14,7 @@ func TestInfo_NoInfo(t *testing.T) {
 	errs := lint.Run(lint.NewConfig([]lint.Check{lint.InfoCheck}), source, loadFrom(t, source))
 	require.Len(t, errs, 1)
 	require.Equal(t, "info-missing", errs[0].Id)
-	require.Equal(t, lint.LEVEL_ERROR, errs[0].Level)
+	require.Equal(t, checker.ERR, errs[0].Level)
 	require.Equal(t, source, errs[0].Source)
 }
 
@@ -23,7 +24,7 @@ func TestInfo_TitleMissing(t *testing.T) {
 	errs := lint.Run(lint.NewConfig([]lint.Check{lint.InfoCheck}), source, loadFrom(t, source))
 	require.Len(t, errs, 1)
 	require.Equal(t, "info-title-missing", errs[0].Id)
-	require.Equal(t, lint.LEVEL_ERROR, errs[0].Level)
+	require.Equal(t, checker.ERR, errs[0].Level)
 	require.Equal(t, source, errs[0].Source)
 }
 
@@ -33,7 +34,7 @@ func TestInfo_VersionMissing(t *testing.T) {
 	errs := lint.Run(lint.NewConfig([]lint.Check{lint.InfoCheck}), source, loadFrom(t, source))
 	require.Len(t, errs, 1)
 	require.Equal(t, "info-version-missing", errs[0].Id)
-	require.Equal(t, lint.LEVEL_ERROR, errs[0].Level)
+	require.Equal(t, checker.ERR, errs[0].Level)
 	require.Equal(t, source, errs[0].Source)
 }
 
@@ -43,7 +44,20 @@ func TestInfo_InvalidTOS(t *testing.T) {
 	errs := lint.Run(lint.NewConfig([]lint.Check{lint.InfoCheck}), source, loadFrom(t, source))
 	require.Len(t, errs, 1)
 	require.Equal(t, "info-invalid-terms-of-service", errs[0].Id)
-	require.Equal(t, lint.LEVEL_ERROR, errs[0].Level)
+	require.Equal(t, checker.ERR, errs[0].Level)
 	require.Equal(t, "terms of service must be in the format of a URL: bla", errs[0].Text)
 	require.Equal(t, source, errs[0].Source)
 }
+
+func TestInfo_InvalidContactAndLicense(t *testing.T) {
+
+	const source = "../data/lint/info/invalid-contact-and-license.yaml"
+	errs := lint.Run(lint.NewConfig([]lint.Check{lint.InfoCheck}), source, loadFrom(t, source))
+	require.Len(t, errs, 3)
+	require.Equal(t, "info-invalid-contact-email", errs[0].Id)
+	require.Equal(t, "contact email must be in the format of an email address: API Support <support@example.com>", errs[0].Text)
+	require.Equal(t, "info-invalid-contact-url", errs[1].Id)
+	require.Equal(t, "contact URL must be in the format of a URL: support", errs[1].Text)
+	require.Equal(t, "info-invalid-license-url", errs[2].Id)
+	require.Equal(t, "license URL must be in the format of a URL: apache-2.0", errs[2].Text)
+}
@@ -0,0 +1,418 @@
+package lint
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// jsonPath is a parsed JSONPath expression, like the given of a Spectral rule
+// It supports a subset of JSONPath:
+// - $ the root
+// - .name and ['name'] children, [0] indexes and [name1,name2] unions
+// - .* and [*] wildcards
+// - ..name, ..* and ..[...] recursive descent
+// - [?(...)] filters that compare @property, @ or @.path with a literal using ==, != and the truthiness of @.path, combined with && or ||
+// - a trailing ~ that selects the keys of the matched nodes instead of their values
+type jsonPath struct {
+	selectors []jsonPathSelector
+	keys      bool
+}
+
+type jsonPathSelector struct {
+	descendant bool
+	wildcard   bool
+	names      []string
+	filter     jsonPathFilter
+}
+
+// jsonPathMatch is a node that matches a JSONPath, key is the mapping key of the node if it has one
+type jsonPathMatch struct {
+	node    *yaml.Node
+	key     *yaml.Node
+	pointer jsonPointer
+}
+
+// line returns the line of the match, the line of the key if the node has a key
+func (match jsonPathMatch) line() int {
+	if match.key != nil {
+		return match.key.Line
+	}
+	return match.node.Line
+}
+
+func parseJSONPath(expression string) (*jsonPath, error) {
+	rest := strings.TrimSpace(expression)
+	if !strings.HasPrefix(rest, "$") {
+		return nil, fmt.Errorf("JSONPath %q must start with $", expression)
+	}
+	rest = rest[1:]
+
+	result := &jsonPath{}
+	if strings.HasSuffix(rest, "~") {
+		result.keys = true
+		rest = rest[:len(rest)-1]
+	}
+
+	for rest != "" {
+		selector := jsonPathSelector{}
+
+		switch {
+		case strings.HasPrefix(rest, ".."):
+			selector.descendant = true
+			rest = rest[2:]
+			if strings.HasPrefix(rest, "[") {
+				break
+			}
+			fallthrough
+		case strings.HasPrefix(rest, "."):
+			rest = strings.TrimPrefix(rest, ".")
+			end := strings.IndexAny(rest, ".[")
+			if end == -1 {
+				end = len(rest)
+			}
+			name := rest[:end]
+			rest = rest[end:]
+			if name == "" {
+				return nil, fmt.Errorf("JSONPath %q has an empty name", expression)
+			}
+			if name == "*" {
+				selector.wildcard = true
+			} else {
+				selector.names = []string{name}
+			}
+			result.selectors = append(result.selectors, selector)
+			continue
+		}
+
+		if !strings.HasPrefix(rest, "[") {
+			return nil, fmt.Errorf("JSONPath %q has an unsupported syntax at %q", expression, rest)
+		}
+
+		end := findBracketEnd(rest)
+		if end == -1 {
+			return nil, fmt.Errorf("JSONPath %q has an unclosed bracket", expression)
+		}
+		content := strings.TrimSpace(rest[1:end])
+		rest = rest[end+1:]
+
+		switch {
+		case content == "*":
+			selector.wildcard = true
+		case strings.HasPrefix(content, "?(") && strings.HasSuffix(content, ")"):
+			filter, err := parseJSONPathFilter(content[2 : len(content)-1])
+			if err != nil {
+				return nil, fmt.Errorf("JSONPath %q has an unsupported filter: %w", expression, err)
+			}
+			selector.filter = filter
+		default:
+			names, err := parseJSONPathNames(content)
+			if err != nil {
+				return nil, fmt.Errorf("JSONPath %q has an unsupported selector [%s]", expression, content)
+			}
+			selector.names = names
+		}
+		result.selectors = append(result.selectors, selector)
+	}
+
+	return result, nil
+}
+
+// findBracketEnd returns the index of the bracket that closes the bracket at the start of the expression, ignoring brackets in quotes
+func findBracketEnd(expression string) int {
+	depth := 0
+	var quote rune
+	for i, c := range expression {
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '[':
+			depth++
+		case c == ']':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// parseJSONPathNames parses the names of a bracket selector, like 'name', 0 or get,put
+func parseJSONPathNames(content string) ([]string, error) {
+	result := []string{}
+	for _, name := range strings.Split(content, ",") {
+		name = strings.TrimSpace(name)
+		if unquoted, ok := unquote(name); ok {
+			result = append(result, unquoted)
+			continue
+		}
+		if name == "" || strings.ContainsAny(name, "()?@$*'\"") {
+			return nil, fmt.Errorf("invalid name %q", name)
+		}
+		result = append(result, name)
+	}
+	return result, nil
+}
+
+func unquote(value string) (string, bool) {
+	if len(value) >= 2 && (value[0] == '\'' || value[0] == '"') && value[len(value)-1] == value[0] {
+		return value[1 : len(value)-1], true
+	}
+	return "", false
+}
+
+// find returns the nodes of the document that match the path
+func (path *jsonPath) find(document *yaml.Node) []jsonPathMatch {
+	matches := []jsonPathMatch{{node: document, pointer: newJSONPointer()}}
+	for _, selector := range path.selectors {
+		next := []jsonPathMatch{}
+		for _, match := range matches {
+			next = append(next, selector.apply(match)...)
+		}
+		matches = next
+	}
+
+	if !path.keys {
+		return matches
+	}
+
+	result := []jsonPathMatch{}
+	for _, match := range matches {
+		if match.key != nil {
+			result = append(result, jsonPathMatch{node: match.key, pointer: match.pointer})
+		}
+	}
+	return result
+}
+
+func (selector jsonPathSelector) apply(match jsonPathMatch) []jsonPathMatch {
+	if !selector.descendant {
+		return selector.selectChildren(match)
+	}
+
+	result := []jsonPathMatch{}
+	walkMatches(match, func(descendant jsonPathMatch) {
+		result = append(result, selector.selectChildren(descendant)...)
+	})
+	return result
+}
+
+// walkMatches calls visit for the match and for all its descendants
+func walkMatches(match jsonPathMatch, visit func(jsonPathMatch)) {
+	visit(match)
+	for _, child := range getChildren(match) {
+		walkMatches(child, visit)
+	}
+}
+
+func getChildren(match jsonPathMatch) []jsonPathMatch {
+	result := []jsonPathMatch{}
+	node := match.node
+	switch node.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key := node.Content[i]
+			result = append(result, jsonPathMatch{node: node.Content[i+1], key: key, pointer: match.pointer.add(key.Value)})
+		}
+	case yaml.SequenceNode:
+		for i, child := range node.Content {
+			result = append(result, jsonPathMatch{node: child, pointer: match.pointer.add(strconv.Itoa(i))})
+		}
+	}
+	return result
+}
+
+func (selector jsonPathSelector) selectChildren(match jsonPathMatch) []jsonPathMatch {
+	result := []jsonPathMatch{}
+	for _, child := range getChildren(match) {
+		if selector.matches(child) {
+			result = append(result, child)
+		}
+	}
+	return result
+}
+
+func (selector jsonPathSelector) matches(child jsonPathMatch) bool {
+	switch {
+	case selector.wildcard:
+		return true
+	case selector.filter != nil:
+		return selector.filter.matches(child)
+	}
+
+	name := child.pointer[len(child.pointer)-1]
+	for _, selected := range selector.names {
+		if selected == name {
+			return true
+		}
+	}
+	return false
+}
+
+// jsonPathFilter is a filter expression in disjunctive normal form: a list of alternatives that are each a list of conditions
+type jsonPathFilter [][]jsonPathCondition
+
+// jsonPathCondition compares an operand, like @property or @.name, with a literal, or checks the truthiness of the operand if there is no operator
+type jsonPathCondition struct {
+	negate   bool
+	operand  []string
+	property bool
+	operator string
+	literal  any
+}
+
+func parseJSONPathFilter(expression string) (jsonPathFilter, error) {
+	result := jsonPathFilter{}
+	for _, alternative := range strings.Split(expression, "||") {
+		conditions := []jsonPathCondition{}
+		for _, term := range strings.Split(alternative, "&&") {
+			condition, err := parseJSONPathCondition(strings.TrimSpace(term))
+			if err != nil {
+				return nil, err
+			}
+			conditions = append(conditions, condition)
+		}
+		result = append(result, conditions)
+	}
+	return result, nil
+}
+
+func parseJSONPathCondition(term string) (jsonPathCondition, error) {
+	result := jsonPathCondition{}
+
+	operand := term
+	// the strict operators of javascript are the same as the loose ones for the values of a document
+	for _, operator := range []string{"===", "!==", "==", "!="} {
+		if left, right, ok := strings.Cut(term, operator); ok {
+			literal, err := parseJSONPathLiteral(strings.TrimSpace(right))
+			if err != nil {
+				return result, err
+			}
+			operand = strings.TrimSpace(left)
+			result.operator = operator[:2]
+			result.literal = literal
+			break
+		}
+	}
+
+	if result.operator == "" && strings.HasPrefix(operand, "!") {
+		result.negate = true
+		operand = strings.TrimSpace(operand[1:])
+	}
+
+	switch {
+	case operand == "@property" || operand == "@key":
+		result.property = true
+	case operand == "@":
+		result.operand = []string{}
+	case jsonPathOperandRegexp.MatchString(operand):
+		result.operand = strings.Split(operand[2:], ".")
+	default:
+		return result, fmt.Errorf("unsupported operand %q", operand)
+	}
+
+	return result, nil
+}
+
+var jsonPathOperandRegexp = regexp.MustCompile(`^@(\.[\w$-]+)+$`)
+
+func parseJSONPathLiteral(literal string) (any, error) {
+	if unquoted, ok := unquote(literal); ok {
+		return unquoted, nil
+	}
+	switch literal {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	case "null":
+		return nil, nil
+	}
+	if number, err := strconv.ParseFloat(literal, 64); err == nil {
+		return number, nil
+	}
+	return nil, fmt.Errorf("unsupported literal %q", literal)
+}
+
+func (filter jsonPathFilter) matches(match jsonPathMatch) bool {
+	for _, conditions := range filter {
+		matched := true
+		for _, condition := range conditions {
+			if !condition.matches(match) {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
+func (condition jsonPathCondition) matches(match jsonPathMatch) bool {
+	var value any
+	var defined bool
+
+	if condition.property {
+		value, defined = match.pointer[len(match.pointer)-1], true
+		if number, err := strconv.ParseFloat(match.pointer[len(match.pointer)-1], 64); err == nil && match.key == nil {
+			value = number
+		}
+	} else if node := lookupNode(match.node, condition.operand...); node != nil {
+		value, defined = normalizeValue(decodeNode(node)), true
+	}
+
+	switch condition.operator {
+	case "==":
+		return defined && value == normalizeValue(condition.literal)
+	case "!=":
+		return !defined || value != normalizeValue(condition.literal)
+	}
+
+	return isTruthy(value, defined) != condition.negate
+}
+
+// normalizeValue converts numbers to float64 so that decoded values can be compared with literals
+func normalizeValue(value any) any {
+	switch v := value.(type) {
+	case int:
+		return float64(v)
+	case int64:
+		return float64(v)
+	case uint64:
+		return float64(v)
+	case map[string]any, []any:
+		// objects and arrays are never equal to a literal
+		return &v
+	}
+	return value
+}
+
+// isTruthy returns true if a value is truthy like in javascript, objects and arrays are always truthy
+func isTruthy(value any, defined bool) bool {
+	if !defined {
+		return false
+	}
+	switch v := value.(type) {
+	case nil:
+		return false
+	case bool:
+		return v
+	case string:
+		return v != ""
+	case int:
+		return v != 0
+	case float64:
+		return v != 0
+	}
+	return true
+}
@@ -0,0 +1,24 @@
+package lint
+
+import (
+	"io"
+	"os"
+
+	"github.com/oasdiff/oasdiff/checker"
+)
+
+// ProcessSeverityLevels reads a file with severity levels of lint rules and returns a map of severity levels
+func ProcessSeverityLevels(file string) (map[string]checker.Level, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return GetSeverityLevels(f)
+}
+
+// GetSeverityLevels reads severity levels of lint rules from a reader and returns a map of severity levels
+func GetSeverityLevels(source io.Reader) (map[string]checker.Level, error) {
+	return checker.ReadSeverityLevels(source, GetAllRuleIds())
+}
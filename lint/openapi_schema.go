@@ -0,0 +1,81 @@
+package lint
+
+import (
+	_ "embed"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/oasdiff/oasdiff/load"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	OpenAPISchemaInvalidId     = "openapi-schema-invalid"
+	JSONSchemaDialectInvalidId = "json-schema-dialect-invalid"
+)
+
+// The embedded schemas are subsets of the official OpenAPI JSON Schemas, see lint/schemas
+var (
+	//go:embed schemas/oas-3.0.json
+	openAPI30Schema []byte
+
+	//go:embed schemas/oas-3.1.json
+	openAPI31Schema []byte
+)
+
+var openAPISchemaValidators = map[string]func() (*schemaValidator, error){
+	"3.0": sync.OnceValues(func() (*schemaValidator, error) { return newSchemaValidator(openAPI30Schema) }),
+	"3.1": sync.OnceValues(func() (*schemaValidator, error) { return newSchemaValidator(openAPI31Schema) }),
+}
+
+// OpenAPISchemaCheck validates the raw document against the OpenAPI JSON Schema of its version, 3.0 or 3.1
+// It also validates that jsonSchemaDialect is a URI
+// The check works on the raw document, so it also runs on a SpecInfo without a loaded Spec
+// Each error includes the JSON Pointer and the line of the violation
+func OpenAPISchemaCheck(source string, s *load.SpecInfo) []*Error {
+	result := make([]*Error, 0)
+
+	if s == nil {
+		return result
+	}
+
+	document := getDocument(source, s)
+	if document == nil {
+		return result
+	}
+
+	version := getOpenAPISchemaVersion(document)
+	validator, err := openAPISchemaValidators[version]()
+	if err != nil {
+		return result
+	}
+
+	for _, violation := range validator.validate(document) {
+		err := newErrorAt(OpenAPISchemaInvalidId, source, violation.pointer, version, violation.message)
+		err.Line = violation.line
+		result = append(result, err)
+	}
+
+	if dialect := lookupNode(document, "jsonSchemaDialect"); dialect != nil && isStringNode(dialect) && !isURI(dialect.Value) {
+		err := newErrorAt(JSONSchemaDialectInvalidId, source, newJSONPointer("jsonSchemaDialect"), dialect.Value)
+		err.Line = dialect.Line
+		result = append(result, err)
+	}
+
+	return result
+}
+
+// getOpenAPISchemaVersion returns the version of the schema to validate the document with, 3.1 for OpenAPI 3.1.x and 3.0 otherwise
+func getOpenAPISchemaVersion(document *yaml.Node) string {
+	if openapi := lookupNode(document, "openapi"); openapi != nil && strings.HasPrefix(openapi.Value, "3.1") {
+		return "3.1"
+	}
+	return "3.0"
+}
+
+// isURI returns true if the value is an absolute URI
+func isURI(value string) bool {
+	u, err := url.Parse(value)
+	return err == nil && u.IsAbs()
+}
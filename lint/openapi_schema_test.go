@@ -0,0 +1,67 @@
+package lint_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/lint"
+	"github.com/oasdiff/oasdiff/load"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOpenAPISchema_Valid(t *testing.T) {
+
+	const source = "../data/lint/openapi.yaml"
+	require.Empty(t, lint.Run(lint.NewConfig([]lint.Check{lint.OpenAPISchemaCheck}), source, loadFrom(t, source)))
+}
+
+func TestOpenAPISchema_Invalid30(t *testing.T) {
+
+	const source = "../data/lint/openapi-schema/invalid-3.0.yaml"
+	errs := lint.Run(lint.NewConfig([]lint.Check{lint.OpenAPISchemaCheck}), source, &load.SpecInfo{Url: source})
+
+	texts := make([]string, len(errs))
+	for i, err := range errs {
+		require.Equal(t, lint.OpenAPISchemaInvalidId, err.Id)
+		require.Equal(t, checker.ERR, err.Level)
+		texts[i] = fmtLine(err)
+	}
+
+	require.Equal(t, []string{
+		`3: spec doesn't match the OpenAPI 3.0 schema: property "version" is required: /info/version`,
+		`9: spec doesn't match the OpenAPI 3.0 schema: value must be one of ["query","header","path","cookie"]: /paths/~1pets/get/parameters/0/in`,
+		`14: spec doesn't match the OpenAPI 3.0 schema: property "description" is required: /paths/~1pets/get/responses/200/description`,
+		`17: spec doesn't match the OpenAPI 3.0 schema: value must be one of ["array","boolean","integer","number","object","string"]: /paths/~1pets/get/responses/200/content/application~1json/schema/type`,
+		`20: spec doesn't match the OpenAPI 3.0 schema: property "responses" is required: /paths/~1owners/post/responses`,
+	}, texts)
+
+	require.Equal(t, "/info/version", errs[0].Pointer)
+	require.Equal(t, 3, errs[0].Line)
+}
+
+func TestOpenAPISchema_Valid31(t *testing.T) {
+
+	const source = "../data/lint/openapi-schema/valid-3.1.yaml"
+	require.Empty(t, lint.Run(lint.NewConfig([]lint.Check{lint.OpenAPISchemaCheck}), source, &load.SpecInfo{Url: source}))
+}
+
+func TestOpenAPISchema_Invalid31(t *testing.T) {
+
+	const source = "../data/lint/openapi-schema/invalid-3.1.yaml"
+	errs := lint.Run(lint.NewConfig([]lint.Check{lint.OpenAPISchemaCheck}), source, &load.SpecInfo{Url: source})
+
+	require.Len(t, errs, 2)
+
+	require.Equal(t, lint.JSONSchemaDialectInvalidId, errs[0].Id)
+	require.Equal(t, "jsonSchemaDialect must be in the format of a URI: base-dialect: /jsonSchemaDialect", errs[0].Text)
+	require.Equal(t, 5, errs[0].Line)
+
+	require.Equal(t, lint.OpenAPISchemaInvalidId, errs[1].Id)
+	require.Equal(t, `spec doesn't match the OpenAPI 3.1 schema: property "headers" isn't allowed: /paths/~1pets/get/responses/200/headers`, errs[1].Text)
+	require.Equal(t, 13, errs[1].Line)
+}
+
+func fmtLine(err *lint.Error) string {
+	return fmt.Sprintf("%d: %s", err.Line, err.Text)
+}
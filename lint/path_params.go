@@ -1,13 +1,18 @@
 package lint
 
 import (
-	"fmt"
-
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/oasdiff/oasdiff/load"
 	"github.com/oasdiff/oasdiff/utils"
 )
 
+const (
+	PathParamNotRequiredId = "path-param-not-required"
+	PathParamExtraId       = "path-param-extra"
+	PathParamMissingId     = "path-param-missing"
+	PathParamDuplicateId   = "path-param-duplicate"
+)
+
 func PathParamsCheck(source string, s *load.SpecInfo) []*Error {
 	result := make([]*Error, 0)
 
@@ -25,12 +30,7 @@ func PathParamsCheck(source string, s *load.SpecInfo) []*Error {
 			}
 
 			if !parameter.Value.Required {
-				result = append(result, &Error{
-					Id:     "path-param-not-required",
-					Level:  LEVEL_ERROR,
-					Text:   fmt.Sprintf("path parameter %q should have required=true: %s", parameter.Value.Name, path),
-					Source: source,
-				})
+				result = append(result, newError(PathParamNotRequiredId, source, parameter.Value.Name, path))
 			}
 
 			pathParams.Add(parameter.Value.Name)
@@ -59,50 +59,31 @@ func checkOperationPathParams(pathParamsFromURL, pathParams utils.StringSet, pat
 		}
 
 		if !parameter.Value.Required {
-			result = append(result, &Error{
-				Id:     "path-param-not-required",
-				Level:  LEVEL_ERROR,
-				Text:   fmt.Sprintf("path parameter %q should have required=true: %s %s", parameter.Value.Name, method, path),
-				Source: source,
-			})
+			result = append(result, newError(PathParamNotRequiredId, source, parameter.Value.Name, method+" "+path))
 		}
 
 		opParams.Add(parameter.Value.Name)
 	}
 
 	for param := range pathParams.Plus(opParams).Minus(pathParamsFromURL) {
-		result = append(result, &Error{
-			Id:     "path-param-extra",
-			Level:  LEVEL_ERROR,
-			Text:   getParamMissingText(opParams, param, method, path),
-			Source: source,
-		})
+		result = append(result, newError(PathParamExtraId, source, param, getParamSection(opParams, param), method+" "+path))
 	}
 
 	for param := range pathParamsFromURL.Minus(pathParams).Minus(opParams) {
-		result = append(result, &Error{
-			Id:     "path-param-missing",
-			Level:  LEVEL_WARN,
-			Text:   fmt.Sprintf("path parameter %q appears in the URL path but is missing from the parameters section of the path and operation: %s %s", param, method, path),
-			Source: source,
-		})
+		result = append(result, newError(PathParamMissingId, source, param, method+" "+path))
 	}
 
 	for param := range pathParams.Intersection(opParams) {
-		result = append(result, &Error{
-			Id:     "path-param-duplicate",
-			Level:  LEVEL_WARN,
-			Text:   fmt.Sprintf("path parameter %q is defined both in path and in operation: %s %s", param, method, path),
-			Source: source,
-		})
+		result = append(result, newError(PathParamDuplicateId, source, param, method+" "+path))
 	}
 
 	return result
 }
 
-func getParamMissingText(opParams utils.StringSet, param, method, path string) string {
+// getParamSection returns the section where an extra path parameter is defined: the operation or the path
+func getParamSection(opParams utils.StringSet, param string) string {
 	if opParams.Contains(param) {
-		return fmt.Sprintf("path parameter %q appears in the parameters section of the operation but is missing in the URL: %s %s", param, method, path)
+		return "operation"
 	}
-	return fmt.Sprintf("path parameter %q appears in the parameters section of the path but is missing in the URL: %s %s", param, method, path)
+	return "path"
 }
@@ -3,6 +3,7 @@ package lint_test
 import (
 	"testing"
 
+	"github.com/oasdiff/oasdiff/checker"
 	"github.com/oasdiff/oasdiff/lint"
 	"github.com/stretchr/testify/require"
 )
@@ -25,7 +26,7 @@ func TestPathParam_MethodExtra(t *testing.T) {
 	require.Len(t, errs, 1)
 	require.Equal(t, "path-param-extra", errs[0].Id)
 	require.Equal(t, "path parameter \"bookId\" appears in the parameters section of the operation but is missing in the URL: GET /books", errs[0].Text)
-	require.Equal(t, lint.LEVEL_ERROR, errs[0].Level)
+	require.Equal(t, checker.ERR, errs[0].Level)
 	require.Equal(t, source, errs[0].Source)
 }
 
@@ -35,7 +36,7 @@ func TestPathParam_PathExtra(t *testing.T) {
 	require.Len(t, errs, 1)
 	require.Equal(t, "path-param-extra", errs[0].Id)
 	require.Equal(t, "path parameter \"bookId\" appears in the parameters section of the path but is missing in the URL: GET /books", errs[0].Text)
-	require.Equal(t, lint.LEVEL_ERROR, errs[0].Level)
+	require.Equal(t, checker.ERR, errs[0].Level)
 	require.Equal(t, source, errs[0].Source)
 }
 
@@ -45,7 +46,7 @@ func TestPathParam_PathMissing(t *testing.T) {
 	require.Len(t, errs, 1)
 	require.Equal(t, "path-param-missing", errs[0].Id)
 	require.Equal(t, "path parameter \"bookId\" appears in the URL path but is missing from the parameters section of the path and operation: GET /books/{bookId}", errs[0].Text)
-	require.Equal(t, lint.LEVEL_WARN, errs[0].Level)
+	require.Equal(t, checker.WARN, errs[0].Level)
 	require.Equal(t, source, errs[0].Source)
 }
 
@@ -55,7 +56,7 @@ func TestPathParam_Duplicate(t *testing.T) {
 	require.Len(t, errs, 1)
 	require.Equal(t, "path-param-duplicate", errs[0].Id)
 	require.Equal(t, "path parameter \"bookId\" is defined both in path and in operation: GET /books/{bookId}", errs[0].Text)
-	require.Equal(t, lint.LEVEL_WARN, errs[0].Level)
+	require.Equal(t, checker.WARN, errs[0].Level)
 	require.Equal(t, source, errs[0].Source)
 }
 
@@ -65,6 +66,6 @@ func TestPathParam_NotRequired(t *testing.T) {
 	require.Len(t, errs, 1)
 	require.Equal(t, "path-param-not-required", errs[0].Id)
 	require.Equal(t, "path parameter \"bookId\" should have required=true: GET /books/{bookId}", errs[0].Text)
-	require.Equal(t, lint.LEVEL_ERROR, errs[0].Level)
+	require.Equal(t, checker.ERR, errs[0].Level)
 	require.Equal(t, source, errs[0].Source)
 }
@@ -0,0 +1,43 @@
+package lint
+
+import "strings"
+
+// jsonPointer is an RFC 6901 JSON Pointer to a location in the spec, for example /paths/~1pets/get
+type jsonPointer []string
+
+func newJSONPointer(tokens ...string) jsonPointer {
+	return jsonPointer(tokens)
+}
+
+// add returns a new pointer with the additional tokens
+func (p jsonPointer) add(tokens ...string) jsonPointer {
+	result := make(jsonPointer, 0, len(p)+len(tokens))
+	result = append(result, p...)
+	return append(result, tokens...)
+}
+
+var (
+	pointerEscaper   = strings.NewReplacer("~", "~0", "/", "~1")
+	pointerUnescaper = strings.NewReplacer("~1", "/", "~0", "~")
+)
+
+func (p jsonPointer) String() string {
+	var result strings.Builder
+	for _, token := range p {
+		result.WriteString("/")
+		result.WriteString(pointerEscaper.Replace(token))
+	}
+	return result.String()
+}
+
+// parsePointer parses a JSON Pointer, like the fragment of a local $ref
+func parsePointer(pointer string) jsonPointer {
+	result := newJSONPointer()
+	if pointer == "" {
+		return result
+	}
+	for _, token := range strings.Split(strings.TrimPrefix(pointer, "/"), "/") {
+		result = append(result, pointerUnescaper.Replace(token))
+	}
+	return result
+}
@@ -4,18 +4,15 @@ import (
 	"regexp"
 )
 
+const InvalidRegexPatternId = "invalid-regex-pattern"
+
 func checkRegex(pattern string, s *state) *Error {
 	if pattern == "" {
 		return nil
 	}
 
 	if err := validate(s.cache, pattern); err != nil {
-		return &Error{
-			Id:     "invalid-regex-pattern",
-			Level:  LEVEL_ERROR,
-			Text:   err.Error(),
-			Source: s.source,
-		}
+		return newError(InvalidRegexPatternId, s.source, err)
 	}
 
 	return nil
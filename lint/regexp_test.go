@@ -3,6 +3,7 @@ package lint_test
 import (
 	"testing"
 
+	"github.com/oasdiff/oasdiff/checker"
 	"github.com/oasdiff/oasdiff/lint"
 	"github.com/stretchr/testify/require"
 )
@@ -13,7 +14,7 @@ func TestRegexCheck(t *testing.T) {
 	errs := lint.Run(lint.NewConfig([]lint.Check{lint.SchemaCheck}), source, loadFrom(t, source))
 	require.Len(t, errs, 1)
 	require.Equal(t, "invalid-regex-pattern", errs[0].Id)
-	require.Equal(t, lint.LEVEL_ERROR, errs[0].Level)
+	require.Equal(t, checker.ERR, errs[0].Level)
 	require.Equal(t, source, errs[0].Source)
 }
 
@@ -24,7 +25,7 @@ func TestRegexCheck_Embedded(t *testing.T) {
 	require.Len(t, errs, 7)
 	for i := range errs {
 		require.Equal(t, "invalid-regex-pattern", errs[i].Id)
-		require.Equal(t, lint.LEVEL_ERROR, errs[i].Level)
+		require.Equal(t, checker.ERR, errs[i].Level)
 		require.Equal(t, source, errs[i].Source)
 	}
 }
@@ -1,12 +1,19 @@
 package lint
 
 import (
-	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/oasdiff/oasdiff/load"
+	"gopkg.in/yaml.v3"
 )
 
+const RequiredParamWithDefaultId = "required-param-with-default"
+
+// RequiredParamsCheck reports required parameters with a default value, since the default is never used
+// Each error includes the JSON Pointer and the line of the default value
+// Referenced parameters are reported once, under components
 func RequiredParamsCheck(source string, s *load.SpecInfo) []*Error {
 	result := make([]*Error, 0)
 
@@ -14,46 +21,64 @@ func RequiredParamsCheck(source string, s *load.SpecInfo) []*Error {
 		return result
 	}
 
-	for path, pathItem := range s.Spec.Paths.Map() {
-		for _, parameter := range pathItem.Parameters {
-			if !parameter.Value.Required {
-				continue
-			}
+	v := requiredParamsValidator{
+		source:   source,
+		document: getDocument(source, s),
+		result:   result,
+	}
 
-			if parameter.Value.Schema != nil && parameter.Value.Schema.Value.Default != nil {
-				result = append(result, &Error{
-					Id:     "required-param-with-default",
-					Level:  LEVEL_ERROR,
-					Text:   fmt.Sprintf("required path parameter %q shouldn't have a default value: %s", parameter.Value.Name, path),
-					Source: source,
-				})
+	if s.Spec.Paths != nil {
+		for _, path := range s.Spec.Paths.InMatchingOrder() {
+			pathItem := s.Spec.Paths.Value(path)
+			pointer := newJSONPointer("paths", path)
+			v.checkParameters(pathItem.Parameters, pointer.add("parameters"))
+			operations := pathItem.Operations()
+			for _, method := range sortedKeys(operations) {
+				v.checkParameters(operations[method].Parameters, pointer.add(strings.ToLower(method), "parameters"))
 			}
 		}
-		for method, op := range pathItem.Operations() {
-			result = append(result, checkOperationRequiredParams(path, method, op, source)...)
+	}
+
+	if components := s.Spec.Components; components != nil {
+		for _, name := range sortedKeys(components.Parameters) {
+			if parameter := components.Parameters[name]; parameter != nil && parameter.Ref == "" {
+				v.checkParameter(parameter.Value, newJSONPointer("components", "parameters", name))
+			}
 		}
 	}
 
-	return result
+	return v.result
 }
 
-func checkOperationRequiredParams(path, method string, op *openapi3.Operation, source string) []*Error {
-	result := make([]*Error, 0)
+type requiredParamsValidator struct {
+	source   string
+	document *yaml.Node
+	result   []*Error
+}
 
-	for _, parameter := range op.Parameters {
-		if !parameter.Value.Required {
+func (v *requiredParamsValidator) checkParameters(parameters openapi3.Parameters, pointer jsonPointer) {
+	for i, parameter := range parameters {
+		// referenced parameters are checked under components
+		if parameter == nil || parameter.Ref != "" {
 			continue
 		}
+		v.checkParameter(parameter.Value, pointer.add(strconv.Itoa(i)))
+	}
+}
 
-		if parameter.Value.Schema != nil && parameter.Value.Schema.Value.Default != nil {
-			result = append(result, &Error{
-				Id:     "required-param-with-default",
-				Level:  LEVEL_ERROR,
-				Text:   fmt.Sprintf("required path parameter %q shouldn't have a default value: %s %s", parameter.Value.Name, method, path),
-				Source: source,
-			})
-		}
+func (v *requiredParamsValidator) checkParameter(parameter *openapi3.Parameter, pointer jsonPointer) {
+	if parameter == nil || !parameter.Required || parameter.Schema == nil || parameter.Schema.Value == nil || parameter.Schema.Value.Default == nil {
+		return
 	}
 
-	return result
+	pointer = pointer.add("schema", "default")
+	if ref := parameter.Schema.Ref; strings.HasPrefix(ref, "#/") {
+		// the default is in the referenced schema
+		pointer = parsePointer(ref[1:]).add("default")
+	}
+	err := newErrorAt(RequiredParamWithDefaultId, v.source, pointer, parameter.In, parameter.Name)
+	if node := resolvePointer(v.document, pointer.String()); node != nil {
+		err.Line = node.Line
+	}
+	v.result = append(v.result, err)
 }
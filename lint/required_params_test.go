@@ -3,6 +3,7 @@ package lint_test
 import (
 	"testing"
 
+	"github.com/oasdiff/oasdiff/checker"
 	"github.com/oasdiff/oasdiff/lint"
 	"github.com/stretchr/testify/require"
 )
@@ -18,8 +19,9 @@ func TestRequiredParam_PathWithDefault(t *testing.T) {
 	errs := lint.Run(lint.NewConfig([]lint.Check{lint.RequiredParamsCheck}), source, loadFrom(t, source))
 	require.Len(t, errs, 1)
 	require.Equal(t, "required-param-with-default", errs[0].Id)
-	require.Equal(t, "required path parameter \"bookId\" shouldn't have a default value: /books/{bookId}", errs[0].Text)
-	require.Equal(t, lint.LEVEL_ERROR, errs[0].Level)
+	require.Equal(t, "required path parameter \"bookId\" shouldn't have a default value: /paths/~1books~1{bookId}/parameters/0/schema/default", errs[0].Text)
+	require.Equal(t, 12, errs[0].Line)
+	require.Equal(t, checker.WARN, errs[0].Level)
 	require.Equal(t, source, errs[0].Source)
 }
 
@@ -28,7 +30,18 @@ func TestRequiredParam_MethodWithDefault(t *testing.T) {
 	errs := lint.Run(lint.NewConfig([]lint.Check{lint.RequiredParamsCheck}), source, loadFrom(t, source))
 	require.Len(t, errs, 1)
 	require.Equal(t, "required-param-with-default", errs[0].Id)
-	require.Equal(t, "required path parameter \"bookId\" shouldn't have a default value: GET /books/{bookId}", errs[0].Text)
-	require.Equal(t, lint.LEVEL_ERROR, errs[0].Level)
+	require.Equal(t, "required path parameter \"bookId\" shouldn't have a default value: /paths/~1books~1{bookId}/get/parameters/0/schema/default", errs[0].Text)
+	require.Equal(t, 13, errs[0].Line)
+	require.Equal(t, checker.WARN, errs[0].Level)
 	require.Equal(t, source, errs[0].Source)
 }
+
+func TestRequiredParam_ComponentsWithDefault(t *testing.T) {
+	const source = "../data/lint/required-params/components_with_default.yaml"
+	errs := lint.Run(lint.NewConfig([]lint.Check{lint.RequiredParamsCheck}), source, loadFrom(t, source))
+	require.Len(t, errs, 2)
+	require.Equal(t, "required query parameter \"limit\" shouldn't have a default value: /components/parameters/limit/schema/default", errs[0].Text)
+	require.Equal(t, 32, errs[0].Line)
+	require.Equal(t, "required header parameter \"X-Tenant\" shouldn't have a default value: /components/schemas/Tenant/default", errs[1].Text)
+	require.Equal(t, 36, errs[1].Line)
+}
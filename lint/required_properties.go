@@ -1,12 +1,12 @@
 package lint
 
 import (
-	"fmt"
-
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/oasdiff/oasdiff/utils"
 )
 
+const ExtraRequiredPropsId = "extra_required_props"
+
 func checkRequireProperties(schema *openapi3.Schema, s *state) *Error {
 	if schema == nil {
 		return nil
@@ -19,12 +19,7 @@ func checkRequireProperties(schema *openapi3.Schema, s *state) *Error {
 	}
 
 	if extraRequiredProps := requiredProps.Minus(props); !extraRequiredProps.Empty() {
-		return &Error{
-			Id:     "extra_required_props",
-			Level:  LEVEL_ERROR,
-			Text:   fmt.Sprintf("none-existing properties %v defined as required", extraRequiredProps.ToStringList()),
-			Source: s.source,
-		}
+		return newError(ExtraRequiredPropsId, s.source, extraRequiredProps.ToStringList())
 	}
 
 	return nil
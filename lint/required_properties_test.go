@@ -3,6 +3,7 @@ package lint_test
 import (
 	"testing"
 
+	"github.com/oasdiff/oasdiff/checker"
 	"github.com/oasdiff/oasdiff/lint"
 	"github.com/stretchr/testify/require"
 )
@@ -20,6 +21,6 @@ func TestRequirePropertiesCheck_Extra(t *testing.T) {
 	errs := lint.Run(lint.NewConfig([]lint.Check{lint.SchemaCheck}), source, loadFrom(t, source))
 	require.Len(t, errs, 1)
 	require.Equal(t, "extra_required_props", errs[0].Id)
-	require.Equal(t, lint.LEVEL_ERROR, errs[0].Level)
+	require.Equal(t, checker.ERR, errs[0].Level)
 	require.Equal(t, source, errs[0].Source)
 }
@@ -0,0 +1,122 @@
+package lint
+
+import (
+	"fmt"
+
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/utils"
+)
+
+type Rule struct {
+	Id          string
+	Level       checker.Level
+	Description string
+	Handler     Check
+}
+
+func newRule(id string, level checker.Level, handler Check) Rule {
+	return Rule{
+		Id:          id,
+		Level:       level,
+		Description: descriptionId(id),
+		Handler:     handler,
+	}
+}
+
+type Rules []Rule
+
+// GetAllRules returns the built-in lint rules with their default levels
+func GetAllRules() Rules {
+	return Rules{
+		// InfoCheck
+		newRule(InfoMissingId, checker.ERR, InfoCheck),
+		newRule(InfoTitleMissingId, checker.ERR, InfoCheck),
+		newRule(InfoVersionMissingId, checker.ERR, InfoCheck),
+		newRule(InfoInvalidTermsOfServiceId, checker.ERR, InfoCheck),
+		newRule(InfoInvalidContactURLId, checker.ERR, InfoCheck),
+		newRule(InfoInvalidContactEmailId, checker.ERR, InfoCheck),
+		newRule(InfoInvalidLicenseURLId, checker.ERR, InfoCheck),
+		// SchemaCheck
+		newRule(InvalidRegexPatternId, checker.ERR, SchemaCheck),
+		newRule(ExtraRequiredPropsId, checker.ERR, SchemaCheck),
+		// PathParamsCheck
+		newRule(PathParamNotRequiredId, checker.ERR, PathParamsCheck),
+		newRule(PathParamExtraId, checker.ERR, PathParamsCheck),
+		newRule(PathParamMissingId, checker.WARN, PathParamsCheck),
+		newRule(PathParamDuplicateId, checker.WARN, PathParamsCheck),
+		// RequiredParamsCheck
+		newRule(RequiredParamWithDefaultId, checker.WARN, RequiredParamsCheck),
+		// ExamplesCheck
+		newRule(ParameterExampleInvalidId, checker.ERR, ExamplesCheck),
+		newRule(HeaderExampleInvalidId, checker.ERR, ExamplesCheck),
+		newRule(MediaTypeExampleInvalidId, checker.ERR, ExamplesCheck),
+		newRule(SchemaExampleInvalidId, checker.ERR, ExamplesCheck),
+		// DefaultsCheck
+		newRule(ParameterDefaultInvalidId, checker.ERR, DefaultsCheck),
+		newRule(HeaderDefaultInvalidId, checker.ERR, DefaultsCheck),
+		newRule(PropertyDefaultInvalidId, checker.ERR, DefaultsCheck),
+		newRule(SchemaDefaultInvalidId, checker.ERR, DefaultsCheck),
+		newRule(RequiredPropertyDefaultId, checker.WARN, DefaultsCheck),
+		// EndpointsCheck
+		newRule(DuplicatePathId, checker.ERR, EndpointsCheck),
+		newRule(DuplicateEndpointId, checker.ERR, EndpointsCheck),
+		newRule(DuplicateEndpointParamNamesId, checker.ERR, EndpointsCheck),
+		newRule(AmbiguousEndpointsId, checker.WARN, EndpointsCheck),
+		// StructureCheck
+		newRule(ParameterSchemaAndContentId, checker.ERR, StructureCheck),
+		newRule(HeaderSchemaAndContentId, checker.ERR, StructureCheck),
+		newRule(DuplicateRequiredPropertyId, checker.ERR, StructureCheck),
+		newRule(DuplicatePropertyId, checker.ERR, StructureCheck),
+		newRule(UnresolvedRefId, checker.ERR, StructureCheck),
+		newRule(PathItemRefSiblingsId, checker.ERR, StructureCheck),
+		// OpenAPISchemaCheck
+		newRule(OpenAPISchemaInvalidId, checker.ERR, OpenAPISchemaCheck),
+		newRule(JSONSchemaDialectInvalidId, checker.ERR, OpenAPISchemaCheck),
+	}
+}
+
+// GetAllChecks returns the checks of all the built-in lint rules
+func GetAllChecks() []Check {
+	return rulesToChecks(GetAllRules())
+}
+
+// GetRuleLevels returns the default levels of the built-in lint rules, including the style rules
+func GetRuleLevels() map[string]checker.Level {
+	result := map[string]checker.Level{}
+	for _, rule := range append(GetAllRules(), GetStyleRules()...) {
+		result[rule.Id] = rule.Level
+	}
+	return result
+}
+
+// GetAllRuleIds returns the ids of the built-in lint rules, including the style rules
+func GetAllRuleIds() []string {
+	result := []string{}
+	for _, rule := range append(GetAllRules(), GetStyleRules()...) {
+		result = append(result, rule.Id)
+	}
+	return result
+}
+
+// rulesToChecks return a unique list of checks from a list of rules
+func rulesToChecks(rules Rules) []Check {
+	result := []Check{}
+	m := utils.StringSet{}
+	for _, rule := range rules {
+		// functions are not comparable, so we convert them to strings
+		pStr := fmt.Sprintf("%v", rule.Handler)
+		if !m.Contains(pStr) {
+			m.Add(pStr)
+			result = append(result, rule.Handler)
+		}
+	}
+	return result
+}
+
+func commentId(id string) string {
+	return id + "-comment"
+}
+
+func descriptionId(id string) string {
+	return id + "-description"
+}
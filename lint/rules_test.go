@@ -0,0 +1,89 @@
+package lint_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/lint"
+	"github.com/oasdiff/oasdiff/load"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRules_UniqueIds(t *testing.T) {
+	ids := lint.GetAllRuleIds()
+	seen := map[string]bool{}
+	for _, id := range ids {
+		require.False(t, seen[id], "duplicate rule id %s", id)
+		seen[id] = true
+	}
+}
+
+func TestRules_Localized(t *testing.T) {
+	l := checker.NewDefaultLocalizer()
+	for _, rule := range lint.GetAllRules() {
+		require.NotEqual(t, rule.Id, l(rule.Id), "missing message for %s", rule.Id)
+		require.NotEqual(t, rule.Description, l(rule.Description), "missing description for %s", rule.Id)
+	}
+}
+
+func TestRules_Checks(t *testing.T) {
+	require.Len(t, lint.GetAllChecks(), 9)
+}
+
+func TestRun_SeverityLevels(t *testing.T) {
+	const source = "../data/lint/path-params/duplicate.yaml"
+	levels, err := lint.ProcessSeverityLevels("../data/lint/severity-levels.txt")
+	require.NoError(t, err)
+
+	errs := lint.Run(lint.NewConfig([]lint.Check{lint.PathParamsCheck}).WithSeverityLevels(levels), source, loadFrom(t, source))
+	require.Len(t, errs, 1)
+	require.Equal(t, lint.PathParamDuplicateId, errs[0].Id)
+	require.Equal(t, checker.ERR, errs[0].Level)
+}
+
+func TestRun_SeverityLevelNone(t *testing.T) {
+	const source = "../data/lint/path-params/duplicate.yaml"
+	config := lint.NewConfig([]lint.Check{lint.PathParamsCheck}).WithSeverityLevels(map[string]checker.Level{lint.PathParamDuplicateId: checker.NONE})
+	require.Empty(t, lint.Run(config, source, loadFrom(t, source)))
+}
+
+func TestGetSeverityLevels_InvalidId(t *testing.T) {
+	_, err := lint.GetSeverityLevels(strings.NewReader("api-path-removed-without-deprecation info"))
+	require.EqualError(t, err, `invalid rule id "api-path-removed-without-deprecation" on line 1`)
+}
+
+func TestRun_Localized(t *testing.T) {
+	const source = "../data/lint/info/no-info.yaml"
+	config := lint.NewConfig([]lint.Check{lint.InfoCheck}).WithLocalizer(checker.NewLocalizer("ru"))
+	errs := lint.Run(config, source, loadFrom(t, source))
+	require.Len(t, errs, 1)
+	require.Equal(t, "отсутствует объект info", errs[0].Text)
+	require.Equal(t, "Рекомендуется включать в спецификацию общую информацию о вашем API. Поля Title и Version обязательны.", errs[0].Comment)
+}
+
+func TestRun_CustomCheck(t *testing.T) {
+	const source = "../data/lint/openapi.yaml"
+	custom := func(source string, _ *load.SpecInfo) []*lint.Error {
+		return []*lint.Error{{Id: "custom", Text: "custom text", Level: checker.INFO, Source: source}}
+	}
+
+	errs := lint.Run(lint.NewConfig([]lint.Check{custom}), source, loadFrom(t, source))
+	require.Len(t, errs, 1)
+	require.Equal(t, "custom text", errs[0].Text)
+	require.Equal(t, checker.INFO, errs[0].Level)
+}
+
+func TestProcessIgnoredErrors(t *testing.T) {
+	const source = "../data/lint/path-params/duplicate.yaml"
+	errs := lint.Run(lint.NewConfig([]lint.Check{lint.PathParamsCheck}), source, loadFrom(t, source))
+	require.Len(t, errs, 1)
+
+	result, err := lint.ProcessIgnoredErrors(checker.ERR, errs, "../data/lint/ignore-warn-example.txt")
+	require.NoError(t, err)
+	require.Len(t, result, 1)
+
+	result, err = lint.ProcessIgnoredErrors(checker.WARN, errs, "../data/lint/ignore-warn-example.txt")
+	require.NoError(t, err)
+	require.Empty(t, result)
+}
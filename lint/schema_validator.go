@@ -0,0 +1,436 @@
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// schemaValidator validates a raw document against a JSON Schema
+// It supports the subset of JSON Schema that is used by the embedded OpenAPI schemas:
+// $ref, type, enum, const, required, properties, patternProperties, additionalProperties, min/maxProperties,
+// items, min/maxItems, uniqueItems, minimum, maximum, min/maxLength, pattern, allOf, anyOf, oneOf, not and if/then/else
+// Like in recent JSON Schema drafts, format is an annotation and isn't validated
+type schemaValidator struct {
+	root    map[string]any
+	regexps map[string]*regexp.Regexp
+}
+
+// schemaViolation is a location in the document that doesn't match the schema
+type schemaViolation struct {
+	pointer jsonPointer
+	line    int
+	message string
+}
+
+func newSchemaValidator(schema []byte) (*schemaValidator, error) {
+	var root map[string]any
+	if err := json.Unmarshal(schema, &root); err != nil {
+		return nil, err
+	}
+	return &schemaValidator{
+		root:    root,
+		regexps: map[string]*regexp.Regexp{},
+	}, nil
+}
+
+func (v *schemaValidator) validate(node *yaml.Node) []schemaViolation {
+	return v.validateNode(v.root, node, newJSONPointer())
+}
+
+func (v *schemaValidator) validateNode(schema any, node *yaml.Node, pointer jsonPointer) []schemaViolation {
+	for node.Kind == yaml.AliasNode && node.Alias != nil {
+		node = node.Alias
+	}
+
+	s, ok := schema.(map[string]any)
+	if !ok {
+		if allowed, isBool := schema.(bool); isBool && !allowed {
+			return []schemaViolation{newSchemaViolation(node, pointer, "value isn't allowed")}
+		}
+		return nil
+	}
+
+	if ref, ok := s["$ref"].(string); ok {
+		return v.validateNode(v.resolve(ref), node, pointer)
+	}
+
+	result := []schemaViolation{}
+	add := func(message string, args ...any) {
+		result = append(result, newSchemaViolation(node, pointer, fmt.Sprintf(message, args...)))
+	}
+
+	if types := getSchemaTypes(s); len(types) > 0 && !matchesType(node, types) {
+		add("value must be %s", strings.Join(types, " or "))
+		return result
+	}
+
+	if enum, ok := s["enum"].([]any); ok && !containsValue(enum, node) {
+		add("value must be one of %s", formatValue(enum))
+	}
+
+	if value, ok := s["const"]; ok && !containsValue([]any{value}, node) {
+		add("value must be %s", formatValue(value))
+	}
+
+	switch node.Kind {
+	case yaml.MappingNode:
+		result = append(result, v.validateObject(s, node, pointer)...)
+	case yaml.SequenceNode:
+		result = append(result, v.validateArray(s, node, pointer)...)
+	case yaml.ScalarNode:
+		result = append(result, v.validateScalar(s, node, pointer)...)
+	}
+
+	for _, subSchema := range getSchemaList(s, "allOf") {
+		result = append(result, v.validateNode(subSchema, node, pointer)...)
+	}
+
+	if anyOf := getSchemaList(s, "anyOf"); len(anyOf) > 0 {
+		result = append(result, v.validateAnyOf(anyOf, node, pointer)...)
+	}
+
+	if oneOf := getSchemaList(s, "oneOf"); len(oneOf) > 0 {
+		result = append(result, v.validateOneOf(oneOf, node, pointer)...)
+	}
+
+	if not, ok := s["not"]; ok && len(v.validateNode(not, node, pointer)) == 0 {
+		if exclusive := getExclusiveProperties(not); len(exclusive) > 0 {
+			add("properties %s are mutually exclusive", strings.Join(exclusive, " and "))
+		} else {
+			add("value must not match the schema")
+		}
+	}
+
+	if condition, ok := s["if"]; ok {
+		if len(v.validateNode(condition, node, pointer)) == 0 {
+			if then, ok := s["then"]; ok {
+				result = append(result, v.validateNode(then, node, pointer)...)
+			}
+		} else if otherwise, ok := s["else"]; ok {
+			result = append(result, v.validateNode(otherwise, node, pointer)...)
+		}
+	}
+
+	return result
+}
+
+func (v *schemaValidator) validateObject(s map[string]any, node *yaml.Node, pointer jsonPointer) []schemaViolation {
+	result := []schemaViolation{}
+
+	if required, ok := s["required"].([]any); ok {
+		for _, name := range required {
+			if name, ok := name.(string); ok && lookupNode(node, name) == nil {
+				result = append(result, newSchemaViolation(node, pointer.add(name), fmt.Sprintf("property %q is required", name)))
+			}
+		}
+	}
+
+	count := len(node.Content) / 2
+	if min, ok := getSchemaNumber(s, "minProperties"); ok && float64(count) < min {
+		result = append(result, newSchemaViolation(node, pointer, fmt.Sprintf("value must have at least %v properties", min)))
+	}
+	if max, ok := getSchemaNumber(s, "maxProperties"); ok && float64(count) > max {
+		result = append(result, newSchemaViolation(node, pointer, fmt.Sprintf("value must have at most %v properties", max)))
+	}
+
+	properties, _ := s["properties"].(map[string]any)
+	patternProperties, _ := s["patternProperties"].(map[string]any)
+	additionalProperties, hasAdditionalProperties := s["additionalProperties"]
+
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		key, value := node.Content[i], node.Content[i+1]
+		valuePointer := pointer.add(key.Value)
+
+		matched := false
+		if property, ok := properties[key.Value]; ok {
+			matched = true
+			result = append(result, v.validateNode(property, value, valuePointer)...)
+		}
+
+		for _, pattern := range sortedKeys(patternProperties) {
+			if v.matchPattern(pattern, key.Value) {
+				matched = true
+				result = append(result, v.validateNode(patternProperties[pattern], value, valuePointer)...)
+			}
+		}
+
+		if matched || !hasAdditionalProperties {
+			continue
+		}
+
+		if allowed, ok := additionalProperties.(bool); ok && !allowed {
+			result = append(result, newSchemaViolation(key, valuePointer, fmt.Sprintf("property %q isn't allowed", key.Value)))
+			continue
+		}
+		result = append(result, v.validateNode(additionalProperties, value, valuePointer)...)
+	}
+
+	return result
+}
+
+func (v *schemaValidator) validateArray(s map[string]any, node *yaml.Node, pointer jsonPointer) []schemaViolation {
+	result := []schemaViolation{}
+
+	count := len(node.Content)
+	if min, ok := getSchemaNumber(s, "minItems"); ok && float64(count) < min {
+		result = append(result, newSchemaViolation(node, pointer, fmt.Sprintf("value must have at least %v items", min)))
+	}
+	if max, ok := getSchemaNumber(s, "maxItems"); ok && float64(count) > max {
+		result = append(result, newSchemaViolation(node, pointer, fmt.Sprintf("value must have at most %v items", max)))
+	}
+
+	if unique, _ := s["uniqueItems"].(bool); unique {
+		items := map[string]struct{}{}
+		for _, item := range node.Content {
+			value := formatValue(decodeNode(item))
+			if _, ok := items[value]; ok {
+				result = append(result, newSchemaViolation(item, pointer, "items must be unique"))
+				break
+			}
+			items[value] = struct{}{}
+		}
+	}
+
+	if items, ok := s["items"]; ok {
+		for i, item := range node.Content {
+			result = append(result, v.validateNode(items, item, pointer.add(strconv.Itoa(i)))...)
+		}
+	}
+
+	return result
+}
+
+func (v *schemaValidator) validateScalar(s map[string]any, node *yaml.Node, pointer jsonPointer) []schemaViolation {
+	result := []schemaViolation{}
+	add := func(message string, args ...any) {
+		result = append(result, newSchemaViolation(node, pointer, fmt.Sprintf(message, args...)))
+	}
+
+	if node.Tag == "!!int" || node.Tag == "!!float" {
+		number, err := strconv.ParseFloat(node.Value, 64)
+		if err != nil {
+			return result
+		}
+		if min, ok := getSchemaNumber(s, "minimum"); ok && number < min {
+			add("value must be at least %v", min)
+		}
+		if max, ok := getSchemaNumber(s, "maximum"); ok && number > max {
+			add("value must be at most %v", max)
+		}
+		return result
+	}
+
+	if !isStringNode(node) {
+		return result
+	}
+
+	length := float64(len([]rune(node.Value)))
+	if min, ok := getSchemaNumber(s, "minLength"); ok && length < min {
+		add("string must have at least %v characters", min)
+	}
+	if max, ok := getSchemaNumber(s, "maxLength"); ok && length > max {
+		add("string must have at most %v characters", max)
+	}
+	if pattern, ok := s["pattern"].(string); ok && !v.matchPattern(pattern, node.Value) {
+		add("string doesn't match the pattern %q", pattern)
+	}
+
+	return result
+}
+
+// validateAnyOf reports the violations of the best matching schema if the node doesn't match any of the schemas
+func (v *schemaValidator) validateAnyOf(schemas []any, node *yaml.Node, pointer jsonPointer) []schemaViolation {
+	best := []schemaViolation(nil)
+	bestFits := false
+	for _, schema := range schemas {
+		violations := v.validateNode(schema, node, pointer)
+		if len(violations) == 0 {
+			return nil
+		}
+		fits := v.fits(schema, node)
+		if best == nil || (fits && !bestFits) || (fits == bestFits && len(violations) < len(best)) {
+			best, bestFits = violations, fits
+		}
+	}
+	return best
+}
+
+func (v *schemaValidator) validateOneOf(schemas []any, node *yaml.Node, pointer jsonPointer) []schemaViolation {
+	matches := 0
+	for _, schema := range schemas {
+		if len(v.validateNode(schema, node, pointer)) == 0 {
+			matches++
+		}
+	}
+
+	switch matches {
+	case 0:
+		return v.validateAnyOf(schemas, node, pointer)
+	case 1:
+		return nil
+	}
+	return []schemaViolation{newSchemaViolation(node, pointer, "value matches more than one of the allowed schemas")}
+}
+
+// fits returns true if the node has the type and the required properties of the schema
+// It is used to pick the schema that the author most likely meant, in order to report its violations
+func (v *schemaValidator) fits(schema any, node *yaml.Node) bool {
+	s, ok := schema.(map[string]any)
+	if !ok {
+		return true
+	}
+
+	if ref, ok := s["$ref"].(string); ok {
+		return v.fits(v.resolve(ref), node)
+	}
+
+	if types := getSchemaTypes(s); len(types) > 0 && !matchesType(node, types) {
+		return false
+	}
+
+	if required, ok := s["required"].([]any); ok && node.Kind == yaml.MappingNode {
+		for _, name := range required {
+			if name, ok := name.(string); ok && lookupNode(node, name) == nil {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// resolve returns the schema of a local reference like #/definitions/Info or #/$defs/info
+func (v *schemaValidator) resolve(ref string) any {
+	var result any = v.root
+	for _, token := range strings.Split(strings.TrimPrefix(ref, "#/"), "/") {
+		m, ok := result.(map[string]any)
+		if !ok {
+			return nil
+		}
+		result = m[pointerUnescaper.Replace(token)]
+	}
+	return result
+}
+
+func (v *schemaValidator) matchPattern(pattern, value string) bool {
+	r, ok := v.regexps[pattern]
+	if !ok {
+		var err error
+		if r, err = regexp.Compile(pattern); err != nil {
+			return true
+		}
+		v.regexps[pattern] = r
+	}
+	return r.MatchString(value)
+}
+
+func newSchemaViolation(node *yaml.Node, pointer jsonPointer, message string) schemaViolation {
+	return schemaViolation{
+		pointer: pointer,
+		line:    node.Line,
+		message: message,
+	}
+}
+
+// getExclusiveProperties returns the quoted property names of a schema like {"required": ["example", "examples"]}, which is used with not to define mutually exclusive properties
+func getExclusiveProperties(schema any) []string {
+	s, ok := schema.(map[string]any)
+	if !ok || len(s) != 1 {
+		return nil
+	}
+
+	result := []string{}
+	for _, name := range getSchemaList(s, "required") {
+		result = append(result, strconv.Quote(fmt.Sprint(name)))
+	}
+	return result
+}
+
+func getSchemaTypes(s map[string]any) []string {
+	switch t := s["type"].(type) {
+	case string:
+		return []string{t}
+	case []any:
+		result := []string{}
+		for _, item := range t {
+			if item, ok := item.(string); ok {
+				result = append(result, item)
+			}
+		}
+		return result
+	}
+	return nil
+}
+
+func getSchemaList(s map[string]any, keyword string) []any {
+	result, _ := s[keyword].([]any)
+	return result
+}
+
+func getSchemaNumber(s map[string]any, keyword string) (float64, bool) {
+	result, ok := s[keyword].(float64)
+	return result, ok
+}
+
+func matchesType(node *yaml.Node, types []string) bool {
+	for _, t := range types {
+		if getNodeType(node) == t || (t == "number" && getNodeType(node) == "integer") {
+			return true
+		}
+	}
+	return false
+}
+
+// getNodeType returns the JSON type of a node
+func getNodeType(node *yaml.Node) string {
+	switch node.Kind {
+	case yaml.MappingNode:
+		return "object"
+	case yaml.SequenceNode:
+		return "array"
+	}
+
+	switch node.Tag {
+	case "!!int":
+		return "integer"
+	case "!!float":
+		if f, err := strconv.ParseFloat(node.Value, 64); err == nil && f == math.Trunc(f) {
+			return "integer"
+		}
+		return "number"
+	case "!!bool":
+		return "boolean"
+	case "!!null":
+		return "null"
+	}
+	return "string"
+}
+
+func isStringNode(node *yaml.Node) bool {
+	return node.Kind == yaml.ScalarNode && getNodeType(node) == "string"
+}
+
+func decodeNode(node *yaml.Node) any {
+	var result any
+	if err := node.Decode(&result); err != nil {
+		return nil
+	}
+	return result
+}
+
+// containsValue returns true if the value of the node is equal to one of the values
+func containsValue(values []any, node *yaml.Node) bool {
+	value := formatValue(decodeNode(node))
+	for _, v := range values {
+		if formatValue(v) == value {
+			return true
+		}
+	}
+	return false
+}
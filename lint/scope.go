@@ -0,0 +1,257 @@
+package lint
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oasdiff/oasdiff/diff"
+	"github.com/oasdiff/oasdiff/load"
+)
+
+// Scope is the set of locations in the revision spec that a diff marks as added or modified
+// For example, a new endpoint, a modified response, an added parameter or a modified component schema
+type Scope struct {
+	pointers []jsonPointer
+}
+
+// NewScope returns the locations of the revision spec that are added or modified by the diff
+func NewScope(d *diff.Diff) *Scope {
+	result := &Scope{}
+	if d == nil {
+		return result
+	}
+
+	result.addExtensions(newJSONPointer(), d.ExtensionsDiff)
+
+	if d.OpenAPIDiff != nil {
+		result.add(newJSONPointer("openapi"))
+	}
+	if d.InfoDiff != nil {
+		result.add(newJSONPointer("info"))
+	}
+	if d.SecurityDiff != nil {
+		result.add(newJSONPointer("security"))
+	}
+	if d.ServersDiff != nil {
+		result.add(newJSONPointer("servers"))
+	}
+	if d.TagsDiff != nil {
+		result.add(newJSONPointer("tags"))
+	}
+	if d.ExternalDocsDiff != nil {
+		result.add(newJSONPointer("externalDocs"))
+	}
+
+	result.addPaths(newJSONPointer("paths"), d.PathsDiff)
+	result.addComponents(d.ComponentsDiff)
+
+	return result
+}
+
+func (scope *Scope) add(pointer jsonPointer) {
+	scope.pointers = append(scope.pointers, pointer)
+}
+
+// Contains returns true if the location of a JSON Pointer is in the scope
+func (scope *Scope) Contains(pointer string) bool {
+	for _, p := range scope.pointers {
+		prefix := p.String()
+		if pointer == prefix || strings.HasPrefix(pointer, prefix+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+func (scope *Scope) addExtensions(pointer jsonPointer, extensionsDiff *diff.ExtensionsDiff) {
+	if extensionsDiff == nil {
+		return
+	}
+	for _, name := range extensionsDiff.Added {
+		scope.add(pointer.add(name))
+	}
+	for _, name := range sortedKeys(extensionsDiff.Modified) {
+		scope.add(pointer.add(name))
+	}
+}
+
+func (scope *Scope) addPaths(pointer jsonPointer, pathsDiff *diff.PathsDiff) {
+	if pathsDiff == nil {
+		return
+	}
+
+	for _, path := range pathsDiff.Added {
+		scope.add(pointer.add(path))
+	}
+
+	for _, path := range sortedKeys(pathsDiff.Modified) {
+		scope.addPath(pointer.add(path), pathsDiff.Modified[path])
+	}
+}
+
+func (scope *Scope) addPath(pointer jsonPointer, pathDiff *diff.PathDiff) {
+	scope.addExtensions(pointer, pathDiff.ExtensionsDiff)
+
+	if pathDiff.RefDiff != nil {
+		scope.add(pointer.add("$ref"))
+	}
+	if pathDiff.SummaryDiff != nil {
+		scope.add(pointer.add("summary"))
+	}
+	if pathDiff.DescriptionDiff != nil {
+		scope.add(pointer.add("description"))
+	}
+	if pathDiff.ServersDiff != nil {
+		scope.add(pointer.add("servers"))
+	}
+
+	if pathDiff.Revision != nil {
+		scope.addParameters(pointer, pathDiff.ParametersDiff, pathDiff.Revision.Parameters)
+	}
+
+	if pathDiff.OperationsDiff == nil {
+		return
+	}
+
+	for _, method := range pathDiff.OperationsDiff.Added {
+		scope.add(pointer.add(strings.ToLower(method)))
+	}
+
+	for _, method := range sortedKeys(pathDiff.OperationsDiff.Modified) {
+		scope.addOperation(pointer.add(strings.ToLower(method)), pathDiff.OperationsDiff.Modified[method])
+	}
+}
+
+func (scope *Scope) addOperation(pointer jsonPointer, methodDiff *diff.MethodDiff) {
+	scope.addExtensions(pointer, methodDiff.ExtensionsDiff)
+
+	fields := map[string]bool{
+		"tags":         methodDiff.TagsDiff != nil,
+		"summary":      methodDiff.SummaryDiff != nil,
+		"description":  methodDiff.DescriptionDiff != nil,
+		"operationId":  methodDiff.OperationIDDiff != nil,
+		"requestBody":  methodDiff.RequestBodyDiff != nil,
+		"callbacks":    methodDiff.CallbacksDiff != nil,
+		"deprecated":   methodDiff.DeprecatedDiff != nil,
+		"security":     methodDiff.SecurityDiff != nil,
+		"servers":      methodDiff.ServersDiff != nil,
+		"externalDocs": methodDiff.ExternalDocsDiff != nil,
+	}
+	for _, field := range sortedKeys(fields) {
+		if fields[field] {
+			scope.add(pointer.add(field))
+		}
+	}
+
+	if methodDiff.Revision != nil {
+		scope.addParameters(pointer, methodDiff.ParametersDiff, methodDiff.Revision.Parameters)
+	}
+
+	if responsesDiff := methodDiff.ResponsesDiff; responsesDiff != nil {
+		for _, status := range responsesDiff.Added {
+			scope.add(pointer.add("responses", status))
+		}
+		for _, status := range sortedKeys(responsesDiff.Modified) {
+			scope.add(pointer.add("responses", status))
+		}
+	}
+}
+
+// addParameters adds the added and modified parameters, which are identified by their index in the parameters of the revision
+func (scope *Scope) addParameters(pointer jsonPointer, parametersDiff *diff.ParametersDiffByLocation, parameters openapi3.Parameters) {
+	if parametersDiff == nil {
+		return
+	}
+
+	for i, parameter := range parameters {
+		if parameter == nil || parameter.Value == nil {
+			continue
+		}
+		in, name := parameter.Value.In, parameter.Value.Name
+		_, modified := parametersDiff.Modified[in][name]
+		if added := parametersDiff.Added[in]; modified || added.Contains(name) {
+			scope.add(pointer.add("parameters", strconv.Itoa(i)))
+		}
+	}
+}
+
+func (scope *Scope) addComponents(componentsDiff *diff.ComponentsDiff) {
+	if componentsDiff == nil {
+		return
+	}
+
+	if d := componentsDiff.SchemasDiff; d != nil {
+		addComponentNames(scope, "schemas", d.Added, d.Modified)
+	}
+	if d := componentsDiff.ParametersDiff; d != nil {
+		addComponentNames(scope, "parameters", d.Added, d.Modified)
+	}
+	if d := componentsDiff.HeadersDiff; d != nil {
+		addComponentNames(scope, "headers", d.Added, d.Modified)
+	}
+	if d := componentsDiff.RequestBodiesDiff; d != nil {
+		addComponentNames(scope, "requestBodies", d.Added, d.Modified)
+	}
+	if d := componentsDiff.ResponsesDiff; d != nil {
+		addComponentNames(scope, "responses", d.Added, d.Modified)
+	}
+	if d := componentsDiff.SecuritySchemesDiff; d != nil {
+		addComponentNames(scope, "securitySchemes", d.Added, d.Modified)
+	}
+	if d := componentsDiff.ExamplesDiff; d != nil {
+		addComponentNames(scope, "examples", d.Added, d.Modified)
+	}
+	if d := componentsDiff.LinksDiff; d != nil {
+		addComponentNames(scope, "links", d.Added, d.Modified)
+	}
+	if d := componentsDiff.CallbacksDiff; d != nil {
+		addComponentNames(scope, "callbacks", d.Added, d.Modified)
+	}
+}
+
+func addComponentNames[V any](scope *Scope, section string, added []string, modified map[string]V) {
+	for _, name := range added {
+		scope.add(newJSONPointer("components", section, name))
+	}
+	for _, name := range sortedKeys(modified) {
+		scope.add(newJSONPointer("components", section, name))
+	}
+}
+
+// FilterByScope returns the errors of the revision spec that are in the scope
+// Errors without a JSON Pointer can't be located, so they are kept only if the base spec doesn't have the same error
+func FilterByScope(scope *Scope, baseErrs, revisionErrs Errors) Errors {
+	existing := map[string]struct{}{}
+	for _, err := range baseErrs {
+		existing[err.Id+" "+err.Text] = struct{}{}
+	}
+
+	result := make(Errors, 0)
+	for _, err := range revisionErrs {
+		if err.Pointer != "" {
+			if scope.Contains(err.Pointer) {
+				result = append(result, err)
+			}
+			continue
+		}
+		if _, ok := existing[err.Id+" "+err.Text]; !ok {
+			result = append(result, err)
+		}
+	}
+	return result
+}
+
+// RunChanged runs the checks on the revision spec and returns only the errors in the parts that the diff marks as added or modified
+// Pre-existing problems in unchanged parts of the spec are suppressed
+func RunChanged(config *Config, d *diff.Diff, base, revision *load.SpecInfo) Errors {
+	if revision == nil {
+		return make(Errors, 0)
+	}
+
+	var baseErrs Errors
+	if base != nil {
+		baseErrs = Run(config, base.Url, base)
+	}
+	return FilterByScope(NewScope(d), baseErrs, Run(config, revision.Url, revision))
+}
@@ -0,0 +1,97 @@
+package lint_test
+
+import (
+	"testing"
+
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/diff"
+	"github.com/oasdiff/oasdiff/lint"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRunChanged(t *testing.T) {
+	base := loadFrom(t, "../data/lint/changed/base.yaml")
+	revision := loadFrom(t, "../data/lint/changed/revision.yaml")
+
+	d, err := diff.Get(diff.NewConfig(), base.Spec, revision.Spec)
+	require.NoError(t, err)
+
+	errs := lint.RunChanged(lint.DefaultConfig(), d, base, revision)
+
+	ids := make([]string, len(errs))
+	for i, err := range errs {
+		ids[i] = err.Id + " " + err.Pointer
+	}
+	require.Equal(t, []string{
+		"parameter-default-invalid /paths/~1pets/get/parameters/1/schema/default",
+		"schema-default-invalid /paths/~1owners/get/responses/200/content/application~1json/schema/default",
+		"required-param-with-default /paths/~1owners/get/parameters/0/schema/default",
+	}, ids)
+	require.Contains(t, errs[2].Text, `"page"`)
+}
+
+func TestRunChanged_NoChanges(t *testing.T) {
+	base := loadFrom(t, "../data/lint/changed/base.yaml")
+
+	d, err := diff.Get(diff.NewConfig(), base.Spec, base.Spec)
+	require.NoError(t, err)
+
+	require.Len(t, lint.Run(lint.DefaultConfig(), base.Url, base), 2)
+	require.Empty(t, lint.RunChanged(lint.DefaultConfig(), d, base, base))
+}
+
+func TestScope_Contains(t *testing.T) {
+	base := loadFrom(t, "../data/lint/changed/base.yaml")
+	revision := loadFrom(t, "../data/lint/changed/revision.yaml")
+
+	d, err := diff.Get(diff.NewConfig(), base.Spec, revision.Spec)
+	require.NoError(t, err)
+
+	scope := lint.NewScope(d)
+	require.True(t, scope.Contains("/paths/~1owners"))
+	require.True(t, scope.Contains("/paths/~1owners/get/parameters/0"))
+	require.True(t, scope.Contains("/paths/~1pets/get/parameters/1"))
+	require.False(t, scope.Contains("/paths/~1pets/get/parameters/0"))
+	require.False(t, scope.Contains("/paths/~1pets/get/responses/200"))
+	require.False(t, scope.Contains("/paths/~1own"))
+}
+
+func TestErrors_ToChanges(t *testing.T) {
+	const source = "../data/lint/changed/revision.yaml"
+	errs := lint.Run(lint.DefaultConfig(), source, loadFrom(t, source))
+
+	changes := errs.ToChanges()
+	require.Len(t, changes, len(errs))
+
+	change := changes[0]
+	require.Equal(t, errs[0].Id, change.GetId())
+	require.Equal(t, checker.ERR, change.GetLevel())
+	require.Equal(t, "/pets", change.GetPath())
+	require.Equal(t, "GET", change.GetOperation())
+	require.Equal(t, source, change.GetSourceFile())
+	require.Zero(t, change.GetSourceLine())
+	require.Equal(t, errs[0].Pointer, change.GetAttributes()[lint.PointerAttribute])
+
+	// lint lines are one-based and the lines of changes are zero-based
+	require.Equal(t, lint.RequiredParamWithDefaultId, errs[3].Id)
+	require.NotZero(t, errs[3].Line)
+	require.Equal(t, errs[3].Line-1, changes[3].GetSourceLine())
+}
+
+func TestErrors_ToChangesSectionAndComment(t *testing.T) {
+	errs := lint.Errors{
+		{Id: "info-error", Text: "text", Comment: "comment", Level: checker.WARN, Pointer: "/info/title"},
+		{Id: "component-error", Text: "text", Level: checker.WARN, Pointer: "/components/schemas/Pet"},
+		{Id: "path-error", Text: "text", Level: checker.WARN, Pointer: "/paths/~1pets/get"},
+		{Id: "document-error", Text: "text", Level: checker.WARN},
+	}
+
+	changes := errs.ToChanges()
+	require.Equal(t, "info", changes[0].GetSection())
+	require.Equal(t, "comment", changes[0].GetComment(checker.NewDefaultLocalizer()))
+	require.Empty(t, changes[0].GetPath())
+	require.Equal(t, "components", changes[1].GetSection())
+	require.Equal(t, "paths", changes[2].GetSection())
+	require.Equal(t, "/pets", changes[2].GetPath())
+	require.Equal(t, "paths", changes[3].GetSection())
+}
@@ -0,0 +1,589 @@
+package lint
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/load"
+	"gopkg.in/yaml.v3"
+)
+
+// SpectralRuleset is a ruleset in a subset of the Spectral format, see https://docs.stoplight.io/docs/spectral
+// Each rule has a given JSONPath and one or more then clauses with an optional field and a function
+// The supported functions are truthy, falsy, defined, undefined, pattern, enumeration, casing, length and schema
+// The rules run on the raw document, so references aren't resolved, and the rules that extend other rulesets aren't loaded
+type SpectralRuleset struct {
+	rules []*spectralRule
+}
+
+type spectralRule struct {
+	id          string
+	description string
+	message     string
+	level       checker.Level
+	given       []*jsonPath
+	then        []*spectralThen
+}
+
+type spectralThen struct {
+	field    string
+	function spectralFunction
+}
+
+// spectralFunction returns the errors of a value, a nil node is a field that isn't defined
+type spectralFunction func(node *yaml.Node) []string
+
+type spectralRulesetFile struct {
+	Rules map[string]yaml.Node `yaml:"rules"`
+}
+
+type spectralRuleFile struct {
+	Description string    `yaml:"description"`
+	Message     string    `yaml:"message"`
+	Severity    yaml.Node `yaml:"severity"`
+	Given       yaml.Node `yaml:"given"`
+	Then        yaml.Node `yaml:"then"`
+}
+
+type spectralThenFile struct {
+	Field           string         `yaml:"field"`
+	Function        string         `yaml:"function"`
+	FunctionOptions map[string]any `yaml:"functionOptions"`
+}
+
+// ReadSpectralRuleset reads a Spectral ruleset from a yaml or json file
+func ReadSpectralRuleset(file string) (*SpectralRuleset, error) {
+	switch filepath.Ext(file) {
+	case ".js", ".mjs", ".cjs", ".ts":
+		return nil, fmt.Errorf("javascript rulesets aren't supported, use a yaml or json ruleset")
+	}
+
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	return ParseSpectralRuleset(data)
+}
+
+// ParseSpectralRuleset parses a Spectral ruleset in yaml or json
+// Rules that only override the severity of a rule from an extended ruleset, like "operation-tags: off", are ignored
+func ParseSpectralRuleset(data []byte) (*SpectralRuleset, error) {
+	var file spectralRulesetFile
+	if err := yaml.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse ruleset: %w", err)
+	}
+
+	result := &SpectralRuleset{}
+	for _, id := range sortedKeys(file.Rules) {
+		node := file.Rules[id]
+		if node.Kind != yaml.MappingNode {
+			continue
+		}
+
+		rule, err := parseSpectralRule(id, &node)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rule %q: %w", id, err)
+		}
+		if rule.level != checker.NONE {
+			result.rules = append(result.rules, rule)
+		}
+	}
+
+	return result, nil
+}
+
+func parseSpectralRule(id string, node *yaml.Node) (*spectralRule, error) {
+	var file spectralRuleFile
+	if err := node.Decode(&file); err != nil {
+		return nil, err
+	}
+
+	level, err := parseSpectralSeverity(&file.Severity)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &spectralRule{
+		id:          id,
+		description: file.Description,
+		message:     file.Message,
+		level:       level,
+	}
+
+	var given []string
+	if err := decodeOneOrMany(&file.Given, &given); err != nil || len(given) == 0 {
+		return nil, fmt.Errorf("given must be a JSONPath or a list of JSONPaths")
+	}
+	for _, expression := range given {
+		path, err := parseJSONPath(expression)
+		if err != nil {
+			return nil, err
+		}
+		result.given = append(result.given, path)
+	}
+
+	var then []spectralThenFile
+	if err := decodeOneOrMany(&file.Then, &then); err != nil || len(then) == 0 {
+		return nil, fmt.Errorf("then must be an object or a list of objects")
+	}
+	for _, thenFile := range then {
+		function, err := newSpectralFunction(thenFile.Function, thenFile.FunctionOptions)
+		if err != nil {
+			return nil, err
+		}
+		result.then = append(result.then, &spectralThen{field: thenFile.Field, function: function})
+	}
+
+	return result, nil
+}
+
+// decodeOneOrMany decodes a node that is either a single value or a list of values into a list
+func decodeOneOrMany[T any](node *yaml.Node, result *[]T) error {
+	if node.Kind == 0 {
+		return nil
+	}
+
+	if node.Kind == yaml.SequenceNode {
+		return node.Decode(result)
+	}
+
+	var value T
+	if err := node.Decode(&value); err != nil {
+		return err
+	}
+	*result = []T{value}
+	return nil
+}
+
+// parseSpectralSeverity converts a Spectral severity, by name or by number, to a level, hints are info and the default is warn
+func parseSpectralSeverity(node *yaml.Node) (checker.Level, error) {
+	switch node.Value {
+	case "":
+		return checker.WARN, nil
+	case "error", "0":
+		return checker.ERR, nil
+	case "warn", "1":
+		return checker.WARN, nil
+	case "info", "hint", "2", "3":
+		return checker.INFO, nil
+	case "off", "-1", "false":
+		return checker.NONE, nil
+	}
+	return checker.NONE, fmt.Errorf("invalid severity %q", node.Value)
+}
+
+func newSpectralFunction(name string, options map[string]any) (spectralFunction, error) {
+	switch name {
+	case "truthy":
+		return spectralTruthy, nil
+	case "falsy":
+		return spectralFalsy, nil
+	case "defined":
+		return spectralDefined, nil
+	case "undefined":
+		return spectralUndefined, nil
+	case "pattern":
+		return newSpectralPattern(options)
+	case "enumeration":
+		return newSpectralEnumeration(options)
+	case "casing":
+		return newSpectralCasing(options)
+	case "length":
+		return newSpectralLength(options)
+	case "schema":
+		return newSpectralSchema(options)
+	case "":
+		return nil, fmt.Errorf("then must have a function")
+	}
+	return nil, fmt.Errorf("unsupported function %q", name)
+}
+
+func isTruthyNode(node *yaml.Node) bool {
+	if node == nil {
+		return false
+	}
+	return isTruthy(decodeNode(node), true)
+}
+
+func spectralTruthy(node *yaml.Node) []string {
+	if !isTruthyNode(node) {
+		return []string{"must be truthy"}
+	}
+	return nil
+}
+
+func spectralFalsy(node *yaml.Node) []string {
+	if isTruthyNode(node) {
+		return []string{"must be falsy"}
+	}
+	return nil
+}
+
+func spectralDefined(node *yaml.Node) []string {
+	if node == nil {
+		return []string{"must be defined"}
+	}
+	return nil
+}
+
+func spectralUndefined(node *yaml.Node) []string {
+	if node != nil {
+		return []string{"must be undefined"}
+	}
+	return nil
+}
+
+func newSpectralPattern(options map[string]any) (spectralFunction, error) {
+	match, err := getSpectralRegexp(options, "match")
+	if err != nil {
+		return nil, err
+	}
+	notMatch, err := getSpectralRegexp(options, "notMatch")
+	if err != nil {
+		return nil, err
+	}
+	if match == nil && notMatch == nil {
+		return nil, fmt.Errorf("pattern must have the option match or notMatch")
+	}
+
+	return func(node *yaml.Node) []string {
+		if node == nil || node.Kind != yaml.ScalarNode {
+			return nil
+		}
+		result := []string{}
+		if match != nil && !match.MatchString(node.Value) {
+			result = append(result, fmt.Sprintf(`must match the pattern "%s"`, match.String()))
+		}
+		if notMatch != nil && notMatch.MatchString(node.Value) {
+			result = append(result, fmt.Sprintf(`must not match the pattern "%s"`, notMatch.String()))
+		}
+		return result
+	}, nil
+}
+
+// getSpectralRegexp returns the regular expression of an option, which may be in the javascript form /pattern/flags
+func getSpectralRegexp(options map[string]any, name string) (*regexp.Regexp, error) {
+	value, ok := options[name]
+	if !ok {
+		return nil, nil
+	}
+
+	pattern, ok := value.(string)
+	if !ok {
+		return nil, fmt.Errorf("option %s must be a string", name)
+	}
+
+	if strings.HasPrefix(pattern, "/") {
+		if end := strings.LastIndex(pattern, "/"); end > 0 {
+			flags := pattern[end+1:]
+			pattern = pattern[1:end]
+			if strings.Contains(flags, "i") {
+				pattern = "(?i)" + pattern
+			}
+		}
+	}
+
+	result, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid option %s: %w", name, err)
+	}
+	return result, nil
+}
+
+func newSpectralEnumeration(options map[string]any) (spectralFunction, error) {
+	values, ok := options["values"].([]any)
+	if !ok {
+		return nil, fmt.Errorf("enumeration must have the option values")
+	}
+
+	return func(node *yaml.Node) []string {
+		if node == nil || node.Kind != yaml.ScalarNode || containsValue(values, node) {
+			return nil
+		}
+		return []string{fmt.Sprintf("must be one of the allowed values: %s", formatValues(values))}
+	}, nil
+}
+
+func formatValues(values []any) string {
+	result := make([]string, len(values))
+	for i, value := range values {
+		result[i] = formatValue(value)
+	}
+	return strings.Join(result, ", ")
+}
+
+// spectralCasings are the casing patterns of Spectral, %[1]s is the pattern of a digit
+var spectralCasings = map[string]string{
+	"flat":   `[a-z][a-z%[1]s]*`,
+	"camel":  `[a-z][a-z%[1]s]*(?:[A-Z%[1]s](?:[a-z%[1]s]+|$))*`,
+	"pascal": `[A-Z][a-z%[1]s]*(?:[A-Z%[1]s](?:[a-z%[1]s]+|$))*`,
+	"kebab":  `[a-z][a-z%[1]s]*(?:-[a-z%[1]s]+)*`,
+	"cobol":  `[A-Z][A-Z%[1]s]*(?:-[A-Z%[1]s]+)*`,
+	"snake":  `[a-z][a-z%[1]s]*(?:_[a-z%[1]s]+)*`,
+	"macro":  `[A-Z][A-Z%[1]s]*(?:_[A-Z%[1]s]+)*`,
+}
+
+func newSpectralCasing(options map[string]any) (spectralFunction, error) {
+	casingType, _ := options["type"].(string)
+	pattern, ok := spectralCasings[casingType]
+	if !ok {
+		return nil, fmt.Errorf("invalid casing type %q, valid types are: %s", casingType, strings.Join(sortedKeys(spectralCasings), ", "))
+	}
+
+	digit := "0-9"
+	if disallowDigits, _ := options["disallowDigits"].(bool); disallowDigits {
+		digit = ""
+	}
+	pattern = fmt.Sprintf(pattern, digit)
+
+	// an optional separator joins words that are each in the casing, like /pets/{petId} with the separator /
+	if separator, ok := options["separator"].(map[string]any); ok {
+		char, _ := separator["char"].(string)
+		if utf8.RuneCountInString(char) != 1 {
+			return nil, fmt.Errorf("the separator of casing must be a single character")
+		}
+		leading := ""
+		if allowLeading, _ := separator["allowLeading"].(bool); allowLeading {
+			leading = regexp.QuoteMeta(char) + "?"
+		}
+		pattern = fmt.Sprintf("%s%s(?:%s%s)*", leading, pattern, regexp.QuoteMeta(char), pattern)
+	}
+
+	casing := regexp.MustCompile("^(?:" + pattern + ")$")
+	return func(node *yaml.Node) []string {
+		if node == nil || !isStringNode(node) || casing.MatchString(node.Value) {
+			return nil
+		}
+		return []string{fmt.Sprintf("must be %s case", casingType)}
+	}, nil
+}
+
+func newSpectralLength(options map[string]any) (spectralFunction, error) {
+	min, hasMin := getNumberOption(options, "min")
+	max, hasMax := getNumberOption(options, "max")
+	if !hasMin && !hasMax {
+		return nil, fmt.Errorf("length must have the option min or max")
+	}
+
+	return func(node *yaml.Node) []string {
+		length, ok := getLength(node)
+		if !ok {
+			return nil
+		}
+		if hasMin && length < min {
+			return []string{fmt.Sprintf("must be longer than %s", formatNumber(min))}
+		}
+		if hasMax && length > max {
+			return []string{fmt.Sprintf("must be shorter than %s", formatNumber(max))}
+		}
+		return nil
+	}, nil
+}
+
+func getNumberOption(options map[string]any, name string) (float64, bool) {
+	switch value := options[name].(type) {
+	case int:
+		return float64(value), true
+	case float64:
+		return value, true
+	}
+	return 0, false
+}
+
+// getLength returns the length of a string, the number of items of a list or of properties of an object, or the value of a number
+func getLength(node *yaml.Node) (float64, bool) {
+	if node == nil {
+		return 0, false
+	}
+	switch node.Kind {
+	case yaml.SequenceNode:
+		return float64(len(node.Content)), true
+	case yaml.MappingNode:
+		return float64(len(node.Content) / 2), true
+	case yaml.ScalarNode:
+		switch getNodeType(node) {
+		case "string":
+			return float64(utf8.RuneCountInString(node.Value)), true
+		case "integer", "number":
+			number, err := strconv.ParseFloat(node.Value, 64)
+			return number, err == nil
+		}
+	}
+	return 0, false
+}
+
+func formatNumber(number float64) string {
+	return strconv.FormatFloat(number, 'f', -1, 64)
+}
+
+func newSpectralSchema(options map[string]any) (spectralFunction, error) {
+	schema, ok := options["schema"].(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("schema must have the option schema")
+	}
+
+	data, err := json.Marshal(schema)
+	if err != nil {
+		return nil, fmt.Errorf("invalid option schema: %w", err)
+	}
+	validator, err := newSchemaValidator(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid option schema: %w", err)
+	}
+
+	return func(node *yaml.Node) []string {
+		if node == nil {
+			return nil
+		}
+		result := []string{}
+		for _, violation := range validator.validate(node) {
+			message := violation.message
+			if len(violation.pointer) > 0 {
+				message = fmt.Sprintf("%s at %s", message, violation.pointer)
+			}
+			result = append(result, message)
+		}
+		return result
+	}, nil
+}
+
+// Check runs the rules of the ruleset on the raw document of a spec
+// The id of each error is the name of the rule and its level is the severity of the rule
+func (ruleset *SpectralRuleset) Check(source string, s *load.SpecInfo) []*Error {
+	result := make([]*Error, 0)
+
+	if s == nil {
+		return result
+	}
+
+	document := getDocument(source, s)
+	if document == nil {
+		return result
+	}
+
+	for _, rule := range ruleset.rules {
+		result = append(result, rule.check(source, document)...)
+	}
+
+	return result
+}
+
+func (rule *spectralRule) check(source string, document *yaml.Node) []*Error {
+	result := make([]*Error, 0)
+	seen := map[string]struct{}{}
+
+	for _, given := range rule.given {
+		for _, match := range given.find(document) {
+			for _, then := range rule.then {
+				for _, target := range then.getTargets(match) {
+					for _, message := range then.function(target.node) {
+						err := rule.newError(source, target, message)
+						if _, ok := seen[err.Pointer+err.Text]; ok {
+							continue
+						}
+						seen[err.Pointer+err.Text] = struct{}{}
+						result = append(result, err)
+					}
+				}
+			}
+		}
+	}
+
+	return result
+}
+
+// getTargets returns the values of the field of a match, a target with a nil node is a field that isn't defined
+// The field @key targets the keys of an object, a field that starts with $ is a JSONPath relative to the match, and other fields are dot-separated property names
+func (then *spectralThen) getTargets(match jsonPathMatch) []jsonPathMatch {
+	switch {
+	case then.field == "":
+		return []jsonPathMatch{match}
+	case then.field == "@key":
+		result := []jsonPathMatch{}
+		for _, child := range getChildren(match) {
+			if child.key != nil {
+				result = append(result, jsonPathMatch{node: child.key, pointer: child.pointer})
+			}
+		}
+		return result
+	case strings.HasPrefix(then.field, "$"):
+		path, err := parseJSONPath(then.field)
+		if err != nil {
+			return nil
+		}
+		result := []jsonPathMatch{}
+		for _, target := range path.find(match.node) {
+			target.pointer = match.pointer.add(target.pointer...)
+			result = append(result, target)
+		}
+		return result
+	}
+
+	keys := strings.Split(then.field, ".")
+	target := jsonPathMatch{node: match.node, key: match.key, pointer: match.pointer}
+	for _, key := range keys {
+		var value *yaml.Node
+		for _, child := range getChildren(target) {
+			if child.pointer[len(child.pointer)-1] == key {
+				value = child.node
+				target = child
+			}
+		}
+		if value == nil {
+			// the field isn't defined, the error is reported at the match
+			return []jsonPathMatch{{pointer: match.pointer.add(keys...), key: match.key, node: nil}}
+		}
+	}
+	return []jsonPathMatch{target}
+}
+
+// newError creates an error with the message of the rule, which may use the placeholders {{error}}, {{description}}, {{property}}, {{path}} and {{value}}
+func (rule *spectralRule) newError(source string, target jsonPathMatch, message string) *Error {
+	template := rule.message
+	if template == "" {
+		template = "{{error}}"
+		if rule.description != "" {
+			template = "{{description}}"
+		}
+	}
+
+	property := ""
+	if len(target.pointer) > 0 {
+		property = target.pointer[len(target.pointer)-1]
+	}
+
+	value := ""
+	if target.node != nil {
+		if target.node.Kind == yaml.ScalarNode {
+			value = target.node.Value
+		} else {
+			value = formatValue(decodeNode(target.node))
+		}
+	}
+
+	text := strings.NewReplacer(
+		"{{error}}", fmt.Sprintf("%q %s", property, message),
+		"{{description}}", rule.description,
+		"{{property}}", property,
+		"{{path}}", target.pointer.String(),
+		"{{value}}", value,
+	).Replace(template)
+
+	result := newErrorAt(rule.id, source, target.pointer)
+	result.Text = fmt.Sprintf("%s: %s", text, target.pointer)
+	result.Level = rule.level
+	if target.node != nil {
+		result.Line = target.line()
+	} else if target.key != nil {
+		result.Line = target.key.Line
+	}
+	return result
+}
@@ -0,0 +1,74 @@
+package lint_test
+
+import (
+	"testing"
+
+	"github.com/oasdiff/oasdiff/lint"
+	"github.com/oasdiff/oasdiff/load"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSpectral_Ruleset(t *testing.T) {
+	const source = "../data/lint/spectral/openapi.yaml"
+	ruleset, err := lint.ReadSpectralRuleset("../data/lint/spectral/ruleset.yaml")
+	require.NoError(t, err)
+
+	errs := lint.Run(lint.NewConfig([]lint.Check{}).WithSpectralRuleset(ruleset), source, &load.SpecInfo{Url: source})
+
+	texts := make([]string, len(errs))
+	for i, err := range errs {
+		require.Equal(t, source, err.Source)
+		require.NotZero(t, err.Line)
+		texts[i] = err.Level.String() + " " + err.Id + ": " + err.Text
+	}
+
+	require.Equal(t, []string{
+		`error info-contact: Info must have a contact: /info/contact`,
+		`error path-keys-no-query: "/pets?sort=name" must not match the pattern "\?": /paths/~1pets?sort=name`,
+		`warning deprecated-operations: deprecated operations must describe an alternative in x-alternative: /paths/~1Pets~1{petId}/delete/x-alternative`,
+		`warning operation-description: description of the operation must be at least 10 characters: /paths/~1pets/get/description`,
+		`warning parameter-in: "in" must be one of the allowed values: "path", "query", "header": /paths/~1pets/get/parameters/1/in`,
+		`warning paths-kebab-case: "/Pets/{petId}" must be kebab case: /paths/~1Pets~1{petId}`,
+		`warning paths-kebab-case: "/pets?sort=name" must be kebab case: /paths/~1pets?sort=name`,
+		`warning server-url: "0" property "description" is required at /description: /servers/0`,
+		`warning server-url: "0" string doesn't match the pattern "^https://" at /url: /servers/0`,
+		`info delete-returns-content: "204" must be undefined: /paths/~1Pets~1{petId}/delete/responses/204`,
+		`info no-x-internal: "x-internal" must be undefined: /paths/~1Pets~1{petId}/delete/x-internal`,
+		`info operation-summary-no-period: "summary" must not match the pattern "\.$": /paths/~1pets/get/summary`,
+	}, texts)
+}
+
+func TestSpectral_Json(t *testing.T) {
+	ruleset, err := lint.ParseSpectralRuleset([]byte(`{"rules": {"title": {"given": "$.info.title", "then": {"function": "casing", "functionOptions": {"type": "macro", "disallowDigits": true}}}}}`))
+	require.NoError(t, err)
+
+	const source = "../data/lint/spectral/openapi.yaml"
+	errs := lint.Run(lint.NewConfig([]lint.Check{}).WithSpectralRuleset(ruleset), source, &load.SpecInfo{Url: source})
+	require.Len(t, errs, 1)
+	require.Equal(t, `"title" must be macro case: /info/title`, errs[0].Text)
+	require.Equal(t, 3, errs[0].Line)
+}
+
+func TestSpectral_Invalid(t *testing.T) {
+	tests := []struct {
+		ruleset string
+		err     string
+	}{
+		{"rules: {r: {given: $.info, severity: fatal, then: {function: truthy}}}", `invalid rule "r": invalid severity "fatal"`},
+		{"rules: {r: {given: info, then: {function: truthy}}}", `invalid rule "r": JSONPath "info" must start with $`},
+		{"rules: {r: {given: '$.paths[?(@.a > 1)]', then: {function: truthy}}}", `invalid rule "r": JSONPath "$.paths[?(@.a > 1)]" has an unsupported filter: unsupported operand "@.a > 1"`},
+		{"rules: {r: {given: $.info, then: {function: xor}}}", `invalid rule "r": unsupported function "xor"`},
+		{"rules: {r: {given: $.info, then: {function: casing, functionOptions: {type: upper}}}}", `invalid rule "r": invalid casing type "upper", valid types are: camel, cobol, flat, kebab, macro, pascal, snake`},
+		{"rules: {r: {given: $.info}}", `invalid rule "r": then must be an object or a list of objects`},
+	}
+
+	for _, test := range tests {
+		_, err := lint.ParseSpectralRuleset([]byte(test.ruleset))
+		require.EqualError(t, err, test.err)
+	}
+}
+
+func TestSpectral_JavascriptRuleset(t *testing.T) {
+	_, err := lint.ReadSpectralRuleset("spectral.js")
+	require.EqualError(t, err, "javascript rulesets aren't supported, use a yaml or json ruleset")
+}
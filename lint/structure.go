@@ -0,0 +1,203 @@
+package lint
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/oasdiff/oasdiff/load"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	ParameterSchemaAndContentId = "parameter-schema-and-content"
+	HeaderSchemaAndContentId    = "header-schema-and-content"
+	DuplicateRequiredPropertyId = "duplicate-required-property"
+	DuplicatePropertyId         = "duplicate-property"
+	UnresolvedRefId             = "unresolved-ref"
+	PathItemRefSiblingsId       = "path-item-ref-siblings"
+)
+
+// StructureCheck finds structural problems that kin-openapi silently normalizes or fails to load:
+// - parameters and headers with both schema and content, which are mutually exclusive
+// - properties that are listed more than once in required
+// - properties that are defined more than once (possible in json, where the last definition wins)
+// - references that can't be resolved
+// - path items with fields next to their $ref, which has undefined behavior
+// The check works on the raw document, so it also runs on a SpecInfo without a loaded Spec
+// Each error includes the JSON Pointer of the problem
+func StructureCheck(source string, s *load.SpecInfo) []*Error {
+	result := make([]*Error, 0)
+
+	if s == nil {
+		return result
+	}
+
+	document := getDocument(source, s)
+	if document == nil {
+		return result
+	}
+
+	v := newStructureValidator(source, document)
+
+	walkNode(document, newJSONPointer(), func(node *yaml.Node, pointer jsonPointer) bool {
+		if isValue(node, pointer) {
+			return false
+		}
+		if node.Kind == yaml.MappingNode {
+			v.checkMapping(node, pointer)
+		}
+		return true
+	})
+
+	v.checkPathItems(lookupNode(document, "paths"))
+
+	return v.result
+}
+
+type structureValidator struct {
+	source    string
+	document  *yaml.Node
+	documents map[string]*yaml.Node
+	result    []*Error
+}
+
+func newStructureValidator(source string, document *yaml.Node) *structureValidator {
+	return &structureValidator{
+		source:    source,
+		document:  document,
+		documents: map[string]*yaml.Node{},
+		result:    make([]*Error, 0),
+	}
+}
+
+// isValue returns true if the node is a value, like an example or an extension, rather than a part of the spec structure
+func isValue(node *yaml.Node, pointer jsonPointer) bool {
+	if len(pointer) == 0 {
+		return false
+	}
+
+	key := pointer[len(pointer)-1]
+	if len(pointer) > 1 && pointer[len(pointer)-2] == "properties" {
+		// a property name
+		return false
+	}
+
+	switch key {
+	case "example", "default", "enum", "const":
+		return true
+	case "value":
+		return len(pointer) > 2 && pointer[len(pointer)-3] == "examples"
+	case "examples":
+		// the examples keyword of a schema in OpenAPI 3.1 is a list of values
+		return node.Kind == yaml.SequenceNode
+	}
+
+	return strings.HasPrefix(key, "x-")
+}
+
+func (v *structureValidator) checkMapping(node *yaml.Node, pointer jsonPointer) {
+	if ref := lookupNode(node, "$ref"); ref != nil && ref.Kind == yaml.ScalarNode {
+		if !v.resolveRef(ref.Value) {
+			v.add(UnresolvedRefId, pointer.add("$ref"), ref.Line, ref.Value)
+		}
+	}
+
+	if lookupNode(node, "schema") != nil && lookupNode(node, "content") != nil {
+		v.checkSchemaAndContent(node, pointer)
+	}
+
+	if required := lookupNode(node, "required"); required != nil && required.Kind == yaml.SequenceNode {
+		names := map[string]struct{}{}
+		for i, name := range required.Content {
+			if _, ok := names[name.Value]; ok {
+				v.add(DuplicateRequiredPropertyId, pointer.add("required", strconv.Itoa(i)), name.Line, name.Value)
+			}
+			names[name.Value] = struct{}{}
+		}
+	}
+
+	if properties := lookupNode(node, "properties"); properties != nil {
+		for _, duplicate := range getDuplicateKeys(properties) {
+			v.add(DuplicatePropertyId, pointer.add("properties", duplicate.key), duplicate.secondLine, duplicate.key, duplicate.line, duplicate.secondLine)
+		}
+	}
+}
+
+func (v *structureValidator) checkSchemaAndContent(node *yaml.Node, pointer jsonPointer) {
+	name, in := lookupNode(node, "name"), lookupNode(node, "in")
+	if name != nil && in != nil {
+		v.add(ParameterSchemaAndContentId, pointer, node.Line, in.Value, name.Value)
+		return
+	}
+
+	if len(pointer) > 1 && pointer[len(pointer)-2] == "headers" {
+		v.add(HeaderSchemaAndContentId, pointer, node.Line, pointer[len(pointer)-1])
+	}
+}
+
+// checkPathItems finds path items with fields next to their $ref
+func (v *structureValidator) checkPathItems(paths *yaml.Node) {
+	if paths == nil || paths.Kind != yaml.MappingNode {
+		return
+	}
+
+	for i := 0; i+1 < len(paths.Content); i += 2 {
+		pathItem := paths.Content[i+1]
+		if lookupNode(pathItem, "$ref") == nil {
+			continue
+		}
+
+		siblings := []string{}
+		for j := 0; j+1 < len(pathItem.Content); j += 2 {
+			if key := pathItem.Content[j].Value; key != "$ref" {
+				siblings = append(siblings, key)
+			}
+		}
+
+		if len(siblings) > 0 {
+			path := paths.Content[i].Value
+			v.add(PathItemRefSiblingsId, newJSONPointer("paths", path), paths.Content[i].Line, path, strings.Join(siblings, ", "))
+		}
+	}
+}
+
+// resolveRef returns true if the reference points to an existing node
+// References to local files are resolved relative to the source, and remote references are assumed to be valid
+func (v *structureValidator) resolveRef(ref string) bool {
+	file, fragment, _ := strings.Cut(ref, "#")
+
+	if file == "" {
+		return resolvePointer(v.document, fragment) != nil
+	}
+
+	if load.NewSource(file).Type != load.SourceTypeFile || load.NewSource(v.source).Type != load.SourceTypeFile {
+		return true
+	}
+
+	path := file
+	if !filepath.IsAbs(path) {
+		path = filepath.Join(filepath.Dir(v.source), path)
+	}
+	document, ok := v.documents[path]
+	if !ok {
+		document = readDocument(path)
+		v.documents[path] = document
+	}
+
+	if document == nil {
+		// a missing file can't be resolved, but an existing file that isn't yaml or json may be referenced without a fragment
+		_, err := os.Stat(path)
+		return err == nil && fragment == ""
+	}
+
+	return resolvePointer(document, fragment) != nil
+}
+
+// add reports an error at a JSON Pointer and a line of the raw document
+func (v *structureValidator) add(id string, pointer jsonPointer, line int, args ...any) {
+	err := newErrorAt(id, v.source, pointer, args...)
+	err.Line = line
+	v.result = append(v.result, err)
+}
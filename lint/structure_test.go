@@ -0,0 +1,89 @@
+package lint_test
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/lint"
+	"github.com/oasdiff/oasdiff/load"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStructure_Valid(t *testing.T) {
+
+	const source = "../data/lint/openapi.yaml"
+	require.Empty(t, lint.Run(lint.NewConfig([]lint.Check{lint.StructureCheck}), source, loadFrom(t, source)))
+}
+
+func TestStructure_Invalid(t *testing.T) {
+
+	// kin-openapi refuses to load a parameter with both schema and content, so the check runs on the raw document only
+	const source = "../data/lint/structure/invalid.yaml"
+	errs := lint.Run(lint.NewConfig([]lint.Check{lint.StructureCheck}), source, &load.SpecInfo{Url: source})
+
+	require.Len(t, errs, 3)
+
+	require.Equal(t, lint.DuplicateRequiredPropertyId, errs[0].Id)
+	require.Equal(t, checker.ERR, errs[0].Level)
+	require.Equal(t, `property "name" appears more than once in the required list: /components/schemas/Pet/required/2`, errs[0].Text)
+	require.Equal(t, "/components/schemas/Pet/required/2", errs[0].Pointer)
+
+	require.Equal(t, lint.HeaderSchemaAndContentId, errs[1].Id)
+	require.Equal(t, `the header "X-Rate-Limit" defines both schema and content, which are mutually exclusive: /paths/~1pets/get/responses/200/headers/X-Rate-Limit`, errs[1].Text)
+
+	require.Equal(t, lint.ParameterSchemaAndContentId, errs[2].Id)
+	require.Equal(t, `the query parameter "filter" defines both schema and content, which are mutually exclusive: /paths/~1pets/get/parameters/0`, errs[2].Text)
+}
+
+func TestStructure_Refs(t *testing.T) {
+
+	// the spec can't be loaded because of the unresolved references, so the check runs on the raw document only
+	const source = "../data/lint/structure/refs.yaml"
+	errs := lint.Run(lint.NewConfig([]lint.Check{lint.StructureCheck}), source, &load.SpecInfo{Url: source})
+
+	require.Len(t, errs, 4)
+
+	require.Equal(t, lint.PathItemRefSiblingsId, errs[0].Id)
+	require.Equal(t, `path "/pets" defines summary, post next to its $ref, which has undefined behavior: /paths/~1pets`, errs[0].Text)
+	require.NotEmpty(t, errs[0].Comment)
+
+	require.Equal(t, lint.UnresolvedRefId, errs[1].Id)
+	require.Equal(t, `reference "missing.yaml" can't be resolved: /paths/~1owners/$ref`, errs[1].Text)
+	require.Equal(t, 14, errs[1].Line)
+	require.Equal(t, `reference "#/components/schemas/Missing" can't be resolved: /paths/~1pets~1{id}/get/responses/200/content/application~1json/schema/$ref`, errs[2].Text)
+	require.Equal(t, `reference "pets.yaml#/components/responses/NotFound" can't be resolved: /paths/~1pets~1{id}/get/responses/404/$ref`, errs[3].Text)
+}
+
+func TestStructure_DuplicateProperties(t *testing.T) {
+
+	const source = "../data/lint/structure/duplicate-properties.json"
+	errs := lint.Run(lint.NewConfig([]lint.Check{lint.StructureCheck}), source, loadFrom(t, source))
+
+	require.Len(t, errs, 1)
+	require.Equal(t, lint.DuplicatePropertyId, errs[0].Id)
+	require.Equal(t, `property "name" is defined more than once, in lines 13 and 16: /components/schemas/Pet/properties/name`, errs[0].Text)
+}
+
+func TestStructure_NoSpec(t *testing.T) {
+	require.Empty(t, lint.StructureCheck("../data/lint/structure/invalid.yaml", nil))
+}
+
+func TestStructure_Modified(t *testing.T) {
+
+	// the check runs on the loaded spec rather than on the file if the spec was modified after loading it
+	const source = "../data/lint/structure/duplicate-required.yaml"
+	config := lint.NewConfig([]lint.Check{lint.StructureCheck})
+
+	spec, err := load.NewSpecInfo(openapi3.NewLoader(), load.NewSource(source))
+	require.NoError(t, err)
+	errs := lint.Run(config, source, spec)
+	require.Len(t, errs, 1)
+	require.Equal(t, lint.DuplicateRequiredPropertyId, errs[0].Id)
+	require.NotZero(t, errs[0].Line)
+
+	spec, err = load.NewSpecInfo(openapi3.NewLoader(), load.NewSource(source), load.WithOverlays("../data/lint/structure/overlay.yaml"))
+	require.NoError(t, err)
+	require.True(t, spec.Modified)
+	require.Empty(t, lint.Run(config, source, spec))
+}
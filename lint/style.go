@@ -0,0 +1,426 @@
+package lint
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/load"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	StylePathCasingId           = "style-path-casing"
+	StylePathTrailingSlashId    = "style-path-trailing-slash"
+	StyleCollectionNotPluralId  = "style-collection-not-plural"
+	StylePropertyCasingId       = "style-property-casing"
+	StyleOperationIdMissingId   = "style-operation-id-missing"
+	StyleOperationIdDuplicateId = "style-operation-id-duplicate"
+	StyleOperationTagMissingId  = "style-operation-tag-missing"
+	StyleTagUndeclaredId        = "style-tag-undeclared"
+	StyleErrorSchemaId          = "style-error-schema"
+)
+
+// GetStyleRules returns the rules of the optional style guide, see Config.WithStyle
+func GetStyleRules() Rules {
+	check := DefaultStyle().Check
+	return Rules{
+		newRule(StylePathCasingId, checker.WARN, check),
+		newRule(StylePathTrailingSlashId, checker.WARN, check),
+		newRule(StyleCollectionNotPluralId, checker.WARN, check),
+		newRule(StylePropertyCasingId, checker.WARN, check),
+		newRule(StyleOperationIdMissingId, checker.WARN, check),
+		newRule(StyleOperationIdDuplicateId, checker.WARN, check),
+		newRule(StyleOperationTagMissingId, checker.WARN, check),
+		newRule(StyleTagUndeclaredId, checker.WARN, check),
+		newRule(StyleErrorSchemaId, checker.WARN, check),
+	}
+}
+
+// casings are the naming conventions of path segments and property names
+var casings = map[string]*regexp.Regexp{
+	"kebab":  regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`),
+	"camel":  regexp.MustCompile(`^[a-z][a-zA-Z0-9]*$`),
+	"snake":  regexp.MustCompile(`^[a-z0-9]+(_[a-z0-9]+)*$`),
+	"pascal": regexp.MustCompile(`^[A-Z][a-zA-Z0-9]*$`),
+}
+
+var casingNames = map[string]string{
+	"kebab":  "kebab-case",
+	"camel":  "camelCase",
+	"snake":  "snake_case",
+	"pascal": "PascalCase",
+}
+
+// StyleRuleOptions configures a style rule
+type StyleRuleOptions struct {
+	// Casing is the naming convention of style-path-casing and style-property-casing: kebab, camel, snake or pascal
+	Casing string `yaml:"casing,omitempty"`
+	// Schema is the reference of the shared error schema of style-error-schema, for example #/components/schemas/Error
+	// By default, the most common schema of the error responses is expected
+	Schema string `yaml:"schema,omitempty"`
+	// Exceptions are regular expressions of names that the rule ignores
+	// The name is the path for path rules, the property name for style-property-casing, the tag for style-tag-undeclared and "METHOD path" for operation rules
+	Exceptions []string `yaml:"exceptions,omitempty"`
+}
+
+// StyleOptions configures the style rules by rule id
+type StyleOptions map[string]StyleRuleOptions
+
+// ReadStyleOptions reads style options from a yaml file, for example:
+//
+//	style-path-casing:
+//	  casing: snake
+//	  exceptions: ['^/legacy/']
+func ReadStyleOptions(file string) (StyleOptions, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	result := StyleOptions{}
+	if err := yaml.Unmarshal(data, &result); err != nil {
+		return nil, fmt.Errorf("failed to parse style options: %w", err)
+	}
+	return result, nil
+}
+
+// Style is the style guide, with the options of each rule
+type Style struct {
+	pathCasing     string
+	propertyCasing string
+	errorSchema    string
+	exceptions     map[string][]*regexp.Regexp
+}
+
+// DefaultStyle returns the style guide with kebab-case paths, camelCase properties and no exceptions
+func DefaultStyle() *Style {
+	return &Style{
+		pathCasing:     "kebab",
+		propertyCasing: "camel",
+		exceptions:     map[string][]*regexp.Regexp{},
+	}
+}
+
+// NewStyle returns the style guide with the given options, it validates the rule ids, the casings and the regular expressions
+func NewStyle(options StyleOptions) (*Style, error) {
+	result := DefaultStyle()
+
+	ids := map[string]struct{}{}
+	for _, rule := range GetStyleRules() {
+		ids[rule.Id] = struct{}{}
+	}
+
+	for _, id := range sortedKeys(options) {
+		if _, ok := ids[id]; !ok {
+			return nil, fmt.Errorf("invalid style rule id %q", id)
+		}
+
+		ruleOptions := options[id]
+		if ruleOptions.Casing != "" {
+			if _, ok := casings[ruleOptions.Casing]; !ok {
+				return nil, fmt.Errorf("invalid casing %q of %s, valid casings are: %s", ruleOptions.Casing, id, strings.Join(sortedKeys(casings), ", "))
+			}
+			switch id {
+			case StylePathCasingId:
+				result.pathCasing = ruleOptions.Casing
+			case StylePropertyCasingId:
+				result.propertyCasing = ruleOptions.Casing
+			default:
+				return nil, fmt.Errorf("%s doesn't have a casing", id)
+			}
+		}
+
+		if ruleOptions.Schema != "" {
+			if id != StyleErrorSchemaId {
+				return nil, fmt.Errorf("%s doesn't have a schema", id)
+			}
+			result.errorSchema = ruleOptions.Schema
+		}
+
+		for _, exception := range ruleOptions.Exceptions {
+			r, err := regexp.Compile(exception)
+			if err != nil {
+				return nil, fmt.Errorf("invalid exception %q of %s: %w", exception, id, err)
+			}
+			result.exceptions[id] = append(result.exceptions[id], r)
+		}
+	}
+
+	return result, nil
+}
+
+// isException returns true if the name matches one of the exceptions of the rule
+func (style *Style) isException(id, name string) bool {
+	for _, r := range style.exceptions[id] {
+		if r.MatchString(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// Check checks the spec against the style guide
+func (style *Style) Check(source string, s *load.SpecInfo) []*Error {
+	v := &styleValidator{
+		style:  style,
+		source: source,
+		result: make([]*Error, 0),
+	}
+
+	if s == nil || s.Spec == nil {
+		return v.result
+	}
+
+	v.checkPaths(s.Spec.Paths)
+	v.checkOperations(s.Spec)
+	v.checkErrorSchemas(s.Spec.Paths)
+	v.checkProperties(s.Spec)
+
+	return v.result
+}
+
+type styleValidator struct {
+	style  *Style
+	source string
+	result []*Error
+}
+
+func (v *styleValidator) add(id, name string, pointer jsonPointer, args ...any) {
+	if v.style.isException(id, name) {
+		return
+	}
+	v.result = append(v.result, newErrorAt(id, v.source, pointer, args...))
+}
+
+func (v *styleValidator) checkPaths(paths *openapi3.Paths) {
+	if paths == nil {
+		return
+	}
+
+	casing := casings[v.style.pathCasing]
+	for _, path := range sortedKeys(paths.Map()) {
+		pointer := newJSONPointer("paths", path)
+
+		if path != "/" && strings.HasSuffix(path, "/") {
+			v.add(StylePathTrailingSlashId, path, pointer, path)
+		}
+
+		segments := strings.Split(strings.Trim(path, "/"), "/")
+		for i, segment := range segments {
+			if segment == "" || strings.Contains(segment, "{") {
+				continue
+			}
+			if !casing.MatchString(segment) {
+				v.add(StylePathCasingId, path, pointer, segment, casingNames[v.style.pathCasing], path)
+			}
+			// a segment that is followed by a path parameter is a collection, like pets in /pets/{id}
+			if i+1 < len(segments) && isTemplateSegment(segments[i+1]) && !isPlural(segment) {
+				v.add(StyleCollectionNotPluralId, path, pointer, segment, path)
+			}
+		}
+	}
+}
+
+// isPlural is a heuristic that returns true if an english word is plural, exceptions can be added to the style options
+func isPlural(word string) bool {
+	word = strings.ToLower(word)
+	switch word {
+	case "people", "children", "men", "women", "data", "media", "criteria", "feet", "teeth", "mice", "geese":
+		return true
+	}
+	return strings.HasSuffix(word, "s") && !strings.HasSuffix(word, "ss") && !strings.HasSuffix(word, "us")
+}
+
+func (v *styleValidator) checkOperations(spec *openapi3.T) {
+	if spec.Paths == nil {
+		return
+	}
+
+	declaredTags := map[string]struct{}{}
+	for _, tag := range spec.Tags {
+		declaredTags[tag.Name] = struct{}{}
+	}
+
+	operationIds := map[string]string{}
+	for _, path := range sortedKeys(spec.Paths.Map()) {
+		operations := spec.Paths.Value(path).Operations()
+		for _, method := range sortedKeys(operations) {
+			op := operations[method]
+			name := method + " " + path
+			pointer := newJSONPointer("paths", path, strings.ToLower(method))
+
+			if op.OperationID == "" {
+				v.add(StyleOperationIdMissingId, name, pointer, method, path)
+			} else if other, ok := operationIds[op.OperationID]; ok {
+				v.add(StyleOperationIdDuplicateId, name, pointer.add("operationId"), op.OperationID, method, path, other)
+			} else {
+				operationIds[op.OperationID] = name
+			}
+
+			if len(op.Tags) == 0 {
+				v.add(StyleOperationTagMissingId, name, pointer, method, path)
+			}
+
+			for i, tag := range op.Tags {
+				if _, ok := declaredTags[tag]; !ok {
+					v.add(StyleTagUndeclaredId, tag, pointer.add("tags", fmt.Sprint(i)), tag, method, path)
+				}
+			}
+		}
+	}
+}
+
+// errorResponse is a media type of a 4xx or 5xx response
+type errorResponse struct {
+	name    string
+	pointer jsonPointer
+	status  string
+	method  string
+	path    string
+	schema  string
+}
+
+func (v *styleValidator) checkErrorSchemas(paths *openapi3.Paths) {
+	if paths == nil {
+		return
+	}
+
+	responses := []errorResponse{}
+	for _, path := range sortedKeys(paths.Map()) {
+		operations := paths.Value(path).Operations()
+		for _, method := range sortedKeys(operations) {
+			if operations[method].Responses == nil {
+				continue
+			}
+			for _, status := range sortedKeys(operations[method].Responses.Map()) {
+				response := operations[method].Responses.Value(status)
+				if !isErrorStatus(status) || response.Value == nil {
+					continue
+				}
+				for _, mediaType := range sortedKeys(response.Value.Content) {
+					schema := ""
+					if schemaRef := response.Value.Content[mediaType].Schema; schemaRef != nil {
+						schema = schemaRef.Ref
+					}
+					responses = append(responses, errorResponse{
+						name:    method + " " + path,
+						pointer: newJSONPointer("paths", path, strings.ToLower(method), "responses", status, "content", mediaType, "schema"),
+						status:  status,
+						method:  method,
+						path:    path,
+						schema:  schema,
+					})
+				}
+			}
+		}
+	}
+
+	expected := v.style.errorSchema
+	if expected == "" {
+		expected = getMostCommonSchema(responses)
+	}
+
+	for _, response := range responses {
+		if response.schema != expected {
+			v.add(StyleErrorSchemaId, response.name, response.pointer, response.status, response.method, response.path, expected)
+		}
+	}
+}
+
+func isErrorStatus(status string) bool {
+	return strings.HasPrefix(status, "4") || strings.HasPrefix(status, "5")
+}
+
+// getMostCommonSchema returns the most common referenced schema of the error responses, inline schemas aren't shared
+func getMostCommonSchema(responses []errorResponse) string {
+	counts := map[string]int{}
+	for _, response := range responses {
+		if response.schema != "" {
+			counts[response.schema]++
+		}
+	}
+
+	schemas := sortedKeys(counts)
+	sort.SliceStable(schemas, func(i, j int) bool {
+		return counts[schemas[i]] > counts[schemas[j]]
+	})
+
+	if len(schemas) == 0 {
+		return "#/components/schemas/Error"
+	}
+	return schemas[0]
+}
+
+// checkProperties checks the property names of the component schemas and of the inline schemas of the operations
+func (v *styleValidator) checkProperties(spec *openapi3.T) {
+	if spec.Components != nil {
+		for _, name := range sortedKeys(spec.Components.Schemas) {
+			v.checkSchemaProperties(spec.Components.Schemas[name], newJSONPointer("components", "schemas", name))
+		}
+	}
+
+	if spec.Paths == nil {
+		return
+	}
+
+	for _, path := range sortedKeys(spec.Paths.Map()) {
+		operations := spec.Paths.Value(path).Operations()
+		for _, method := range sortedKeys(operations) {
+			op := operations[method]
+			pointer := newJSONPointer("paths", path, strings.ToLower(method))
+
+			if op.RequestBody != nil && op.RequestBody.Ref == "" && op.RequestBody.Value != nil {
+				v.checkContentProperties(op.RequestBody.Value.Content, pointer.add("requestBody", "content"))
+			}
+
+			if op.Responses == nil {
+				continue
+			}
+			for _, status := range sortedKeys(op.Responses.Map()) {
+				if response := op.Responses.Value(status); response.Ref == "" && response.Value != nil {
+					v.checkContentProperties(response.Value.Content, pointer.add("responses", status, "content"))
+				}
+			}
+		}
+	}
+}
+
+func (v *styleValidator) checkContentProperties(content openapi3.Content, pointer jsonPointer) {
+	for _, mediaType := range sortedKeys(content) {
+		v.checkSchemaProperties(content[mediaType].Schema, pointer.add(mediaType, "schema"))
+	}
+}
+
+// checkSchemaProperties checks the property names of an inline schema and of its subschemas, referenced schemas are checked under components
+func (v *styleValidator) checkSchemaProperties(schemaRef *openapi3.SchemaRef, pointer jsonPointer) {
+	schema := getInlineSchema(schemaRef)
+	if schema == nil {
+		return
+	}
+
+	casing := casings[v.style.propertyCasing]
+	for _, name := range sortedKeys(schema.Properties) {
+		propertyPointer := pointer.add("properties", name)
+		if !casing.MatchString(name) {
+			v.add(StylePropertyCasingId, name, propertyPointer, name, casingNames[v.style.propertyCasing])
+		}
+		v.checkSchemaProperties(schema.Properties[name], propertyPointer)
+	}
+
+	v.checkSchemaProperties(schema.Items, pointer.add("items"))
+	v.checkSchemaProperties(schema.AdditionalProperties.Schema, pointer.add("additionalProperties"))
+	for i, subSchema := range schema.AllOf {
+		v.checkSchemaProperties(subSchema, pointer.add("allOf", fmt.Sprint(i)))
+	}
+	for i, subSchema := range schema.OneOf {
+		v.checkSchemaProperties(subSchema, pointer.add("oneOf", fmt.Sprint(i)))
+	}
+	for i, subSchema := range schema.AnyOf {
+		v.checkSchemaProperties(subSchema, pointer.add("anyOf", fmt.Sprint(i)))
+	}
+}
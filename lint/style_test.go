@@ -0,0 +1,96 @@
+package lint_test
+
+import (
+	"testing"
+
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/lint"
+	"github.com/stretchr/testify/require"
+)
+
+func getStyleTexts(t *testing.T, style *lint.Style, source string) []string {
+	t.Helper()
+
+	errs := lint.Run(lint.NewConfig([]lint.Check{}).WithStyle(style), source, loadFrom(t, source))
+	texts := make([]string, len(errs))
+	for i, err := range errs {
+		require.Equal(t, source, err.Source)
+		texts[i] = err.Level.String() + " " + err.Id + ": " + err.Text
+	}
+	return texts
+}
+
+func TestStyle_Valid(t *testing.T) {
+	require.Empty(t, getStyleTexts(t, lint.DefaultStyle(), "../data/lint/style/valid.yaml"))
+}
+
+func TestStyle_Invalid(t *testing.T) {
+	require.ElementsMatch(t, []string{
+		`warning style-collection-not-plural: collection "pet" in path "/pet/{petId}" should be plural: /paths/~1pet~1{petId}`,
+		`warning style-error-schema: the 404 response of GET /pet/{petId} doesn't use the shared error schema #/components/schemas/Error: /paths/~1pet~1{petId}/get/responses/404/content/application~1json/schema`,
+		`warning style-operation-id-duplicate: operationId "listPets" of GET /pet/{petId} is already used by GET /Pets/: /paths/~1pet~1{petId}/get/operationId`,
+		`warning style-operation-id-missing: DELETE /pet/{petId} doesn't have an operationId: /paths/~1pet~1{petId}/delete`,
+		`warning style-operation-tag-missing: DELETE /pet/{petId} doesn't have a tag: /paths/~1pet~1{petId}/delete`,
+		`warning style-path-casing: path segment "Pets" isn't kebab-case in path "/Pets/": /paths/~1Pets~1`,
+		`warning style-path-trailing-slash: path "/Pets/" ends with a slash: /paths/~1Pets~1`,
+		`warning style-property-casing: property "ErrorMessage" isn't camelCase: /components/schemas/Error/properties/ErrorMessage`,
+		`warning style-property-casing: property "next_page" isn't camelCase: /paths/~1Pets~1/get/responses/200/content/application~1json/schema/properties/next_page`,
+		`warning style-tag-undeclared: tag "animals" of GET /pet/{petId} isn't declared in the tags of the spec: /paths/~1pet~1{petId}/get/tags/0`,
+	}, getStyleTexts(t, lint.DefaultStyle(), "../data/lint/style/invalid.yaml"))
+}
+
+func TestStyle_Options(t *testing.T) {
+	options, err := lint.ReadStyleOptions("../data/lint/style/options.yaml")
+	require.NoError(t, err)
+
+	style, err := lint.NewStyle(options)
+	require.NoError(t, err)
+
+	require.ElementsMatch(t, []string{
+		`warning style-collection-not-plural: collection "pet" in path "/pet/{petId}" should be plural: /paths/~1pet~1{petId}`,
+		`warning style-error-schema: the 404 response of DELETE /pet/{petId} doesn't use the shared error schema #/components/schemas/Problem: /paths/~1pet~1{petId}/delete/responses/404/content/application~1json/schema`,
+		`warning style-error-schema: the 404 response of GET /pet/{petId} doesn't use the shared error schema #/components/schemas/Problem: /paths/~1pet~1{petId}/get/responses/404/content/application~1json/schema`,
+		`warning style-error-schema: the 500 response of GET /Pets/ doesn't use the shared error schema #/components/schemas/Problem: /paths/~1Pets~1/get/responses/500/content/application~1json/schema`,
+		`warning style-operation-id-duplicate: operationId "listPets" of GET /pet/{petId} is already used by GET /Pets/: /paths/~1pet~1{petId}/get/operationId`,
+		`warning style-operation-id-missing: DELETE /pet/{petId} doesn't have an operationId: /paths/~1pet~1{petId}/delete`,
+		`warning style-path-trailing-slash: path "/Pets/" ends with a slash: /paths/~1Pets~1`,
+		`warning style-property-casing: property "ErrorMessage" isn't snake_case: /components/schemas/Error/properties/ErrorMessage`,
+		`warning style-tag-undeclared: tag "animals" of GET /pet/{petId} isn't declared in the tags of the spec: /paths/~1pet~1{petId}/get/tags/0`,
+	}, getStyleTexts(t, style, "../data/lint/style/invalid.yaml"))
+}
+
+func TestStyle_InvalidOptions(t *testing.T) {
+	_, err := lint.NewStyle(lint.StyleOptions{"style-unknown": {}})
+	require.EqualError(t, err, `invalid style rule id "style-unknown"`)
+
+	_, err = lint.NewStyle(lint.StyleOptions{lint.StylePathCasingId: {Casing: "upper"}})
+	require.EqualError(t, err, `invalid casing "upper" of style-path-casing, valid casings are: camel, kebab, pascal, snake`)
+
+	_, err = lint.NewStyle(lint.StyleOptions{lint.StyleOperationIdMissingId: {Casing: "camel"}})
+	require.EqualError(t, err, "style-operation-id-missing doesn't have a casing")
+
+	_, err = lint.NewStyle(lint.StyleOptions{lint.StyleTagUndeclaredId: {Exceptions: []string{"["}}})
+	require.ErrorContains(t, err, `invalid exception "[" of style-tag-undeclared`)
+}
+
+func TestStyle_SeverityLevels(t *testing.T) {
+	const source = "../data/lint/style/invalid.yaml"
+	levels := map[string]checker.Level{}
+	for _, rule := range lint.GetStyleRules() {
+		levels[rule.Id] = checker.NONE
+	}
+	levels[lint.StyleOperationIdDuplicateId] = checker.ERR
+
+	errs := lint.Run(lint.NewConfig([]lint.Check{}).WithStyle(lint.DefaultStyle()).WithSeverityLevels(levels), source, loadFrom(t, source))
+	require.Len(t, errs, 1)
+	require.Equal(t, lint.StyleOperationIdDuplicateId, errs[0].Id)
+	require.Equal(t, checker.ERR, errs[0].Level)
+}
+
+func TestStyle_Localized(t *testing.T) {
+	l := checker.NewDefaultLocalizer()
+	for _, rule := range lint.GetStyleRules() {
+		require.NotEqual(t, rule.Id, l(rule.Id), "missing message for %s", rule.Id)
+		require.NotEqual(t, rule.Description, l(rule.Description), "missing description for %s", rule.Id)
+	}
+}
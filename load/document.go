@@ -0,0 +1,34 @@
+package load
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+)
+
+// ReadDocument returns the raw content of a spec from a local path, a URL, or stdin, without loading it as an OpenAPI spec
+func ReadDocument(source *Source) ([]byte, error) {
+	switch source.Type {
+	case SourceTypeStdin:
+		return io.ReadAll(os.Stdin)
+	case SourceTypeURL:
+		return readURL(source.Uri.String())
+	default:
+		return os.ReadFile(source.Path)
+	}
+}
+
+func readURL(url string) ([]byte, error) {
+	resp, err := http.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return nil, fmt.Errorf("error loading %q: request returned status code %d", url, resp.StatusCode)
+	}
+
+	return io.ReadAll(resp.Body)
+}
@@ -4,8 +4,13 @@ import (
 	"fmt"
 
 	"github.com/oasdiff/oasdiff/flatten/allof"
+	"github.com/oasdiff/oasdiff/flatten/bundle"
 	"github.com/oasdiff/oasdiff/flatten/commonparams"
+	"github.com/oasdiff/oasdiff/flatten/deref"
+	"github.com/oasdiff/oasdiff/flatten/discriminator"
 	"github.com/oasdiff/oasdiff/flatten/headers"
+	"github.com/oasdiff/oasdiff/flatten/hoist"
+	"github.com/oasdiff/oasdiff/overlay"
 )
 
 // Option functions can be used to preprocess specs after loading them
@@ -34,6 +39,7 @@ func WithFlattenAllOf() Option {
 			if specInfo.Spec, err = allof.MergeSpec(specInfo.Spec); err != nil {
 				return nil, fmt.Errorf("failed to flatten allOf in %q: %w", specInfo.Url, err)
 			}
+			specInfo.Modified = true
 		}
 		return specInfos, nil
 	}
@@ -45,6 +51,78 @@ func WithFlattenParams() Option {
 	return func(loader Loader, specInfos []*SpecInfo) ([]*SpecInfo, error) {
 		for _, specInfo := range specInfos {
 			commonparams.Move(specInfo.Spec)
+			specInfo.Modified = true
+		}
+		return specInfos, nil
+	}
+}
+
+// WithBundle returns SpecInfos with the objects referenced in external files moved into the components
+func WithBundle() Option {
+	return func(loader Loader, specInfos []*SpecInfo) ([]*SpecInfo, error) {
+		for _, specInfo := range specInfos {
+			bundle.Bundle(specInfo.Spec)
+			specInfo.Modified = true
+		}
+		return specInfos, nil
+	}
+}
+
+// WithDereference returns SpecInfos with all $refs replaced by the objects that they reference
+// Circular schema references are replaced by placeholders, see deref.CircularRefExtension
+func WithDereference() Option {
+	return func(loader Loader, specInfos []*SpecInfo) ([]*SpecInfo, error) {
+		for _, specInfo := range specInfos {
+			deref.Dereference(specInfo.Spec)
+			specInfo.Modified = true
+		}
+		return specInfos, nil
+	}
+}
+
+// WithFlattenDiscriminator returns SpecInfos with the subschemas of oneOf and anyOf with a discriminator merged with their parent and restricted to their discriminator values
+func WithFlattenDiscriminator() Option {
+	return func(loader Loader, specInfos []*SpecInfo) ([]*SpecInfo, error) {
+		for _, specInfo := range specInfos {
+			if err := discriminator.Flatten(specInfo.Spec); err != nil {
+				return nil, fmt.Errorf("failed to flatten discriminated schemas in %q: %w", specInfo.Url, err)
+			}
+			specInfo.Modified = true
+		}
+		return specInfos, nil
+	}
+}
+
+// WithHoist returns SpecInfos with inline object schemas moved into named component schemas
+func WithHoist() Option {
+	return func(loader Loader, specInfos []*SpecInfo) ([]*SpecInfo, error) {
+		for _, specInfo := range specInfos {
+			hoist.Hoist(specInfo.Spec)
+			specInfo.Modified = true
+		}
+		return specInfos, nil
+	}
+}
+
+// WithOverlays returns SpecInfos with the OpenAPI Overlays in the given files applied in order
+// See https://github.com/OAI/Overlay-Specification
+func WithOverlays(files ...string) Option {
+	return func(loader Loader, specInfos []*SpecInfo) ([]*SpecInfo, error) {
+		overlays := make([]*overlay.Overlay, len(files))
+		for i, file := range files {
+			var err error
+			if overlays[i], err = overlay.Load(file); err != nil {
+				return nil, fmt.Errorf("failed to load overlay %q: %w", file, err)
+			}
+		}
+
+		var err error
+		for _, specInfo := range specInfos {
+			if specInfo.Spec, err = overlay.ApplyToSpec(specInfo.Spec, specInfo.Url, overlays...); err != nil {
+				return nil, fmt.Errorf("failed to apply overlays to %q: %w", specInfo.Url, err)
+			}
+			specInfo.Version = getVersion(specInfo.Spec)
+			specInfo.Modified = true
 		}
 		return specInfos, nil
 	}
@@ -55,6 +133,7 @@ func WithLowercaseHeaders() Option {
 	return func(loader Loader, specInfos []*SpecInfo) ([]*SpecInfo, error) {
 		for _, specInfo := range specInfos {
 			headers.Lowercase(specInfo.Spec)
+			specInfo.Modified = true
 		}
 		return specInfos, nil
 	}
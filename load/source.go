@@ -42,6 +42,9 @@ func NewSource(path string) *Source {
 }
 
 func (source *Source) String() string {
+	if source == nil {
+		return ""
+	}
 	return source.Path
 }
 
@@ -14,6 +14,8 @@ type SpecInfo struct {
 	Url     string
 	Spec    *openapi3.T
 	Version string
+	// Modified is true if a load option changed the spec, like an overlay or flattening, so it no longer matches the document at Url
+	Modified bool
 }
 
 func (specInfo *SpecInfo) GetVersion() string {
@@ -0,0 +1,124 @@
+package overlay
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Apply applies the actions of the overlay to a generic JSON document, in order, and returns the resulting document
+// The document may be modified in place
+func (overlay *Overlay) Apply(doc any) (any, error) {
+	for i, action := range overlay.Actions {
+		var err error
+		if doc, err = action.apply(doc); err != nil {
+			return nil, fmt.Errorf("failed to apply action #%d with target %q: %w", i+1, action.Target, err)
+		}
+	}
+	return doc, nil
+}
+
+func (action Action) apply(doc any) (any, error) {
+	p, err := parsePath(action.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	locations := p.selectLocations(doc)
+
+	if action.Remove {
+		// remove array elements from last to first so that the locations of the remaining elements remain valid
+		slices.SortStableFunc(locations, compareLocations)
+		for i := len(locations) - 1; i >= 0; i-- {
+			if len(locations[i]) == 0 {
+				return nil, fmt.Errorf("can't remove the root of the document")
+			}
+			doc = setValue(doc, locations[i], nil, true)
+		}
+		return doc, nil
+	}
+
+	if action.Update == nil {
+		return doc, nil
+	}
+
+	for _, loc := range locations {
+		doc = setValue(doc, loc, update(getValue(doc, loc), normalize(action.Update)), false)
+	}
+	return doc, nil
+}
+
+// update merges the update into an object or appends it to an array
+func update(target, value any) any {
+	switch target := target.(type) {
+	case map[string]any:
+		return merge(target, value)
+	case []any:
+		if items, ok := value.([]any); ok {
+			return append(target, items...)
+		}
+		return append(target, value)
+	}
+	return value
+}
+
+// merge merges objects recursively, other values replace the target
+func merge(target, value any) any {
+	targetObject, ok := target.(map[string]any)
+	if !ok {
+		return value
+	}
+	valueObject, ok := value.(map[string]any)
+	if !ok {
+		return value
+	}
+
+	for key, v := range valueObject {
+		targetObject[key] = merge(targetObject[key], v)
+	}
+	return targetObject
+}
+
+// normalize returns a deep copy of a value decoded from YAML, with map[any]any converted to map[string]any
+func normalize(value any) any {
+	switch value := value.(type) {
+	case map[string]any:
+		result := make(map[string]any, len(value))
+		for k, v := range value {
+			result[k] = normalize(v)
+		}
+		return result
+	case map[any]any:
+		result := make(map[string]any, len(value))
+		for k, v := range value {
+			result[fmt.Sprint(k)] = normalize(v)
+		}
+		return result
+	case []any:
+		result := make([]any, len(value))
+		for i, v := range value {
+			result[i] = normalize(v)
+		}
+		return result
+	}
+	return value
+}
+
+// compareLocations orders locations so that array elements come in ascending index order
+func compareLocations(a, b location) int {
+	for i := 0; i < len(a) && i < len(b); i++ {
+		ai, aOk := a[i].(int)
+		bi, bOk := b[i].(int)
+		if aOk && bOk && ai != bi {
+			return ai - bi
+		}
+		as, aOk := a[i].(string)
+		bs, bOk := b[i].(string)
+		if aOk && bOk && as != bs {
+			if as < bs {
+				return -1
+			}
+			return 1
+		}
+	}
+	return len(a) - len(b)
+}
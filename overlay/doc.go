@@ -0,0 +1,3 @@
+// Package overlay reads, applies and generates OpenAPI Overlay documents
+// See https://github.com/OAI/Overlay-Specification
+package overlay
@@ -0,0 +1,125 @@
+package overlay
+
+import (
+	"fmt"
+	"reflect"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// Generate returns an overlay that transforms the base spec into the revision spec
+func Generate(base, revision *openapi3.T) (*Overlay, error) {
+	baseDoc, err := toDocument(base)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert base spec: %w", err)
+	}
+
+	revisionDoc, err := toDocument(revision)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert revision spec: %w", err)
+	}
+
+	return &Overlay{
+		Overlay: Version,
+		Info: Info{
+			Title:   getTitle(base, revision),
+			Version: "1.0.0",
+		},
+		Actions: generateActions(location{}, baseDoc, revisionDoc),
+	}, nil
+}
+
+func getTitle(base, revision *openapi3.T) string {
+	if base.Info == nil || revision.Info == nil || base.Info.Version == "" || revision.Info.Version == "" {
+		return "Changes from base to revision"
+	}
+	return fmt.Sprintf("Changes from %s to %s", base.Info.Version, revision.Info.Version)
+}
+
+// generateActions returns the actions that transform the base object into the revision object
+// Deleted members and members that can't be merged (arrays and values that changed their type) are removed first, then a single update adds the new and changed members
+// Members that are objects in both documents are compared recursively
+func generateActions(loc location, base, revision any) []Action {
+	baseObject, baseOk := base.(map[string]any)
+	revisionObject, revisionOk := revision.(map[string]any)
+	if !baseOk || !revisionOk {
+		return nil
+	}
+
+	removals := []Action{}
+	updates := map[string]any{}
+	nested := []Action{}
+
+	for _, c := range getChildren(loc, baseObject) {
+		name := c.loc[len(c.loc)-1].(string)
+		revisionValue, ok := revisionObject[name]
+		if !ok {
+			removals = append(removals, Action{Target: formatLocation(c.loc), Remove: true})
+			continue
+		}
+
+		if reflect.DeepEqual(c.value, revisionValue) {
+			continue
+		}
+
+		if isObject(c.value) && isObject(revisionValue) {
+			nested = append(nested, generateActions(c.loc, c.value, revisionValue)...)
+			continue
+		}
+
+		if isArray(c.value) && isArray(revisionValue) {
+			if actions, ok := generateArrayActions(c.loc, c.value.([]any), revisionValue.([]any)); ok {
+				nested = append(nested, actions...)
+				continue
+			}
+		}
+
+		if isObject(c.value) || isArray(c.value) || isObject(revisionValue) || isArray(revisionValue) {
+			removals = append(removals, Action{Target: formatLocation(c.loc), Remove: true})
+		}
+		updates[name] = revisionValue
+	}
+
+	for _, c := range getChildren(loc, revisionObject) {
+		name := c.loc[len(c.loc)-1].(string)
+		if _, ok := baseObject[name]; !ok {
+			updates[name] = c.value
+		}
+	}
+
+	result := removals
+	if len(updates) > 0 {
+		result = append(result, Action{Target: formatLocation(loc), Update: updates})
+	}
+	return append(result, nested...)
+}
+
+// generateArrayActions compares arrays of the same length element by element
+// It fails if the lengths differ or if an element that isn't an object in both arrays changed, because overlay updates can only append to arrays
+func generateArrayActions(loc location, base, revision []any) ([]Action, bool) {
+	if len(base) != len(revision) {
+		return nil, false
+	}
+
+	result := []Action{}
+	for i := range base {
+		if reflect.DeepEqual(base[i], revision[i]) {
+			continue
+		}
+		if !isObject(base[i]) || !isObject(revision[i]) {
+			return nil, false
+		}
+		result = append(result, generateActions(appendLocation(loc, i), base[i], revision[i])...)
+	}
+	return result, true
+}
+
+func isObject(value any) bool {
+	_, ok := value.(map[string]any)
+	return ok
+}
+
+func isArray(value any) bool {
+	_, ok := value.([]any)
+	return ok
+}
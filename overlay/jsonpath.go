@@ -0,0 +1,282 @@
+package overlay
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// segment is a single step of a JSONPath expression
+type segment struct {
+	descendant bool     // '..' selects the matching descendants rather than the matching children
+	wildcard   bool     // '*' selects all members or elements
+	names      []string // member names
+	indices    []int    // element indices, negative indices count from the end
+}
+
+// path is a parsed JSONPath expression
+// Supported syntax: $, .name, .*, ..name, ..*, ['name', ...], [index, ...] and [*]
+// Filter expressions and slices are not supported
+type path []segment
+
+// location identifies a node in a JSON document by the member names and element indices that lead to it from the root
+type location []any
+
+func parsePath(expression string) (path, error) {
+	if !strings.HasPrefix(expression, "$") {
+		return nil, fmt.Errorf("invalid JSONPath %q: must start with '$'", expression)
+	}
+
+	result := path{}
+	rest := expression[1:]
+	for rest != "" {
+		var seg segment
+		var err error
+		switch {
+		case strings.HasPrefix(rest, ".."):
+			seg, rest, err = parseDotSegment(rest[2:])
+			seg.descendant = true
+		case strings.HasPrefix(rest, "."):
+			seg, rest, err = parseDotSegment(rest[1:])
+		case strings.HasPrefix(rest, "["):
+			seg, rest, err = parseBracketSegment(rest)
+		default:
+			err = fmt.Errorf("unexpected %q", rest)
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid JSONPath %q: %w", expression, err)
+		}
+		result = append(result, seg)
+	}
+	return result, nil
+}
+
+var reMemberName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*`)
+
+func parseDotSegment(rest string) (segment, string, error) {
+	if strings.HasPrefix(rest, "*") {
+		return segment{wildcard: true}, rest[1:], nil
+	}
+	if strings.HasPrefix(rest, "[") {
+		return parseBracketSegment(rest)
+	}
+	name := reMemberName.FindString(rest)
+	if name == "" {
+		return segment{}, "", fmt.Errorf("expected a member name at %q", rest)
+	}
+	return segment{names: []string{name}}, rest[len(name):], nil
+}
+
+func parseBracketSegment(rest string) (segment, string, error) {
+	result := segment{}
+	rest = strings.TrimSpace(rest[1:])
+	for {
+		switch {
+		case rest == "":
+			return segment{}, "", fmt.Errorf("missing ']'")
+		case strings.HasPrefix(rest, "?"):
+			return segment{}, "", fmt.Errorf("filter expressions are not supported")
+		case strings.HasPrefix(rest, "*"):
+			result.wildcard = true
+			rest = rest[1:]
+		case rest[0] == '\'' || rest[0] == '"':
+			name, remaining, err := parseQuoted(rest)
+			if err != nil {
+				return segment{}, "", err
+			}
+			result.names = append(result.names, name)
+			rest = remaining
+		default:
+			end := strings.IndexAny(rest, ",]")
+			if end < 0 {
+				return segment{}, "", fmt.Errorf("missing ']'")
+			}
+			index, err := strconv.Atoi(strings.TrimSpace(rest[:end]))
+			if err != nil {
+				return segment{}, "", fmt.Errorf("invalid selector %q", strings.TrimSpace(rest[:end]))
+			}
+			result.indices = append(result.indices, index)
+			rest = rest[end:]
+		}
+
+		rest = strings.TrimSpace(rest)
+		switch {
+		case strings.HasPrefix(rest, "]"):
+			return result, rest[1:], nil
+		case strings.HasPrefix(rest, ","):
+			rest = strings.TrimSpace(rest[1:])
+		default:
+			return segment{}, "", fmt.Errorf("expected ',' or ']' at %q", rest)
+		}
+	}
+}
+
+func parseQuoted(rest string) (string, string, error) {
+	quote := rest[0]
+	var builder strings.Builder
+	for i := 1; i < len(rest); i++ {
+		switch rest[i] {
+		case '\\':
+			if i+1 == len(rest) {
+				return "", "", fmt.Errorf("unterminated string")
+			}
+			i++
+			builder.WriteByte(rest[i])
+		case quote:
+			return builder.String(), rest[i+1:], nil
+		default:
+			builder.WriteByte(rest[i])
+		}
+	}
+	return "", "", fmt.Errorf("unterminated string")
+}
+
+// selectLocations returns the locations of the nodes that the path selects in the document
+func (p path) selectLocations(doc any) []location {
+	current := []location{{}}
+	for _, seg := range p {
+		next := []location{}
+		for _, loc := range current {
+			next = append(next, seg.selectLocations(loc, getValue(doc, loc))...)
+		}
+		current = next
+	}
+	return current
+}
+
+func (seg segment) selectLocations(loc location, value any) []location {
+	result := seg.selectChildren(loc, value)
+	if !seg.descendant {
+		return result
+	}
+
+	for _, child := range getChildren(loc, value) {
+		result = append(result, seg.selectLocations(child.loc, child.value)...)
+	}
+	return result
+}
+
+func (seg segment) selectChildren(loc location, value any) []location {
+	result := []location{}
+	switch value := value.(type) {
+	case map[string]any:
+		if seg.wildcard {
+			for _, child := range getChildren(loc, value) {
+				result = append(result, child.loc)
+			}
+			return result
+		}
+		for _, name := range seg.names {
+			if _, ok := value[name]; ok {
+				result = append(result, appendLocation(loc, name))
+			}
+		}
+	case []any:
+		if seg.wildcard {
+			for _, child := range getChildren(loc, value) {
+				result = append(result, child.loc)
+			}
+			return result
+		}
+		for _, index := range seg.indices {
+			if index < 0 {
+				index += len(value)
+			}
+			if index >= 0 && index < len(value) {
+				result = append(result, appendLocation(loc, index))
+			}
+		}
+	}
+	return result
+}
+
+type child struct {
+	loc   location
+	value any
+}
+
+// getChildren returns the members of an object, sorted by name, or the elements of an array
+func getChildren(loc location, value any) []child {
+	result := []child{}
+	switch value := value.(type) {
+	case map[string]any:
+		names := make([]string, 0, len(value))
+		for name := range value {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			result = append(result, child{loc: appendLocation(loc, name), value: value[name]})
+		}
+	case []any:
+		for i, item := range value {
+			result = append(result, child{loc: appendLocation(loc, i), value: item})
+		}
+	}
+	return result
+}
+
+func appendLocation(loc location, step any) location {
+	result := make(location, len(loc), len(loc)+1)
+	copy(result, loc)
+	return append(result, step)
+}
+
+func getValue(doc any, loc location) any {
+	for _, step := range loc {
+		switch step := step.(type) {
+		case string:
+			doc = doc.(map[string]any)[step]
+		case int:
+			doc = doc.([]any)[step]
+		}
+	}
+	return doc
+}
+
+// setValue replaces the node at the location, or deletes it from its parent if remove is true, and returns the updated document
+func setValue(doc any, loc location, value any, remove bool) any {
+	if len(loc) == 0 {
+		return value
+	}
+
+	switch step := loc[0].(type) {
+	case string:
+		object := doc.(map[string]any)
+		if len(loc) == 1 && remove {
+			delete(object, step)
+		} else {
+			object[step] = setValue(object[step], loc[1:], value, remove)
+		}
+		return object
+	case int:
+		array := doc.([]any)
+		if len(loc) == 1 && remove {
+			return append(array[:step:step], array[step+1:]...)
+		}
+		array[step] = setValue(array[step], loc[1:], value, remove)
+		return array
+	}
+	return doc
+}
+
+// formatLocation returns a normalized JSONPath for the location
+func formatLocation(loc location) string {
+	var builder strings.Builder
+	builder.WriteString("$")
+	for _, step := range loc {
+		switch step := step.(type) {
+		case string:
+			if reMemberName.FindString(step) == step {
+				builder.WriteString("." + step)
+			} else {
+				builder.WriteString("['" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(step) + "']")
+			}
+		case int:
+			builder.WriteString("[" + strconv.Itoa(step) + "]")
+		}
+	}
+	return builder.String()
+}
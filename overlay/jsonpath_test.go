@@ -0,0 +1,76 @@
+package overlay
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func getTestDocument() any {
+	return map[string]any{
+		"paths": map[string]any{
+			"/pets": map[string]any{
+				"get":  map[string]any{"operationId": "listPets"},
+				"post": map[string]any{"operationId": "addPet"},
+			},
+		},
+		"tags": []any{
+			map[string]any{"name": "pets"},
+			map[string]any{"name": "internal"},
+		},
+	}
+}
+
+func TestParsePath_Invalid(t *testing.T) {
+	for _, expression := range []string{"paths", "$.", "$['a'", "$[?(@.name)]", "$[a]", "$['a' 'b']", "$.['unterminated]"} {
+		_, err := parsePath(expression)
+		require.Error(t, err, expression)
+	}
+}
+
+func TestSelect_Child(t *testing.T) {
+	p, err := parsePath("$.paths['/pets'].get")
+	require.NoError(t, err)
+	require.Equal(t, []location{{"paths", "/pets", "get"}}, p.selectLocations(getTestDocument()))
+}
+
+func TestSelect_DoubleQuotes(t *testing.T) {
+	p, err := parsePath(`$["paths"]["/pets"]`)
+	require.NoError(t, err)
+	require.Equal(t, []location{{"paths", "/pets"}}, p.selectLocations(getTestDocument()))
+}
+
+func TestSelect_Wildcard(t *testing.T) {
+	p, err := parsePath("$.paths.*.*")
+	require.NoError(t, err)
+	require.Equal(t, []location{{"paths", "/pets", "get"}, {"paths", "/pets", "post"}}, p.selectLocations(getTestDocument()))
+}
+
+func TestSelect_Indices(t *testing.T) {
+	p, err := parsePath("$.tags[0, -1, 5]")
+	require.NoError(t, err)
+	require.Equal(t, []location{{"tags", 0}, {"tags", 1}}, p.selectLocations(getTestDocument()))
+}
+
+func TestSelect_Descendants(t *testing.T) {
+	p, err := parsePath("$..operationId")
+	require.NoError(t, err)
+	require.Equal(t, []location{{"paths", "/pets", "get", "operationId"}, {"paths", "/pets", "post", "operationId"}}, p.selectLocations(getTestDocument()))
+}
+
+func TestSelect_Missing(t *testing.T) {
+	p, err := parsePath("$.components.schemas")
+	require.NoError(t, err)
+	require.Empty(t, p.selectLocations(getTestDocument()))
+}
+
+func TestFormatLocation(t *testing.T) {
+	require.Equal(t, `$.paths['/pets'].get.parameters[0]['x-it\'s']`, formatLocation(location{"paths", "/pets", "get", "parameters", 0, "x-it's"}))
+}
+
+func TestFormatLocation_RoundTrip(t *testing.T) {
+	loc := location{"paths", "/pets", "get"}
+	p, err := parsePath(formatLocation(loc))
+	require.NoError(t, err)
+	require.Equal(t, []location{loc}, p.selectLocations(getTestDocument()))
+}
@@ -0,0 +1,114 @@
+package overlay
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"os"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"gopkg.in/yaml.v3"
+)
+
+// Version is the version of the Overlay specification that this package supports
+const Version = "1.0.0"
+
+// Overlay is an OpenAPI Overlay document: an ordered list of actions that modify an OpenAPI spec
+type Overlay struct {
+	Overlay string   `json:"overlay" yaml:"overlay"`
+	Info    Info     `json:"info" yaml:"info"`
+	Extends string   `json:"extends,omitempty" yaml:"extends,omitempty"`
+	Actions []Action `json:"actions" yaml:"actions"`
+}
+
+type Info struct {
+	Title   string `json:"title" yaml:"title"`
+	Version string `json:"version" yaml:"version"`
+}
+
+// Action modifies the nodes that its target selects
+// Remove deletes the nodes, otherwise Update is merged into objects and appended to arrays
+type Action struct {
+	Target      string `json:"target" yaml:"target"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
+	Update      any    `json:"update,omitempty" yaml:"update,omitempty"`
+	Remove      bool   `json:"remove,omitempty" yaml:"remove,omitempty"`
+}
+
+// Load reads an overlay from a YAML or JSON file
+func Load(file string) (*Overlay, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+
+	return Parse(data)
+}
+
+// Parse parses an overlay from YAML or JSON
+func Parse(data []byte) (*Overlay, error) {
+	var result Overlay
+	if err := yaml.Unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+
+	if result.Overlay == "" {
+		return nil, fmt.Errorf("missing overlay version")
+	}
+
+	for i, action := range result.Actions {
+		if action.Target == "" {
+			return nil, fmt.Errorf("action #%d has no target", i+1)
+		}
+		if _, err := parsePath(action.Target); err != nil {
+			return nil, fmt.Errorf("action #%d: %w", i+1, err)
+		}
+	}
+
+	return &result, nil
+}
+
+// ApplyToSpec applies the overlays to the spec and returns the resulting spec
+// location is the path or URL of the spec, it is used to resolve relative references
+func ApplyToSpec(spec *openapi3.T, location string, overlays ...*Overlay) (*openapi3.T, error) {
+	doc, err := toDocument(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, overlay := range overlays {
+		if doc, err = overlay.Apply(doc); err != nil {
+			return nil, err
+		}
+	}
+
+	data, err := json.Marshal(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	return loader.LoadFromDataWithPath(data, getURL(location))
+}
+
+// toDocument converts the spec to a generic JSON document
+func toDocument(spec *openapi3.T) (any, error) {
+	data, err := json.Marshal(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	var result any
+	if err := json.Unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+func getURL(location string) *url.URL {
+	if u, err := url.ParseRequestURI(location); err == nil && (u.Scheme == "http" || u.Scheme == "https") {
+		return u
+	}
+	return &url.URL{Path: location}
+}
@@ -0,0 +1,136 @@
+package overlay_test
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oasdiff/oasdiff/overlay"
+	"github.com/stretchr/testify/require"
+)
+
+func loadSpec(t *testing.T, file string) *openapi3.T {
+	t.Helper()
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	spec, err := loader.LoadFromFile(file)
+	require.NoError(t, err)
+	return spec
+}
+
+func TestLoad(t *testing.T) {
+	o, err := overlay.Load("../data/overlay/public.yaml")
+	require.NoError(t, err)
+	require.Equal(t, "1.0.0", o.Overlay)
+	require.Equal(t, "Public contract", o.Info.Title)
+	require.Len(t, o.Actions, 4)
+	require.True(t, o.Actions[0].Remove)
+}
+
+func TestLoad_InvalidTarget(t *testing.T) {
+	_, err := overlay.Load("../data/overlay/invalid.yaml")
+	require.EqualError(t, err, `action #1: invalid JSONPath "paths": must start with '$'`)
+}
+
+func TestLoad_MissingVersion(t *testing.T) {
+	_, err := overlay.Parse([]byte("actions: []"))
+	require.EqualError(t, err, "missing overlay version")
+}
+
+func TestLoad_MissingFile(t *testing.T) {
+	_, err := overlay.Load("../data/overlay/missing.yaml")
+	require.Error(t, err)
+}
+
+func TestApplyToSpec(t *testing.T) {
+	o, err := overlay.Load("../data/overlay/public.yaml")
+	require.NoError(t, err)
+
+	spec, err := overlay.ApplyToSpec(loadSpec(t, "../data/overlay/base.yaml"), "../data/overlay/base.yaml", o)
+	require.NoError(t, err)
+
+	require.Nil(t, spec.Paths.Value("/admin/pets"))
+	require.NotNil(t, spec.Paths.Value("/pets"))
+	require.Len(t, spec.Tags, 1)
+	require.Equal(t, "pets", spec.Tags[0].Name)
+	require.NotContains(t, spec.Components.Schemas["Pet"].Value.Properties, "x-internal-notes")
+	require.Equal(t, "The pets API", spec.Info.Description)
+	require.Equal(t, "Pets", spec.Info.Title)
+}
+
+func TestApply_UpdateArray(t *testing.T) {
+	o, err := overlay.Parse([]byte(`
+overlay: 1.0.0
+info:
+  title: Add a tag
+  version: 1.0.0
+actions:
+  - target: $.tags
+    update:
+      name: new
+`))
+	require.NoError(t, err)
+
+	doc, err := o.Apply(map[string]any{"tags": []any{map[string]any{"name": "pets"}}})
+	require.NoError(t, err)
+	require.Equal(t, map[string]any{"tags": []any{map[string]any{"name": "pets"}, map[string]any{"name": "new"}}}, doc)
+}
+
+func TestApply_RemoveRoot(t *testing.T) {
+	o := overlay.Overlay{Actions: []overlay.Action{{Target: "$", Remove: true}}}
+	_, err := o.Apply(map[string]any{})
+	require.EqualError(t, err, `failed to apply action #1 with target "$": can't remove the root of the document`)
+}
+
+func TestGenerate_RoundTrip(t *testing.T) {
+	for _, files := range [][2]string{
+		{"../data/overlay/base.yaml", "../data/overlay/revision.yaml"},
+		{"../data/openapi-test1.yaml", "../data/openapi-test3.yaml"},
+		{"../data/openapi-test3.yaml", "../data/openapi-test1.yaml"},
+	} {
+		base := loadSpec(t, files[0])
+		revision := loadSpec(t, files[1])
+
+		o, err := overlay.Generate(base, revision)
+		require.NoError(t, err)
+		require.NotEmpty(t, o.Actions)
+
+		result, err := overlay.ApplyToSpec(base, files[0], o)
+		require.NoError(t, err)
+
+		// reload the revision without overlays to normalize it the same way as the result
+		expected, err := overlay.ApplyToSpec(revision, files[1])
+		require.NoError(t, err)
+
+		require.Equal(t, toJSON(t, expected), toJSON(t, result), files)
+	}
+}
+
+func TestGenerate_NoChanges(t *testing.T) {
+	spec := loadSpec(t, "../data/overlay/base.yaml")
+	o, err := overlay.Generate(spec, spec)
+	require.NoError(t, err)
+	require.Empty(t, o.Actions)
+	require.Equal(t, "Changes from 1.0.0 to 1.0.0", o.Info.Title)
+}
+
+func TestGenerate_Actions(t *testing.T) {
+	o, err := overlay.Generate(loadSpec(t, "../data/overlay/base.yaml"), loadSpec(t, "../data/overlay/revision.yaml"))
+	require.NoError(t, err)
+
+	targets := []string{}
+	for _, action := range o.Actions {
+		targets = append(targets, action.Target)
+	}
+	require.Contains(t, targets, "$.paths['/admin/pets']")
+	require.Contains(t, targets, "$.paths['/pets'].get.parameters[0].schema")
+	require.Contains(t, targets, "$.components.schemas.Pet.required")
+}
+
+func toJSON(t *testing.T, spec *openapi3.T) string {
+	t.Helper()
+
+	data, err := spec.MarshalJSON()
+	require.NoError(t, err)
+	return string(data)
+}
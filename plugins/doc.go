@@ -0,0 +1,5 @@
+/*
+Package plugins runs external executables that add organization-specific checks to oasdiff
+Each plugin receives the diff and the specs as JSON on stdin and writes the changes it found as JSON to stdout
+*/
+package plugins
@@ -0,0 +1,44 @@
+package plugins
+
+import (
+	"time"
+)
+
+// DefaultTimeout is the time that a plugin is allowed to run if its timeout isn't specified
+const DefaultTimeout = 30 * time.Second
+
+// Plugin describes an external executable that implements additional checks
+type Plugin struct {
+	Name    string        `mapstructure:"name"`
+	Command string        `mapstructure:"command"`
+	Args    []string      `mapstructure:"args"`
+	Timeout time.Duration `mapstructure:"timeout"`
+	// Ids are the ids of the changes that the plugin reports, their levels can be overridden with --severity-levels
+	Ids []string `mapstructure:"ids"`
+}
+
+// Plugins is a list of plugins
+type Plugins []Plugin
+
+// GetIds returns the ids of the changes that the plugins may report, including PluginFailedId
+func (plugins Plugins) GetIds() []string {
+	result := []string{PluginFailedId}
+	for _, plugin := range plugins {
+		result = append(result, plugin.Ids...)
+	}
+	return result
+}
+
+func (plugin Plugin) getName() string {
+	if plugin.Name != "" {
+		return plugin.Name
+	}
+	return plugin.Command
+}
+
+func (plugin Plugin) getTimeout() time.Duration {
+	if plugin.Timeout > 0 {
+		return plugin.Timeout
+	}
+	return DefaultTimeout
+}
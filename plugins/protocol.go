@@ -0,0 +1,30 @@
+package plugins
+
+import (
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oasdiff/oasdiff/diff"
+)
+
+// Request is the JSON document that oasdiff writes to the plugin's stdin
+// Diff has the same structure as the output of `oasdiff diff -f json`
+// Base and Revision are omitted in composed mode
+type Request struct {
+	Diff     *diff.Diff  `json:"diff"`
+	Base     *openapi3.T `json:"base,omitempty"`
+	Revision *openapi3.T `json:"revision,omitempty"`
+}
+
+// Response is the JSON document that the plugin writes to its stdout
+type Response struct {
+	Changes []Change `json:"changes"`
+}
+
+// Change is a change reported by a plugin
+type Change struct {
+	Id        string `json:"id"`
+	Level     string `json:"level"`
+	Path      string `json:"path,omitempty"`
+	Operation string `json:"operation,omitempty"`
+	Message   string `json:"message"`
+	Location  string `json:"location,omitempty"`
+}
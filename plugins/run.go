@@ -0,0 +1,167 @@
+package plugins
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/diff"
+	"github.com/oasdiff/oasdiff/load"
+)
+
+const (
+	// PluginFailedId is the id of the change that is reported when a plugin fails
+	PluginFailedId = "plugin-failed"
+
+	// PluginAttribute is the attribute that holds the name of the plugin that reported a change
+	PluginAttribute = "plugin"
+	// LocationAttribute is the attribute that holds the location reported by the plugin
+	LocationAttribute = "location"
+
+	waitDelay = time.Second
+)
+
+// RunAll runs the plugins one after the other and returns their changes
+// A plugin that fails, times out or returns an invalid response doesn't affect the other plugins, it is reported as a warning instead
+func RunAll(plugins Plugins, diffReport *diff.Diff, specInfoPair *load.SpecInfoPair) checker.Changes {
+	result := checker.Changes{}
+	if len(plugins) == 0 {
+		return result
+	}
+
+	source := getSource(specInfoPair)
+
+	request, err := json.Marshal(newRequest(diffReport, specInfoPair))
+	if err != nil {
+		for _, plugin := range plugins {
+			result = append(result, newFailure(plugin, source, err))
+		}
+		return result
+	}
+
+	for _, plugin := range plugins {
+		changes, err := Run(plugin, request, source)
+		if err != nil {
+			result = append(result, newFailure(plugin, source, err))
+			continue
+		}
+		result = append(result, changes...)
+	}
+
+	return result
+}
+
+func newRequest(diffReport *diff.Diff, specInfoPair *load.SpecInfoPair) Request {
+	request := Request{}
+	if diffReport != nil {
+		// the endpoints diff is a derived view of the paths diff which can't be represented in JSON, like in `oasdiff diff -f json`
+		d := *diffReport
+		d.EndpointsDiff = nil
+		request.Diff = &d
+	}
+	if specInfoPair != nil {
+		request.Base = specInfoPair.Base.Spec
+		request.Revision = specInfoPair.Revision.Spec
+	}
+	return request
+}
+
+func getSource(specInfoPair *load.SpecInfoPair) *load.Source {
+	if specInfoPair == nil || specInfoPair.Revision == nil {
+		return load.NewSource("")
+	}
+	return load.NewSource(specInfoPair.Revision.Url)
+}
+
+// Run runs a single plugin with the given request and converts its response to changes
+func Run(plugin Plugin, request []byte, source *load.Source) (checker.Changes, error) {
+	if plugin.Command == "" {
+		return nil, errors.New("no command")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), plugin.getTimeout())
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, plugin.Command, plugin.Args...)
+	cmd.Stdin = bytes.NewReader(request)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	// don't wait for subprocesses that keep the output open after the plugin was killed
+	cmd.WaitDelay = waitDelay
+
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return nil, fmt.Errorf("timed out after %s", plugin.getTimeout())
+		}
+		if message := strings.TrimSpace(stderr.String()); message != "" {
+			return nil, fmt.Errorf("%w: %s", err, message)
+		}
+		return nil, err
+	}
+
+	var response Response
+	if err := json.Unmarshal(stdout.Bytes(), &response); err != nil {
+		return nil, fmt.Errorf("invalid response: %w", err)
+	}
+
+	result := make(checker.Changes, 0, len(response.Changes))
+	for i, change := range response.Changes {
+		if change.Id == "" {
+			return nil, fmt.Errorf("invalid response: change #%d has no id", i+1)
+		}
+
+		level, err := checker.NewLevel(change.Level)
+		if err != nil {
+			return nil, fmt.Errorf("invalid response: change %q has an invalid level %q", change.Id, change.Level)
+		}
+
+		result = append(result, newChange(plugin, source, change, level))
+	}
+
+	return result, nil
+}
+
+func newChange(plugin Plugin, source *load.Source, change Change, level checker.Level) checker.CustomChange {
+	attributes := map[string]any{PluginAttribute: plugin.getName()}
+	if change.Location != "" {
+		attributes[LocationAttribute] = change.Location
+	}
+
+	message := change.Message
+	if message == "" {
+		message = change.Id
+	}
+
+	return checker.CustomChange{
+		Id:        change.Id,
+		Text:      message,
+		Args:      []any{change.Location},
+		Level:     level,
+		Operation: strings.ToUpper(change.Operation),
+		Path:      change.Path,
+		Source:    source,
+		CommonChange: checker.CommonChange{
+			Attributes: attributes,
+		},
+	}
+}
+
+func newFailure(plugin Plugin, source *load.Source, err error) checker.CustomChange {
+	return checker.CustomChange{
+		Id:     PluginFailedId,
+		Text:   fmt.Sprintf("plugin %q failed: %v", plugin.getName(), err),
+		Args:   []any{plugin.getName()},
+		Level:  checker.WARN,
+		Source: source,
+		CommonChange: checker.CommonChange{
+			Attributes: map[string]any{PluginAttribute: plugin.getName()},
+		},
+	}
+}
@@ -0,0 +1,102 @@
+//go:build unix
+
+package plugins_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/diff"
+	"github.com/oasdiff/oasdiff/load"
+	"github.com/oasdiff/oasdiff/plugins"
+	"github.com/stretchr/testify/require"
+)
+
+func getDiff(t *testing.T) (*diff.Diff, *load.SpecInfoPair) {
+	t.Helper()
+
+	loader := openapi3.NewLoader()
+	base, err := load.NewSpecInfo(loader, load.NewSource("../data/custom-rules/base.yaml"))
+	require.NoError(t, err)
+	revision, err := load.NewSpecInfo(loader, load.NewSource("../data/custom-rules/revision.yaml"))
+	require.NoError(t, err)
+
+	d, _, err := diff.GetWithOperationsSourcesMap(diff.NewConfig(), base, revision)
+	require.NoError(t, err)
+	return d, load.NewSpecInfoPair(base, revision)
+}
+
+func TestRunAll(t *testing.T) {
+	d, specInfoPair := getDiff(t)
+
+	changes := plugins.RunAll(plugins.Plugins{{Name: "api-guidelines", Command: "../data/plugins/api-guidelines.sh"}}, d, specInfoPair)
+	require.Equal(t, checker.Changes{
+		checker.CustomChange{
+			Id:        "v1-endpoint-changed",
+			Text:      "v1 endpoints are frozen",
+			Args:      []any{"responses/200"},
+			Level:     checker.WARN,
+			Operation: "GET",
+			Path:      "/v1/pets",
+			Source:    load.NewSource("../data/custom-rules/revision.yaml"),
+			CommonChange: checker.CommonChange{
+				Attributes: map[string]any{
+					plugins.PluginAttribute:   "api-guidelines",
+					plugins.LocationAttribute: "responses/200",
+				},
+			},
+		},
+	}, changes)
+}
+
+func TestRunAll_Composed(t *testing.T) {
+	d, _ := getDiff(t)
+
+	changes := plugins.RunAll(plugins.Plugins{{Name: "api-guidelines", Command: "../data/plugins/api-guidelines.sh"}}, d, nil)
+	require.Len(t, changes, 1)
+	require.Equal(t, plugins.PluginFailedId, changes[0].GetId())
+	require.Equal(t, `plugin "api-guidelines" failed: exit status 1: missing specs`, changes[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+}
+
+func TestRunAll_ErrorIsolation(t *testing.T) {
+	d, specInfoPair := getDiff(t)
+
+	changes := plugins.RunAll(plugins.Plugins{
+		{Name: "fail", Command: "../data/plugins/fail.sh"},
+		{Name: "api-guidelines", Command: "../data/plugins/api-guidelines.sh"},
+	}, d, specInfoPair)
+	require.Len(t, changes, 2)
+	require.Equal(t, plugins.PluginFailedId, changes[0].GetId())
+	require.Equal(t, checker.WARN, changes[0].GetLevel())
+	require.Equal(t, `plugin "fail" failed: exit status 2: something went wrong`, changes[0].GetUncolorizedText(checker.NewDefaultLocalizer()))
+	require.Equal(t, "v1-endpoint-changed", changes[1].GetId())
+}
+
+func TestRun_Timeout(t *testing.T) {
+	start := time.Now()
+	_, err := plugins.Run(plugins.Plugin{Command: "../data/plugins/sleep.sh", Timeout: 100 * time.Millisecond}, []byte("{}"), load.NewSource(""))
+	require.EqualError(t, err, "timed out after 100ms")
+	require.Less(t, time.Since(start), 5*time.Second)
+}
+
+func TestRun_InvalidResponse(t *testing.T) {
+	_, err := plugins.Run(plugins.Plugin{Command: "../data/plugins/invalid-response.sh"}, []byte("{}"), load.NewSource(""))
+	require.ErrorContains(t, err, "invalid response")
+}
+
+func TestRun_InvalidLevel(t *testing.T) {
+	_, err := plugins.Run(plugins.Plugin{Command: "../data/plugins/invalid-level.sh"}, []byte("{}"), load.NewSource(""))
+	require.EqualError(t, err, `invalid response: change "my-change" has an invalid level "critical"`)
+}
+
+func TestRun_MissingCommand(t *testing.T) {
+	_, err := plugins.Run(plugins.Plugin{Command: "../data/plugins/missing.sh"}, []byte("{}"), load.NewSource(""))
+	require.Error(t, err)
+}
+
+func TestRun_NoCommand(t *testing.T) {
+	_, err := plugins.Run(plugins.Plugin{Name: "empty"}, []byte("{}"), load.NewSource(""))
+	require.EqualError(t, err, "no command")
+}
@@ -0,0 +1,5 @@
+/*
+Package traffic matches recorded API traffic against an OpenAPI spec
+This is helpful to estimate the impact of breaking changes on actual clients
+*/
+package traffic
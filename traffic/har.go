@@ -0,0 +1,74 @@
+package traffic
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+type harFile struct {
+	Log struct {
+		Entries []struct {
+			Request harRequest `json:"request"`
+		} `json:"entries"`
+	} `json:"log"`
+}
+
+type harRequest struct {
+	Method      string         `json:"method"`
+	URL         string         `json:"url"`
+	Headers     []harNameValue `json:"headers"`
+	QueryString []harNameValue `json:"queryString"`
+	PostData    *struct {
+		Text string `json:"text"`
+	} `json:"postData"`
+}
+
+type harNameValue struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// LoadHAR reads recorded requests from an HTTP Archive (HAR) document
+// Clients are identified by the X-Client-Id header, or by the User-Agent header if X-Client-Id is missing
+func LoadHAR(r io.Reader) (Samples, error) {
+	var har harFile
+	if err := json.NewDecoder(r).Decode(&har); err != nil {
+		return nil, err
+	}
+
+	result := make(Samples, 0, len(har.Log.Entries))
+	for _, entry := range har.Log.Entries {
+		request := entry.Request
+
+		header := http.Header{}
+		for _, h := range request.Headers {
+			header.Add(h.Name, h.Value)
+		}
+
+		var query url.Values
+		if len(request.QueryString) > 0 {
+			query = url.Values{}
+			for _, q := range request.QueryString {
+				query.Add(q.Name, q.Value)
+			}
+		}
+
+		var body []byte
+		if request.PostData != nil {
+			body = []byte(request.PostData.Text)
+		}
+
+		result = append(result, newSample(request.Method, request.URL, query, header, body, getHARClient(header)))
+	}
+
+	return result, nil
+}
+
+func getHARClient(header http.Header) string {
+	if client := header.Get(ClientHeader); client != "" {
+		return client
+	}
+	return header.Get("User-Agent")
+}
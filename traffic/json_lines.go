@@ -0,0 +1,104 @@
+package traffic
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+)
+
+type jsonLine struct {
+	Method  string            `json:"method"`
+	Path    string            `json:"path"`
+	Query   json.RawMessage   `json:"query"`
+	Headers map[string]string `json:"headers"`
+	Body    json.RawMessage   `json:"body"`
+	Client  string            `json:"client"`
+}
+
+// LoadJSONLines reads recorded requests from an access log with one JSON object per line
+// Each object should contain the fields: method, path, query, headers, body and client
+// The query can be a query string or an object, the body can be any JSON value or a string containing the raw body
+func LoadJSONLines(r io.Reader) (Samples, error) {
+	result := Samples{}
+
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 16*1024*1024)
+
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		line := bytes.TrimSpace(scanner.Bytes())
+		if len(line) == 0 {
+			continue
+		}
+
+		var entry jsonLine
+		if err := json.Unmarshal(line, &entry); err != nil {
+			return nil, fmt.Errorf("invalid line #%d: %w", lineNum, err)
+		}
+
+		query, err := getQuery(entry.Query)
+		if err != nil {
+			return nil, fmt.Errorf("invalid query on line #%d: %w", lineNum, err)
+		}
+
+		header := http.Header{}
+		for name, value := range entry.Headers {
+			header.Set(name, value)
+		}
+
+		result = append(result, newSample(entry.Method, entry.Path, query, header, getBody(entry.Body), entry.Client))
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func getQuery(raw json.RawMessage) (url.Values, error) {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil, nil
+	}
+
+	var queryString string
+	if err := json.Unmarshal(raw, &queryString); err == nil {
+		return url.ParseQuery(queryString)
+	}
+
+	var queryObject map[string]any
+	if err := json.Unmarshal(raw, &queryObject); err != nil {
+		return nil, err
+	}
+
+	result := url.Values{}
+	for name, value := range queryObject {
+		switch v := value.(type) {
+		case []any:
+			for _, item := range v {
+				result.Add(name, fmt.Sprint(item))
+			}
+		default:
+			result.Add(name, fmt.Sprint(v))
+		}
+	}
+	return result, nil
+}
+
+func getBody(raw json.RawMessage) []byte {
+	if len(raw) == 0 || string(raw) == "null" {
+		return nil
+	}
+
+	var bodyString string
+	if err := json.Unmarshal(raw, &bodyString); err == nil {
+		return []byte(bodyString)
+	}
+
+	return raw
+}
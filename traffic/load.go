@@ -0,0 +1,47 @@
+package traffic
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ClientHeader is the request header used to identify clients in HAR files
+const ClientHeader = "X-Client-Id"
+
+// Load reads recorded traffic from a HAR file (.har extension) or from a JSON lines access log (any other extension)
+func Load(path string) (Samples, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	samples, err := load(f, path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load traffic from %q: %w", path, err)
+	}
+	return samples, nil
+}
+
+// LoadAll reads recorded traffic from multiple files
+func LoadAll(paths []string) (Samples, error) {
+	result := Samples{}
+	for _, path := range paths {
+		samples, err := Load(path)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, samples...)
+	}
+	return result, nil
+}
+
+func load(r io.Reader, path string) (Samples, error) {
+	if strings.EqualFold(filepath.Ext(path), ".har") {
+		return LoadHAR(r)
+	}
+	return LoadJSONLines(r)
+}
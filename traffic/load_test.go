@@ -0,0 +1,63 @@
+package traffic_test
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/oasdiff/oasdiff/traffic"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad_JSONLines(t *testing.T) {
+	samples, err := traffic.Load("../data/traffic/traffic.jsonl")
+	require.NoError(t, err)
+	require.Len(t, samples, 7)
+
+	require.Equal(t, "GET", samples[0].Method)
+	require.Equal(t, "/v1/pets", samples[0].Path)
+	require.Equal(t, url.Values{"status": {"available"}}, samples[0].Query)
+	require.Equal(t, "a", samples[0].Client)
+
+	require.Equal(t, url.Values{"status": {"pending"}, "limit": {"5"}}, samples[1].Query)
+	require.Equal(t, url.Values{"status": {"pending"}}, samples[2].Query)
+	require.JSONEq(t, `{"name": "rex", "kind": "dog"}`, string(samples[5].Body))
+}
+
+func TestLoad_HAR(t *testing.T) {
+	samples, err := traffic.Load("../data/traffic/traffic.har")
+	require.NoError(t, err)
+	require.Len(t, samples, 2)
+
+	require.Equal(t, "POST", samples[0].Method)
+	require.Equal(t, "/v1/pets", samples[0].Path)
+	require.Equal(t, "mobile", samples[0].Client)
+	require.JSONEq(t, `{"name": "tweety", "kind": "bird"}`, string(samples[0].Body))
+
+	require.Equal(t, "DELETE", samples[1].Method)
+	require.Equal(t, "web", samples[1].Client)
+}
+
+func TestLoad_NotFound(t *testing.T) {
+	_, err := traffic.Load("../data/traffic/missing.jsonl")
+	require.Error(t, err)
+}
+
+func TestLoadJSONLines_Invalid(t *testing.T) {
+	_, err := traffic.LoadJSONLines(strings.NewReader("{\"method\": \"GET\"}\nnot json\n"))
+	require.EqualError(t, err, "invalid line #2: invalid character 'o' in literal null (expecting 'u')")
+}
+
+func TestLoadJSONLines_StringBody(t *testing.T) {
+	samples, err := traffic.LoadJSONLines(strings.NewReader(`{"method": "post", "path": "/pets", "body": "{\"kind\": \"cat\"}"}`))
+	require.NoError(t, err)
+	require.Len(t, samples, 1)
+	require.Equal(t, "POST", samples[0].Method)
+	require.JSONEq(t, `{"kind": "cat"}`, string(samples[0].Body))
+}
+
+func TestLoadAll(t *testing.T) {
+	samples, err := traffic.LoadAll([]string{"../data/traffic/traffic.jsonl", "../data/traffic/traffic.har"})
+	require.NoError(t, err)
+	require.Len(t, samples, 9)
+}
@@ -0,0 +1,131 @@
+package traffic
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oasdiff/oasdiff/utils"
+)
+
+// Matcher matches recorded requests to the operations of an OpenAPI spec
+type Matcher struct {
+	endpoints []*endpoint
+	prefixes  []string
+}
+
+type endpoint struct {
+	path    string
+	regexp  *regexp.Regexp
+	params  uint
+	methods utils.StringSet
+}
+
+// NewMatcher creates a matcher for the operations of the given spec
+// Request paths may include the base path of one of the spec's servers
+func NewMatcher(spec *openapi3.T) *Matcher {
+	matcher := Matcher{
+		endpoints: []*endpoint{},
+		prefixes:  getPrefixes(spec.Servers),
+	}
+
+	if spec.Paths == nil {
+		return &matcher
+	}
+
+	for path, pathItem := range spec.Paths.Map() {
+		methods := utils.StringSet{}
+		for method := range pathItem.Operations() {
+			methods.Add(method)
+		}
+
+		_, params, _ := utils.NormalizeTemplatedPath(path)
+		matcher.endpoints = append(matcher.endpoints, &endpoint{
+			path:    path,
+			regexp:  templateToRegexp(path),
+			params:  params,
+			methods: methods,
+		})
+	}
+
+	// prefer concrete paths over templated ones, for example: /pets/mine over /pets/{id}
+	sort.Slice(matcher.endpoints, func(i, j int) bool {
+		ei, ej := matcher.endpoints[i], matcher.endpoints[j]
+		if ei.params != ej.params {
+			return ei.params < ej.params
+		}
+		return ei.path < ej.path
+	})
+
+	return &matcher
+}
+
+// Match returns the spec path of the operation that handles the given request
+func (matcher *Matcher) Match(method, path string) (string, bool) {
+	method = strings.ToUpper(method)
+
+	for _, candidate := range matcher.getCandidates(path) {
+		for _, endpoint := range matcher.endpoints {
+			if endpoint.methods.Contains(method) && endpoint.regexp.MatchString(candidate) {
+				return endpoint.path, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+func (matcher *Matcher) getCandidates(path string) []string {
+	result := []string{path}
+	for _, prefix := range matcher.prefixes {
+		if stripped, found := strings.CutPrefix(path, prefix); found && strings.HasPrefix(stripped, "/") {
+			result = append(result, stripped)
+		}
+	}
+	return result
+}
+
+func getPrefixes(servers openapi3.Servers) []string {
+	result := []string{}
+	for _, server := range servers {
+		if server == nil {
+			continue
+		}
+		basePath, err := server.BasePath()
+		if err != nil {
+			continue
+		}
+		if basePath = strings.TrimSuffix(basePath, "/"); basePath != "" {
+			result = append(result, basePath)
+		}
+	}
+	return result
+}
+
+// templateToRegexp converts a templated path like /pets/{id} to a regular expression that matches concrete request paths
+func templateToRegexp(path string) *regexp.Regexp {
+	var sb strings.Builder
+	sb.WriteString("^")
+	for {
+		start := strings.IndexByte(path, '{')
+		if start < 0 {
+			break
+		}
+		end := strings.IndexByte(path[start:], '}')
+		if end < 0 {
+			break
+		}
+		sb.WriteString(regexp.QuoteMeta(path[:start]))
+		sb.WriteString("[^/]+")
+		path = path[start+end+1:]
+	}
+	sb.WriteString(regexp.QuoteMeta(path))
+	sb.WriteString("/?$")
+	return regexp.MustCompile(sb.String())
+}
+
+// getOperationKey returns a key that identifies an operation
+func getOperationKey(method, path string) string {
+	return strings.ToUpper(method) + " " + path
+}
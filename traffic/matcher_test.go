@@ -0,0 +1,45 @@
+package traffic_test
+
+import (
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oasdiff/oasdiff/traffic"
+	"github.com/stretchr/testify/require"
+)
+
+func loadSpec(t *testing.T, path string) *openapi3.T {
+	t.Helper()
+
+	spec, err := openapi3.NewLoader().LoadFromFile(path)
+	require.NoError(t, err)
+	return spec
+}
+
+func TestMatcher(t *testing.T) {
+	matcher := traffic.NewMatcher(loadSpec(t, "../data/traffic/base.yaml"))
+
+	tests := []struct {
+		method string
+		path   string
+		result string
+		ok     bool
+	}{
+		{"GET", "/pets", "/pets", true},
+		{"get", "/v1/pets", "/pets", true},
+		{"GET", "/v1/pets/", "/pets", true},
+		{"GET", "/v1/pets/mine", "/pets/mine", true},
+		{"GET", "/v1/pets/123", "/pets/{id}", true},
+		{"DELETE", "/v1/pets/123", "/pets/{id}", true},
+		{"DELETE", "/v1/pets/mine", "/pets/{id}", true},
+		{"PUT", "/v1/pets/123", "", false},
+		{"GET", "/v1/pets/123/toys", "", false},
+		{"GET", "/v2/pets", "", false},
+	}
+
+	for _, test := range tests {
+		result, ok := matcher.Match(test.method, test.path)
+		require.Equal(t, test.ok, ok, "%s %s", test.method, test.path)
+		require.Equal(t, test.result, result, "%s %s", test.method, test.path)
+	}
+}
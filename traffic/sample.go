@@ -0,0 +1,68 @@
+package traffic
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Sample is a single recorded request
+type Sample struct {
+	Method string
+	Path   string
+	Query  url.Values
+	Header http.Header
+	Body   []byte
+	Client string
+}
+
+// Samples is a list of recorded requests
+type Samples []*Sample
+
+// newSample creates a sample from a raw request target which may include a query string
+func newSample(method, target string, query url.Values, header http.Header, body []byte, client string) *Sample {
+	path := target
+	if u, err := url.Parse(target); err == nil {
+		path = u.Path
+		if query == nil {
+			query = u.Query()
+		}
+	}
+
+	if query == nil {
+		query = url.Values{}
+	}
+
+	if header == nil {
+		header = http.Header{}
+	}
+
+	return &Sample{
+		Method: strings.ToUpper(method),
+		Path:   path,
+		Query:  query,
+		Header: header,
+		Body:   body,
+		Client: client,
+	}
+}
+
+// getParam returns the values of a non-path request parameter
+func (sample *Sample) getParam(in, name string) ([]string, bool) {
+	switch in {
+	case "query":
+		values, ok := sample.Query[name]
+		return values, ok
+	case "header":
+		values, ok := sample.Header[http.CanonicalHeaderKey(name)]
+		return values, ok
+	case "cookie":
+		request := http.Request{Header: sample.Header}
+		cookie, err := request.Cookie(name)
+		if err != nil {
+			return nil, false
+		}
+		return []string{cookie.Value}, true
+	}
+	return nil, false
+}
@@ -0,0 +1,196 @@
+package traffic
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/utils"
+)
+
+// UsageAttribute is the attribute key under which usage is added to changes
+const UsageAttribute = "usage"
+
+// Usage summarizes the recorded requests that are affected by a change
+type Usage struct {
+	Requests int `json:"requests" yaml:"requests"`
+	Clients  int `json:"clients" yaml:"clients"`
+}
+
+func newUsage(samples Samples) Usage {
+	clients := utils.StringSet{}
+	for _, sample := range samples {
+		clients.Add(sample.Client)
+	}
+	return Usage{
+		Requests: len(samples),
+		Clients:  len(clients),
+	}
+}
+
+// Annotate adds the usage of each change to the change attributes under the key "usage"
+// Only changes to operations that exist in the base spec are annotated
+// If downgrade is true, breaking changes without any recorded usage are downgraded to INFO
+func Annotate(changes checker.Changes, base *openapi3.T, samples Samples, downgrade bool) checker.Changes {
+	if base == nil {
+		return changes
+	}
+
+	samplesByOperation := groupByOperation(NewMatcher(base), samples)
+
+	result := make(checker.Changes, len(changes))
+	for i, change := range changes {
+		result[i] = annotate(change, base, samplesByOperation, downgrade)
+	}
+	return result
+}
+
+func groupByOperation(matcher *Matcher, samples Samples) map[string]Samples {
+	result := map[string]Samples{}
+	for _, sample := range samples {
+		if path, ok := matcher.Match(sample.Method, sample.Path); ok {
+			key := getOperationKey(sample.Method, path)
+			result[key] = append(result[key], sample)
+		}
+	}
+	return result
+}
+
+func annotate(change checker.Change, base *openapi3.T, samplesByOperation map[string]Samples, downgrade bool) checker.Change {
+	apiChange, ok := change.(checker.ApiChange)
+	if !ok {
+		return change
+	}
+
+	if base.Paths == nil {
+		return change
+	}
+	pathItem := base.Paths.Value(apiChange.Path)
+	if pathItem == nil || pathItem.GetOperation(strings.ToUpper(apiChange.Operation)) == nil {
+		return change
+	}
+
+	usage := newUsage(filterSamples(apiChange, samplesByOperation[getOperationKey(apiChange.Operation, apiChange.Path)]))
+
+	attributes := map[string]any{}
+	for k, v := range apiChange.Attributes {
+		attributes[k] = v
+	}
+	attributes[UsageAttribute] = usage
+	apiChange.Attributes = attributes
+
+	if downgrade && usage.Requests == 0 && apiChange.Level.IsBreaking() {
+		apiChange.Level = checker.INFO
+	}
+
+	return apiChange
+}
+
+// filterSamples returns the samples that are affected by the change
+// For most changes, this is any request to the operation, but some changes only affect requests that use a specific parameter, property or value
+func filterSamples(change checker.ApiChange, samples Samples) Samples {
+	var affected func(*Sample) bool
+
+	switch change.Id {
+	case checker.RequestParameterRemovedId:
+		if len(change.Args) == 2 {
+			in, name := fmt.Sprint(change.Args[0]), fmt.Sprint(change.Args[1])
+			affected = func(sample *Sample) bool {
+				if in == openapi3.ParameterInPath {
+					return true
+				}
+				_, found := sample.getParam(in, name)
+				return found
+			}
+		}
+	case checker.RequestParameterEnumValueRemovedId:
+		if len(change.Args) == 3 {
+			value, in, name := fmt.Sprint(change.Args[0]), fmt.Sprint(change.Args[1]), fmt.Sprint(change.Args[2])
+			affected = func(sample *Sample) bool {
+				values, _ := sample.getParam(in, name)
+				for _, v := range values {
+					if v == value {
+						return true
+					}
+				}
+				return false
+			}
+		}
+	case checker.RequestPropertyRemovedId:
+		if len(change.Args) >= 1 {
+			property := fmt.Sprint(change.Args[0])
+			affected = func(sample *Sample) bool {
+				return len(getBodyValues(sample, property)) > 0
+			}
+		}
+	case checker.RequestPropertyEnumValueRemovedId, checker.RequestReadOnlyPropertyEnumValueRemovedId:
+		if len(change.Args) == 2 {
+			value, property := change.Args[0], fmt.Sprint(change.Args[1])
+			affected = func(sample *Sample) bool {
+				return containsValue(getBodyValues(sample, property), value)
+			}
+		}
+	case checker.RequestBodyEnumValueRemovedId:
+		if len(change.Args) == 1 {
+			value := change.Args[0]
+			affected = func(sample *Sample) bool {
+				return containsValue(getBodyValues(sample, ""), value)
+			}
+		}
+	}
+
+	if affected == nil {
+		return samples
+	}
+
+	result := Samples{}
+	for _, sample := range samples {
+		if affected(sample) {
+			result = append(result, sample)
+		}
+	}
+	return result
+}
+
+// getBodyValues returns the values in the JSON body of the sample at the given property path, for example: roleAssignments/items/role
+func getBodyValues(sample *Sample, property string) []any {
+	var body any
+	if err := json.Unmarshal(sample.Body, &body); err != nil {
+		return nil
+	}
+
+	values := []any{body}
+	if property == "" {
+		return values
+	}
+
+	for _, name := range strings.Split(property, "/") {
+		next := []any{}
+		for _, value := range values {
+			switch v := value.(type) {
+			case map[string]any:
+				if child, ok := v[name]; ok {
+					next = append(next, child)
+				}
+			case []any:
+				if name == "items" {
+					next = append(next, v...)
+				}
+			}
+		}
+		values = next
+	}
+	return values
+}
+
+func containsValue(values []any, value any) bool {
+	expected := fmt.Sprint(value)
+	for _, v := range values {
+		if fmt.Sprint(v) == expected {
+			return true
+		}
+	}
+	return false
+}
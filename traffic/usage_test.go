@@ -0,0 +1,95 @@
+package traffic_test
+
+import (
+	"testing"
+
+	"github.com/oasdiff/oasdiff/checker"
+	"github.com/oasdiff/oasdiff/diff"
+	"github.com/oasdiff/oasdiff/load"
+	"github.com/oasdiff/oasdiff/traffic"
+	"github.com/stretchr/testify/require"
+)
+
+func getChanges(t *testing.T) (checker.Changes, *load.SpecInfo) {
+	t.Helper()
+
+	s1 := &load.SpecInfo{Spec: loadSpec(t, "../data/traffic/base.yaml"), Url: "base.yaml"}
+	s2 := &load.SpecInfo{Spec: loadSpec(t, "../data/traffic/revision.yaml"), Url: "revision.yaml"}
+
+	d, osm, err := diff.GetWithOperationsSourcesMap(diff.NewConfig(), s1, s2)
+	require.NoError(t, err)
+
+	return checker.CheckBackwardCompatibility(checker.NewConfig(checker.GetAllChecks()), d, osm), s1
+}
+
+func findChange(t *testing.T, changes checker.Changes, id string) checker.Change {
+	t.Helper()
+
+	for _, change := range changes {
+		if change.GetId() == id {
+			return change
+		}
+	}
+	require.Failf(t, "change not found", id)
+	return nil
+}
+
+func getUsage(change checker.Change) traffic.Usage {
+	return change.GetAttributes()[traffic.UsageAttribute].(traffic.Usage)
+}
+
+func TestAnnotate(t *testing.T) {
+	changes, base := getChanges(t)
+	samples, err := traffic.Load("../data/traffic/traffic.jsonl")
+	require.NoError(t, err)
+
+	annotated := traffic.Annotate(changes, base.Spec, samples, false)
+	require.Len(t, annotated, len(changes))
+
+	enumRemoved := findChange(t, annotated, checker.RequestParameterEnumValueRemovedId)
+	require.Equal(t, traffic.Usage{Requests: 2, Clients: 2}, getUsage(enumRemoved))
+	require.Equal(t, checker.ERR, enumRemoved.GetLevel())
+
+	paramRemoved := findChange(t, annotated, checker.RequestParameterRemovedId)
+	require.Equal(t, traffic.Usage{Requests: 1, Clients: 1}, getUsage(paramRemoved))
+
+	propertyEnumRemoved := findChange(t, annotated, checker.RequestPropertyEnumValueRemovedId)
+	require.Equal(t, traffic.Usage{Requests: 0, Clients: 0}, getUsage(propertyEnumRemoved))
+	require.Equal(t, checker.ERR, propertyEnumRemoved.GetLevel())
+
+	endpointRemoved := findChange(t, annotated, checker.APIRemovedWithoutDeprecationId)
+	require.Equal(t, traffic.Usage{Requests: 0, Clients: 0}, getUsage(endpointRemoved))
+}
+
+func TestAnnotate_Downgrade(t *testing.T) {
+	changes, base := getChanges(t)
+	samples, err := traffic.Load("../data/traffic/traffic.jsonl")
+	require.NoError(t, err)
+
+	annotated := traffic.Annotate(changes, base.Spec, samples, true)
+
+	require.Equal(t, checker.ERR, findChange(t, annotated, checker.RequestParameterEnumValueRemovedId).GetLevel())
+	require.Equal(t, checker.INFO, findChange(t, annotated, checker.RequestPropertyEnumValueRemovedId).GetLevel())
+	require.Equal(t, checker.INFO, findChange(t, annotated, checker.APIRemovedWithoutDeprecationId).GetLevel())
+}
+
+func TestAnnotate_HAR(t *testing.T) {
+	changes, base := getChanges(t)
+	samples, err := traffic.Load("../data/traffic/traffic.har")
+	require.NoError(t, err)
+
+	annotated := traffic.Annotate(changes, base.Spec, samples, true)
+
+	propertyEnumRemoved := findChange(t, annotated, checker.RequestPropertyEnumValueRemovedId)
+	require.Equal(t, traffic.Usage{Requests: 1, Clients: 1}, getUsage(propertyEnumRemoved))
+	require.Equal(t, checker.ERR, propertyEnumRemoved.GetLevel())
+
+	endpointRemoved := findChange(t, annotated, checker.APIRemovedWithoutDeprecationId)
+	require.Equal(t, traffic.Usage{Requests: 1, Clients: 1}, getUsage(endpointRemoved))
+	require.Equal(t, checker.ERR, endpointRemoved.GetLevel())
+}
+
+func TestAnnotate_NoBase(t *testing.T) {
+	changes, _ := getChanges(t)
+	require.Equal(t, changes, traffic.Annotate(changes, nil, nil, true))
+}
@@ -0,0 +1,74 @@
+package utils
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// Example is the value of the example field or of an entry in the examples field of a parameter, a header or a media type
+type Example struct {
+	// Name is "example" for the example field and the key of the entry for the examples field
+	Name string
+	// Path is the location of the value relative to its parent, like [example] or [examples name value]
+	Path  []string
+	Value any
+}
+
+// GetExamples returns the example field, followed by the entries of the examples field sorted by name
+func GetExamples(example any, examples openapi3.Examples) []Example {
+	result := []Example{}
+	if example != nil {
+		result = append(result, Example{Name: "example", Path: []string{"example"}, Value: example})
+	}
+
+	names := make([]string, 0, len(examples))
+	for name := range examples {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		exampleRef := examples[name]
+		if exampleRef == nil || exampleRef.Value == nil || exampleRef.Value.Value == nil {
+			continue
+		}
+		result = append(result, Example{Name: name, Path: []string{"examples", name, "value"}, Value: exampleRef.Value.Value})
+	}
+	return result
+}
+
+// GetParameterSchema returns the schema of a parameter which is defined either by schema or by content
+func GetParameterSchema(parameter *openapi3.Parameter) *openapi3.Schema {
+	if parameter.Schema != nil {
+		return parameter.Schema.Value
+	}
+	for _, mediaType := range parameter.Content {
+		if mediaType != nil && mediaType.Schema != nil {
+			return mediaType.Schema.Value
+		}
+	}
+	return nil
+}
+
+// GetValidationReason returns a short description of a schema validation error without the schema and value details
+func GetValidationReason(err error) string {
+	var schemaErr *openapi3.SchemaError
+	if !errors.As(err, &schemaErr) {
+		return err.Error()
+	}
+
+	reason := schemaErr.Reason
+	if reason == "" {
+		reason = fmt.Sprintf("value doesn't satisfy %q", schemaErr.SchemaField)
+	}
+
+	if pointer := schemaErr.JSONPointer(); len(pointer) > 0 {
+		return fmt.Sprintf("/%s: %s", strings.Join(pointer, "/"), reason)
+	}
+
+	return reason
+}